@@ -125,6 +125,32 @@ func (s *TestSuite) TestExecutableStatement(c *C) {
 	evalAndPrint(c, err, false, stmt)
 	c.Assert(cs.Len(), Equals, 29)
 
+	// BETWEEN SYMMETRIC accepts the bounds in either order
+	for _, stmt := range []string{
+		"SELECT Epoch, Open, High, Low, Close from `AAPL/1Min/OHLCV` WHERE Epoch BETWEEN SYMMETRIC '2000-01-05-13:00' AND '2000-01-05-12:30';",
+		"SELECT Epoch, Open, High, Low, Close from `AAPL/1Min/OHLCV` WHERE Epoch between symmetric '2000-01-05-12:30' AND '2000-01-05-13:00';",
+	} {
+		ast, err = NewAstBuilder(stmt)
+		evalAndPrint(c, err, false, stmt)
+		es, err = NewExecutableStatement(ast.Mtree)
+		evalAndPrint(c, err, false, stmt)
+		cs, err = es.Materialize()
+		evalAndPrint(c, err, false, stmt)
+		c.Assert(cs.Len(), Equals, 29)
+	}
+	// SYMMETRIC is not reserved, so it still names columns
+	stmt = "SELECT Epoch, symmetric from `AAPL/1Min/OHLCV` WHERE symmetric BETWEEN 1 AND 2;"
+	_, err = NewAstBuilder(stmt)
+	evalAndPrint(c, err, false, stmt)
+	stmt = "SELECT Epoch, Open, High, Low, Close from `AAPL/1Min/OHLCV` WHERE Epoch BETWEEN '2000-01-05-13:00' AND '2000-01-05-12:30';"
+	ast, err = NewAstBuilder(stmt)
+	evalAndPrint(c, err, false, stmt)
+	es, err = NewExecutableStatement(ast.Mtree)
+	evalAndPrint(c, err, false, stmt)
+	cs, err = es.Materialize()
+	evalAndPrint(c, err, false, stmt)
+	c.Assert(cs.Len(), Equals, 0)
+
 	stmt = "SELECT Epoch, Open, High, Low, Close from `AAPL/1Min/OHLCV` WHERE Epoch > '2000-01-05-12:30' AND Epoch < '2000-01-05-13:00';"
 	ast, err = NewAstBuilder(stmt)
 	evalAndPrint(c, err, false, stmt)
@@ -489,40 +489,33 @@ func (es *ExecutableStatement) VisitPredicateParse(ctx *PredicateParse) interfac
 }
 
 func (es *ExecutableStatement) VisitBetweenParse(ctx *BetweenParse) interface{} {
-	i_literal := es.nodeCursor.Visit(ctx.lower)
-	if literal, ok := i_literal.(*Literal); !ok {
-		return fmt.Errorf("Dynamic predicate bounds not supported")
-	} else {
+	var bounds [2]*Literal
+	for i, node := range []IMSTree{ctx.lower, ctx.upper} {
+		i_literal := es.nodeCursor.Visit(node)
+		literal, ok := i_literal.(*Literal)
+		if !ok {
+			return fmt.Errorf("Dynamic predicate bounds not supported")
+		}
 		/*
 			Make sure that the literal represents a numeric quantity
 		*/
-		err := CoerceToNumeric(literal)
-		if err != nil {
+		if err := CoerceToNumeric(literal); err != nil {
 			return err
 		}
-		if ctx.IsNot {
-			es.nodeCursor.pendingSP.AddComparison(io.LTE, literal.Value)
-		} else {
-			es.nodeCursor.pendingSP.AddComparison(io.GT, literal.Value)
-		}
+		bounds[i] = literal
+	}
+	lower, upper := bounds[0], bounds[1]
+	// BETWEEN SYMMETRIC accepts the bounds in either order
+	if ctx.IsSymmetric && numericLess(upper.Value, lower.Value) {
+		lower, upper = upper, lower
 	}
 
-	i_literal = es.nodeCursor.Visit(ctx.upper)
-	if literal, ok := i_literal.(*Literal); !ok {
-		return fmt.Errorf("Dynamic predicate bounds not supported")
+	if ctx.IsNot {
+		es.nodeCursor.pendingSP.AddComparison(io.LTE, lower.Value)
+		es.nodeCursor.pendingSP.AddComparison(io.GTE, upper.Value)
 	} else {
-		/*
-			Make sure that the literal represents a numeric quantity
-		*/
-		err := CoerceToNumeric(literal)
-		if err != nil {
-			return err
-		}
-		if ctx.IsNot {
-			es.nodeCursor.pendingSP.AddComparison(io.GTE, literal.Value)
-		} else {
-			es.nodeCursor.pendingSP.AddComparison(io.LT, literal.Value)
-		}
+		es.nodeCursor.pendingSP.AddComparison(io.GT, lower.Value)
+		es.nodeCursor.pendingSP.AddComparison(io.LT, upper.Value)
 	}
 	return nil
 }
@@ -673,3 +666,17 @@ func CoerceToNumeric(literal *Literal) (err error) {
 	}
 	return nil
 }
+
+// numericLess compares the values of two literals coerced to numerics
+func numericLess(a, b interface{}) bool {
+	toFloat := func(v interface{}) float64 {
+		switch value := v.(type) {
+		case int64:
+			return float64(value)
+		case float64:
+			return value
+		}
+		return 0
+	}
+	return toFloat(a) < toFloat(b)
+}
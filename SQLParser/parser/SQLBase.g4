@@ -213,7 +213,7 @@ booleanliteral: (TRUE | FALSE);
 predicate
     : comparisonOperator right=valueExpression                     #comparison
     | comparisonOperator comparisonQuantifier '(' query ')'        #quantifiedComparison
-    | NOT? BETWEEN lower=valueExpression
+    | NOT? BETWEEN SYMMETRIC? lower=valueExpression
      AND upper=valueExpression                                     #between
     | NOT? IN '(' valueExpression (',' valueExpression)* ')'       #inList
     | NOT? IN '(' query ')'                                        #inSubquery
@@ -410,4 +410,5 @@ nonReserved
     | INPUT | OUTPUT
     | INCLUDING | EXCLUDING | PROPERTIES
     | ALL | SOME | ANY
+    | SYMMETRIC
     ;
@@ -28,6 +28,7 @@ NOT:  [Nn][Oo][Tt];
 NO:  [Nn][Oo];
 EXISTS:  [Ee][Xx][Ii][Ss][Tt][Ss];
 BETWEEN:  [Bb][Ee][Tt][Ww][Ee][Ee][Nn];
+SYMMETRIC:  [Ss][Yy][Mm][Mm][Ee][Tt][Rr][Ii][Cc];
 LIKE:  [Ll][Ii][Kk][Ee];
 IS:  [Ii][Ss];
 NULL:  [Nn][Uu][Ll][Ll];
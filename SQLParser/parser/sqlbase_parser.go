@@ -16,7 +16,7 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 1072, 54993, 33286, 44333, 17431, 44785, 36224, 43741, 3, 214, 1312,
+	3, 1072, 54993, 33286, 44333, 17431, 44785, 36224, 43741, 3, 215, 1315,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
@@ -156,509 +156,512 @@ var parserATN = []uint16{
 	3, 52, 3, 52, 3, 52, 3, 52, 5, 52, 1287, 10, 52, 3, 53, 3, 53, 3, 53, 3,
 	53, 5, 53, 1293, 10, 53, 3, 54, 3, 54, 3, 54, 7, 54, 1298, 10, 54, 12,
 	54, 14, 54, 1301, 11, 54, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 5, 55, 1308,
-	10, 55, 3, 56, 3, 56, 3, 56, 2, 8, 22, 44, 60, 66, 68, 80, 57, 2, 4, 6,
-	8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42,
-	44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78,
-	80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 2,
-	24, 4, 2, 13, 13, 33, 33, 4, 2, 48, 48, 110, 110, 3, 2, 177, 178, 3, 2,
-	137, 139, 3, 2, 47, 48, 3, 2, 44, 45, 4, 2, 16, 16, 19, 19, 3, 2, 141,
-	143, 3, 2, 31, 32, 3, 2, 41, 42, 3, 2, 197, 198, 3, 2, 197, 201, 3, 2,
-	181, 184, 3, 2, 191, 196, 3, 2, 16, 18, 3, 2, 59, 64, 3, 2, 90, 91, 3,
-	2, 93, 94, 3, 2, 120, 121, 3, 2, 122, 124, 3, 2, 167, 168, 18, 2, 14, 14,
-	16, 18, 30, 30, 35, 35, 49, 50, 52, 65, 87, 91, 93, 96, 101, 101, 103,
-	105, 111, 124, 127, 135, 140, 144, 149, 169, 173, 179, 181, 187, 1514,
-	2, 112, 3, 2, 2, 2, 4, 426, 3, 2, 2, 2, 6, 429, 3, 2, 2, 2, 8, 433, 3,
-	2, 2, 2, 10, 447, 3, 2, 2, 2, 12, 449, 3, 2, 2, 2, 14, 455, 3, 2, 2, 2,
-	16, 461, 3, 2, 2, 2, 18, 472, 3, 2, 2, 2, 20, 476, 3, 2, 2, 2, 22, 493,
-	3, 2, 2, 2, 24, 523, 3, 2, 2, 2, 26, 525, 3, 2, 2, 2, 28, 533, 3, 2, 2,
-	2, 30, 570, 3, 2, 2, 2, 32, 620, 3, 2, 2, 2, 34, 635, 3, 2, 2, 2, 36, 650,
-	3, 2, 2, 2, 38, 652, 3, 2, 2, 2, 40, 661, 3, 2, 2, 2, 42, 675, 3, 2, 2,
-	2, 44, 677, 3, 2, 2, 2, 46, 704, 3, 2, 2, 2, 48, 720, 3, 2, 2, 2, 50, 722,
-	3, 2, 2, 2, 52, 731, 3, 2, 2, 2, 54, 741, 3, 2, 2, 2, 56, 776, 3, 2, 2,
-	2, 58, 780, 3, 2, 2, 2, 60, 789, 3, 2, 2, 2, 62, 799, 3, 2, 2, 2, 64, 862,
-	3, 2, 2, 2, 66, 868, 3, 2, 2, 2, 68, 1097, 3, 2, 2, 2, 70, 1118, 3, 2,
-	2, 2, 72, 1120, 3, 2, 2, 2, 74, 1122, 3, 2, 2, 2, 76, 1124, 3, 2, 2, 2,
-	78, 1134, 3, 2, 2, 2, 80, 1178, 3, 2, 2, 2, 82, 1189, 3, 2, 2, 2, 84, 1196,
-	3, 2, 2, 2, 86, 1198, 3, 2, 2, 2, 88, 1203, 3, 2, 2, 2, 90, 1209, 3, 2,
-	2, 2, 92, 1248, 3, 2, 2, 2, 94, 1257, 3, 2, 2, 2, 96, 1263, 3, 2, 2, 2,
-	98, 1270, 3, 2, 2, 2, 100, 1279, 3, 2, 2, 2, 102, 1286, 3, 2, 2, 2, 104,
-	1292, 3, 2, 2, 2, 106, 1294, 3, 2, 2, 2, 108, 1307, 3, 2, 2, 2, 110, 1309,
-	3, 2, 2, 2, 112, 113, 5, 4, 3, 2, 113, 114, 7, 11, 2, 2, 114, 3, 3, 2,
-	2, 2, 115, 427, 5, 6, 4, 2, 116, 117, 7, 100, 2, 2, 117, 121, 7, 102, 2,
-	2, 118, 119, 7, 185, 2, 2, 119, 120, 7, 34, 2, 2, 120, 122, 7, 36, 2, 2,
-	121, 118, 3, 2, 2, 2, 121, 122, 3, 2, 2, 2, 122, 123, 3, 2, 2, 2, 123,
-	124, 5, 106, 54, 2, 124, 125, 7, 3, 2, 2, 125, 130, 5, 10, 6, 2, 126, 127,
-	7, 4, 2, 2, 127, 129, 5, 10, 6, 2, 128, 126, 3, 2, 2, 2, 129, 132, 3, 2,
-	2, 2, 130, 128, 3, 2, 2, 2, 130, 131, 3, 2, 2, 2, 131, 133, 3, 2, 2, 2,
-	132, 130, 3, 2, 2, 2, 133, 136, 7, 5, 2, 2, 134, 135, 7, 97, 2, 2, 135,
-	137, 5, 16, 9, 2, 136, 134, 3, 2, 2, 2, 136, 137, 3, 2, 2, 2, 137, 427,
-	3, 2, 2, 2, 138, 139, 7, 100, 2, 2, 139, 143, 7, 102, 2, 2, 140, 141, 7,
-	185, 2, 2, 141, 142, 7, 34, 2, 2, 142, 144, 7, 36, 2, 2, 143, 140, 3, 2,
-	2, 2, 143, 144, 3, 2, 2, 2, 144, 145, 3, 2, 2, 2, 145, 148, 5, 106, 54,
-	2, 146, 147, 7, 97, 2, 2, 147, 149, 5, 16, 9, 2, 148, 146, 3, 2, 2, 2,
-	148, 149, 3, 2, 2, 2, 149, 150, 3, 2, 2, 2, 150, 151, 7, 15, 2, 2, 151,
-	152, 5, 6, 4, 2, 152, 427, 3, 2, 2, 2, 153, 154, 7, 136, 2, 2, 154, 157,
-	7, 102, 2, 2, 155, 156, 7, 185, 2, 2, 156, 158, 7, 36, 2, 2, 157, 155,
-	3, 2, 2, 2, 157, 158, 3, 2, 2, 2, 158, 159, 3, 2, 2, 2, 159, 427, 5, 106,
-	54, 2, 160, 161, 7, 106, 2, 2, 161, 162, 7, 108, 2, 2, 162, 164, 5, 106,
-	54, 2, 163, 165, 5, 54, 28, 2, 164, 163, 3, 2, 2, 2, 164, 165, 3, 2, 2,
-	2, 165, 166, 3, 2, 2, 2, 166, 167, 5, 6, 4, 2, 167, 427, 3, 2, 2, 2, 168,
-	169, 7, 107, 2, 2, 169, 170, 7, 13, 2, 2, 170, 173, 5, 106, 54, 2, 171,
-	172, 7, 20, 2, 2, 172, 174, 5, 60, 31, 2, 173, 171, 3, 2, 2, 2, 173, 174,
-	3, 2, 2, 2, 174, 427, 3, 2, 2, 2, 175, 176, 7, 145, 2, 2, 176, 177, 7,
-	102, 2, 2, 177, 178, 5, 106, 54, 2, 178, 179, 7, 146, 2, 2, 179, 180, 7,
-	140, 2, 2, 180, 181, 5, 106, 54, 2, 181, 427, 3, 2, 2, 2, 182, 183, 7,
-	145, 2, 2, 183, 184, 7, 102, 2, 2, 184, 185, 5, 106, 54, 2, 185, 186, 7,
-	146, 2, 2, 186, 187, 7, 132, 2, 2, 187, 188, 5, 108, 55, 2, 188, 189, 7,
-	140, 2, 2, 189, 190, 5, 108, 55, 2, 190, 427, 3, 2, 2, 2, 191, 192, 7,
-	145, 2, 2, 192, 193, 7, 102, 2, 2, 193, 194, 5, 106, 54, 2, 194, 195, 7,
-	14, 2, 2, 195, 196, 7, 132, 2, 2, 196, 197, 5, 12, 7, 2, 197, 427, 3, 2,
-	2, 2, 198, 201, 7, 100, 2, 2, 199, 200, 7, 31, 2, 2, 200, 202, 7, 105,
-	2, 2, 201, 199, 3, 2, 2, 2, 201, 202, 3, 2, 2, 2, 202, 203, 3, 2, 2, 2,
-	203, 204, 7, 104, 2, 2, 204, 206, 5, 106, 54, 2, 205, 207, 5, 54, 28, 2,
-	206, 205, 3, 2, 2, 2, 206, 207, 3, 2, 2, 2, 207, 208, 3, 2, 2, 2, 208,
-	209, 7, 15, 2, 2, 209, 210, 5, 6, 4, 2, 210, 427, 3, 2, 2, 2, 211, 212,
-	7, 136, 2, 2, 212, 215, 7, 104, 2, 2, 213, 214, 7, 185, 2, 2, 214, 216,
-	7, 36, 2, 2, 215, 213, 3, 2, 2, 2, 215, 216, 3, 2, 2, 2, 216, 217, 3, 2,
-	2, 2, 217, 427, 5, 106, 54, 2, 218, 219, 7, 169, 2, 2, 219, 220, 5, 106,
-	54, 2, 220, 229, 7, 3, 2, 2, 221, 226, 5, 102, 52, 2, 222, 223, 7, 4, 2,
-	2, 223, 225, 5, 102, 52, 2, 224, 222, 3, 2, 2, 2, 225, 228, 3, 2, 2, 2,
-	226, 224, 3, 2, 2, 2, 226, 227, 3, 2, 2, 2, 227, 230, 3, 2, 2, 2, 228,
-	226, 3, 2, 2, 2, 229, 221, 3, 2, 2, 2, 229, 230, 3, 2, 2, 2, 230, 231,
-	3, 2, 2, 2, 231, 232, 7, 5, 2, 2, 232, 427, 3, 2, 2, 2, 233, 244, 7, 111,
-	2, 2, 234, 239, 5, 104, 53, 2, 235, 236, 7, 4, 2, 2, 236, 238, 5, 104,
-	53, 2, 237, 235, 3, 2, 2, 2, 238, 241, 3, 2, 2, 2, 239, 237, 3, 2, 2, 2,
-	239, 240, 3, 2, 2, 2, 240, 245, 3, 2, 2, 2, 241, 239, 3, 2, 2, 2, 242,
-	243, 7, 16, 2, 2, 243, 245, 7, 113, 2, 2, 244, 234, 3, 2, 2, 2, 244, 242,
-	3, 2, 2, 2, 245, 246, 3, 2, 2, 2, 246, 248, 7, 86, 2, 2, 247, 249, 7, 102,
-	2, 2, 248, 247, 3, 2, 2, 2, 248, 249, 3, 2, 2, 2, 249, 250, 3, 2, 2, 2,
-	250, 251, 5, 106, 54, 2, 251, 252, 7, 140, 2, 2, 252, 256, 5, 108, 55,
-	2, 253, 254, 7, 97, 2, 2, 254, 255, 7, 111, 2, 2, 255, 257, 7, 115, 2,
-	2, 256, 253, 3, 2, 2, 2, 256, 257, 3, 2, 2, 2, 257, 427, 3, 2, 2, 2, 258,
-	262, 7, 112, 2, 2, 259, 260, 7, 111, 2, 2, 260, 261, 7, 115, 2, 2, 261,
-	263, 7, 51, 2, 2, 262, 259, 3, 2, 2, 2, 262, 263, 3, 2, 2, 2, 263, 274,
-	3, 2, 2, 2, 264, 269, 5, 104, 53, 2, 265, 266, 7, 4, 2, 2, 266, 268, 5,
-	104, 53, 2, 267, 265, 3, 2, 2, 2, 268, 271, 3, 2, 2, 2, 269, 267, 3, 2,
-	2, 2, 269, 270, 3, 2, 2, 2, 270, 275, 3, 2, 2, 2, 271, 269, 3, 2, 2, 2,
-	272, 273, 7, 16, 2, 2, 273, 275, 7, 113, 2, 2, 274, 264, 3, 2, 2, 2, 274,
-	272, 3, 2, 2, 2, 275, 276, 3, 2, 2, 2, 276, 278, 7, 86, 2, 2, 277, 279,
-	7, 102, 2, 2, 278, 277, 3, 2, 2, 2, 278, 279, 3, 2, 2, 2, 279, 280, 3,
-	2, 2, 2, 280, 281, 5, 106, 54, 2, 281, 282, 7, 13, 2, 2, 282, 283, 5, 108,
-	55, 2, 283, 427, 3, 2, 2, 2, 284, 286, 7, 116, 2, 2, 285, 287, 7, 117,
-	2, 2, 286, 285, 3, 2, 2, 2, 286, 287, 3, 2, 2, 2, 287, 299, 3, 2, 2, 2,
-	288, 289, 7, 3, 2, 2, 289, 294, 5, 96, 49, 2, 290, 291, 7, 4, 2, 2, 291,
-	293, 5, 96, 49, 2, 292, 290, 3, 2, 2, 2, 293, 296, 3, 2, 2, 2, 294, 292,
-	3, 2, 2, 2, 294, 295, 3, 2, 2, 2, 295, 297, 3, 2, 2, 2, 296, 294, 3, 2,
-	2, 2, 297, 298, 7, 5, 2, 2, 298, 300, 3, 2, 2, 2, 299, 288, 3, 2, 2, 2,
-	299, 300, 3, 2, 2, 2, 300, 301, 3, 2, 2, 2, 301, 427, 5, 4, 3, 2, 302,
-	303, 7, 127, 2, 2, 303, 304, 7, 100, 2, 2, 304, 305, 7, 102, 2, 2, 305,
-	427, 5, 106, 54, 2, 306, 307, 7, 127, 2, 2, 307, 308, 7, 100, 2, 2, 308,
-	309, 7, 104, 2, 2, 309, 427, 5, 106, 54, 2, 310, 311, 7, 127, 2, 2, 311,
-	314, 7, 128, 2, 2, 312, 313, 9, 2, 2, 2, 313, 315, 5, 106, 54, 2, 314,
-	312, 3, 2, 2, 2, 314, 315, 3, 2, 2, 2, 315, 318, 3, 2, 2, 2, 316, 317,
-	7, 38, 2, 2, 317, 319, 7, 204, 2, 2, 318, 316, 3, 2, 2, 2, 318, 319, 3,
-	2, 2, 2, 319, 427, 3, 2, 2, 2, 320, 321, 7, 127, 2, 2, 321, 324, 7, 129,
-	2, 2, 322, 323, 9, 2, 2, 2, 323, 325, 5, 108, 55, 2, 324, 322, 3, 2, 2,
-	2, 324, 325, 3, 2, 2, 2, 325, 328, 3, 2, 2, 2, 326, 327, 7, 38, 2, 2, 327,
-	329, 7, 204, 2, 2, 328, 326, 3, 2, 2, 2, 328, 329, 3, 2, 2, 2, 329, 427,
-	3, 2, 2, 2, 330, 331, 7, 127, 2, 2, 331, 334, 7, 130, 2, 2, 332, 333, 7,
-	38, 2, 2, 333, 335, 7, 204, 2, 2, 334, 332, 3, 2, 2, 2, 334, 335, 3, 2,
-	2, 2, 335, 427, 3, 2, 2, 2, 336, 337, 7, 127, 2, 2, 337, 338, 7, 131, 2,
-	2, 338, 339, 9, 2, 2, 2, 339, 427, 5, 106, 54, 2, 340, 341, 9, 3, 2, 2,
-	341, 427, 5, 106, 54, 2, 342, 343, 7, 127, 2, 2, 343, 427, 7, 135, 2, 2,
-	344, 345, 7, 127, 2, 2, 345, 427, 7, 153, 2, 2, 346, 347, 7, 151, 2, 2,
-	347, 348, 7, 153, 2, 2, 348, 349, 5, 106, 54, 2, 349, 350, 7, 191, 2, 2,
-	350, 351, 5, 58, 30, 2, 351, 427, 3, 2, 2, 2, 352, 353, 7, 152, 2, 2, 353,
-	354, 7, 153, 2, 2, 354, 427, 5, 106, 54, 2, 355, 356, 7, 155, 2, 2, 356,
-	365, 7, 156, 2, 2, 357, 362, 5, 98, 50, 2, 358, 359, 7, 4, 2, 2, 359, 361,
-	5, 98, 50, 2, 360, 358, 3, 2, 2, 2, 361, 364, 3, 2, 2, 2, 362, 360, 3,
-	2, 2, 2, 362, 363, 3, 2, 2, 2, 363, 366, 3, 2, 2, 2, 364, 362, 3, 2, 2,
-	2, 365, 357, 3, 2, 2, 2, 365, 366, 3, 2, 2, 2, 366, 427, 3, 2, 2, 2, 367,
-	369, 7, 157, 2, 2, 368, 370, 7, 159, 2, 2, 369, 368, 3, 2, 2, 2, 369, 370,
-	3, 2, 2, 2, 370, 427, 3, 2, 2, 2, 371, 373, 7, 158, 2, 2, 372, 374, 7,
-	159, 2, 2, 373, 372, 3, 2, 2, 2, 373, 374, 3, 2, 2, 2, 374, 427, 3, 2,
-	2, 2, 375, 376, 7, 127, 2, 2, 376, 377, 7, 134, 2, 2, 377, 378, 9, 2, 2,
-	2, 378, 381, 5, 106, 54, 2, 379, 380, 7, 20, 2, 2, 380, 382, 5, 60, 31,
-	2, 381, 379, 3, 2, 2, 2, 381, 382, 3, 2, 2, 2, 382, 393, 3, 2, 2, 2, 383,
-	384, 7, 27, 2, 2, 384, 385, 7, 22, 2, 2, 385, 390, 5, 26, 14, 2, 386, 387,
-	7, 4, 2, 2, 387, 389, 5, 26, 14, 2, 388, 386, 3, 2, 2, 2, 389, 392, 3,
-	2, 2, 2, 390, 388, 3, 2, 2, 2, 390, 391, 3, 2, 2, 2, 391, 394, 3, 2, 2,
-	2, 392, 390, 3, 2, 2, 2, 393, 383, 3, 2, 2, 2, 393, 394, 3, 2, 2, 2, 394,
-	397, 3, 2, 2, 2, 395, 396, 7, 29, 2, 2, 396, 398, 7, 206, 2, 2, 397, 395,
-	3, 2, 2, 2, 397, 398, 3, 2, 2, 2, 398, 427, 3, 2, 2, 2, 399, 400, 7, 170,
-	2, 2, 400, 401, 5, 108, 55, 2, 401, 402, 7, 13, 2, 2, 402, 403, 5, 4, 3,
-	2, 403, 427, 3, 2, 2, 2, 404, 405, 7, 171, 2, 2, 405, 406, 7, 170, 2, 2,
-	406, 427, 5, 108, 55, 2, 407, 408, 7, 172, 2, 2, 408, 418, 5, 108, 55,
-	2, 409, 410, 7, 85, 2, 2, 410, 415, 5, 58, 30, 2, 411, 412, 7, 4, 2, 2,
-	412, 414, 5, 58, 30, 2, 413, 411, 3, 2, 2, 2, 414, 417, 3, 2, 2, 2, 415,
-	413, 3, 2, 2, 2, 415, 416, 3, 2, 2, 2, 416, 419, 3, 2, 2, 2, 417, 415,
-	3, 2, 2, 2, 418, 409, 3, 2, 2, 2, 418, 419, 3, 2, 2, 2, 419, 427, 3, 2,
-	2, 2, 420, 421, 7, 110, 2, 2, 421, 422, 7, 173, 2, 2, 422, 427, 5, 108,
-	55, 2, 423, 424, 7, 110, 2, 2, 424, 425, 7, 174, 2, 2, 425, 427, 5, 108,
-	55, 2, 426, 115, 3, 2, 2, 2, 426, 116, 3, 2, 2, 2, 426, 138, 3, 2, 2, 2,
-	426, 153, 3, 2, 2, 2, 426, 160, 3, 2, 2, 2, 426, 168, 3, 2, 2, 2, 426,
-	175, 3, 2, 2, 2, 426, 182, 3, 2, 2, 2, 426, 191, 3, 2, 2, 2, 426, 198,
-	3, 2, 2, 2, 426, 211, 3, 2, 2, 2, 426, 218, 3, 2, 2, 2, 426, 233, 3, 2,
-	2, 2, 426, 258, 3, 2, 2, 2, 426, 284, 3, 2, 2, 2, 426, 302, 3, 2, 2, 2,
-	426, 306, 3, 2, 2, 2, 426, 310, 3, 2, 2, 2, 426, 320, 3, 2, 2, 2, 426,
-	330, 3, 2, 2, 2, 426, 336, 3, 2, 2, 2, 426, 340, 3, 2, 2, 2, 426, 342,
-	3, 2, 2, 2, 426, 344, 3, 2, 2, 2, 426, 346, 3, 2, 2, 2, 426, 352, 3, 2,
-	2, 2, 426, 355, 3, 2, 2, 2, 426, 367, 3, 2, 2, 2, 426, 371, 3, 2, 2, 2,
-	426, 375, 3, 2, 2, 2, 426, 399, 3, 2, 2, 2, 426, 404, 3, 2, 2, 2, 426,
-	407, 3, 2, 2, 2, 426, 420, 3, 2, 2, 2, 426, 423, 3, 2, 2, 2, 427, 5, 3,
-	2, 2, 2, 428, 430, 5, 8, 5, 2, 429, 428, 3, 2, 2, 2, 429, 430, 3, 2, 2,
-	2, 430, 431, 3, 2, 2, 2, 431, 432, 5, 20, 11, 2, 432, 7, 3, 2, 2, 2, 433,
-	435, 7, 97, 2, 2, 434, 436, 7, 98, 2, 2, 435, 434, 3, 2, 2, 2, 435, 436,
-	3, 2, 2, 2, 436, 437, 3, 2, 2, 2, 437, 442, 5, 38, 20, 2, 438, 439, 7,
-	4, 2, 2, 439, 441, 5, 38, 20, 2, 440, 438, 3, 2, 2, 2, 441, 444, 3, 2,
-	2, 2, 442, 440, 3, 2, 2, 2, 442, 443, 3, 2, 2, 2, 443, 9, 3, 2, 2, 2, 444,
-	442, 3, 2, 2, 2, 445, 448, 5, 12, 7, 2, 446, 448, 5, 14, 8, 2, 447, 445,
-	3, 2, 2, 2, 447, 446, 3, 2, 2, 2, 448, 11, 3, 2, 2, 2, 449, 450, 5, 108,
-	55, 2, 450, 453, 5, 80, 41, 2, 451, 452, 7, 103, 2, 2, 452, 454, 7, 204,
-	2, 2, 453, 451, 3, 2, 2, 2, 453, 454, 3, 2, 2, 2, 454, 13, 3, 2, 2, 2,
-	455, 456, 7, 38, 2, 2, 456, 459, 5, 106, 54, 2, 457, 458, 9, 4, 2, 2, 458,
-	460, 7, 179, 2, 2, 459, 457, 3, 2, 2, 2, 459, 460, 3, 2, 2, 2, 460, 15,
-	3, 2, 2, 2, 461, 462, 7, 3, 2, 2, 462, 467, 5, 18, 10, 2, 463, 464, 7,
-	4, 2, 2, 464, 466, 5, 18, 10, 2, 465, 463, 3, 2, 2, 2, 466, 469, 3, 2,
-	2, 2, 467, 465, 3, 2, 2, 2, 467, 468, 3, 2, 2, 2, 468, 470, 3, 2, 2, 2,
-	469, 467, 3, 2, 2, 2, 470, 471, 7, 5, 2, 2, 471, 17, 3, 2, 2, 2, 472, 473,
-	5, 108, 55, 2, 473, 474, 7, 191, 2, 2, 474, 475, 5, 58, 30, 2, 475, 19,
-	3, 2, 2, 2, 476, 487, 5, 22, 12, 2, 477, 478, 7, 27, 2, 2, 478, 479, 7,
-	22, 2, 2, 479, 484, 5, 26, 14, 2, 480, 481, 7, 4, 2, 2, 481, 483, 5, 26,
-	14, 2, 482, 480, 3, 2, 2, 2, 483, 486, 3, 2, 2, 2, 484, 482, 3, 2, 2, 2,
-	484, 485, 3, 2, 2, 2, 485, 488, 3, 2, 2, 2, 486, 484, 3, 2, 2, 2, 487,
-	477, 3, 2, 2, 2, 487, 488, 3, 2, 2, 2, 488, 491, 3, 2, 2, 2, 489, 490,
-	7, 29, 2, 2, 490, 492, 7, 206, 2, 2, 491, 489, 3, 2, 2, 2, 491, 492, 3,
-	2, 2, 2, 492, 21, 3, 2, 2, 2, 493, 494, 8, 12, 1, 2, 494, 495, 5, 24, 13,
-	2, 495, 504, 3, 2, 2, 2, 496, 497, 12, 3, 2, 2, 497, 499, 9, 5, 2, 2, 498,
-	500, 5, 40, 21, 2, 499, 498, 3, 2, 2, 2, 499, 500, 3, 2, 2, 2, 500, 501,
-	3, 2, 2, 2, 501, 503, 5, 22, 12, 4, 502, 496, 3, 2, 2, 2, 503, 506, 3,
-	2, 2, 2, 504, 502, 3, 2, 2, 2, 504, 505, 3, 2, 2, 2, 505, 23, 3, 2, 2,
-	2, 506, 504, 3, 2, 2, 2, 507, 524, 5, 28, 15, 2, 508, 509, 7, 102, 2, 2,
-	509, 524, 5, 106, 54, 2, 510, 511, 7, 99, 2, 2, 511, 516, 5, 58, 30, 2,
-	512, 513, 7, 4, 2, 2, 513, 515, 5, 58, 30, 2, 514, 512, 3, 2, 2, 2, 515,
-	518, 3, 2, 2, 2, 516, 514, 3, 2, 2, 2, 516, 517, 3, 2, 2, 2, 517, 524,
-	3, 2, 2, 2, 518, 516, 3, 2, 2, 2, 519, 520, 7, 3, 2, 2, 520, 521, 5, 20,
-	11, 2, 521, 522, 7, 5, 2, 2, 522, 524, 3, 2, 2, 2, 523, 507, 3, 2, 2, 2,
-	523, 508, 3, 2, 2, 2, 523, 510, 3, 2, 2, 2, 523, 519, 3, 2, 2, 2, 524,
-	25, 3, 2, 2, 2, 525, 527, 5, 58, 30, 2, 526, 528, 9, 6, 2, 2, 527, 526,
-	3, 2, 2, 2, 527, 528, 3, 2, 2, 2, 528, 531, 3, 2, 2, 2, 529, 530, 7, 43,
-	2, 2, 530, 532, 9, 7, 2, 2, 531, 529, 3, 2, 2, 2, 531, 532, 3, 2, 2, 2,
-	532, 27, 3, 2, 2, 2, 533, 535, 7, 12, 2, 2, 534, 536, 5, 40, 21, 2, 535,
-	534, 3, 2, 2, 2, 535, 536, 3, 2, 2, 2, 536, 537, 3, 2, 2, 2, 537, 542,
-	5, 42, 22, 2, 538, 539, 7, 4, 2, 2, 539, 541, 5, 42, 22, 2, 540, 538, 3,
-	2, 2, 2, 541, 544, 3, 2, 2, 2, 542, 540, 3, 2, 2, 2, 542, 543, 3, 2, 2,
-	2, 543, 554, 3, 2, 2, 2, 544, 542, 3, 2, 2, 2, 545, 546, 7, 13, 2, 2, 546,
-	551, 5, 44, 23, 2, 547, 548, 7, 4, 2, 2, 548, 550, 5, 44, 23, 2, 549, 547,
-	3, 2, 2, 2, 550, 553, 3, 2, 2, 2, 551, 549, 3, 2, 2, 2, 551, 552, 3, 2,
-	2, 2, 552, 555, 3, 2, 2, 2, 553, 551, 3, 2, 2, 2, 554, 545, 3, 2, 2, 2,
-	554, 555, 3, 2, 2, 2, 555, 558, 3, 2, 2, 2, 556, 557, 7, 20, 2, 2, 557,
-	559, 5, 60, 31, 2, 558, 556, 3, 2, 2, 2, 558, 559, 3, 2, 2, 2, 559, 563,
-	3, 2, 2, 2, 560, 561, 7, 21, 2, 2, 561, 562, 7, 22, 2, 2, 562, 564, 5,
-	30, 16, 2, 563, 560, 3, 2, 2, 2, 563, 564, 3, 2, 2, 2, 564, 567, 3, 2,
-	2, 2, 565, 566, 7, 28, 2, 2, 566, 568, 5, 60, 31, 2, 567, 565, 3, 2, 2,
-	2, 567, 568, 3, 2, 2, 2, 568, 29, 3, 2, 2, 2, 569, 571, 5, 40, 21, 2, 570,
-	569, 3, 2, 2, 2, 570, 571, 3, 2, 2, 2, 571, 572, 3, 2, 2, 2, 572, 577,
-	5, 32, 17, 2, 573, 574, 7, 4, 2, 2, 574, 576, 5, 32, 17, 2, 575, 573, 3,
-	2, 2, 2, 576, 579, 3, 2, 2, 2, 577, 575, 3, 2, 2, 2, 577, 578, 3, 2, 2,
-	2, 578, 31, 3, 2, 2, 2, 579, 577, 3, 2, 2, 2, 580, 621, 5, 34, 18, 2, 581,
-	582, 7, 26, 2, 2, 582, 591, 7, 3, 2, 2, 583, 588, 5, 106, 54, 2, 584, 585,
-	7, 4, 2, 2, 585, 587, 5, 106, 54, 2, 586, 584, 3, 2, 2, 2, 587, 590, 3,
-	2, 2, 2, 588, 586, 3, 2, 2, 2, 588, 589, 3, 2, 2, 2, 589, 592, 3, 2, 2,
-	2, 590, 588, 3, 2, 2, 2, 591, 583, 3, 2, 2, 2, 591, 592, 3, 2, 2, 2, 592,
-	593, 3, 2, 2, 2, 593, 621, 7, 5, 2, 2, 594, 595, 7, 25, 2, 2, 595, 604,
-	7, 3, 2, 2, 596, 601, 5, 106, 54, 2, 597, 598, 7, 4, 2, 2, 598, 600, 5,
-	106, 54, 2, 599, 597, 3, 2, 2, 2, 600, 603, 3, 2, 2, 2, 601, 599, 3, 2,
-	2, 2, 601, 602, 3, 2, 2, 2, 602, 605, 3, 2, 2, 2, 603, 601, 3, 2, 2, 2,
-	604, 596, 3, 2, 2, 2, 604, 605, 3, 2, 2, 2, 605, 606, 3, 2, 2, 2, 606,
-	621, 7, 5, 2, 2, 607, 608, 7, 23, 2, 2, 608, 609, 7, 24, 2, 2, 609, 610,
-	7, 3, 2, 2, 610, 615, 5, 36, 19, 2, 611, 612, 7, 4, 2, 2, 612, 614, 5,
-	36, 19, 2, 613, 611, 3, 2, 2, 2, 614, 617, 3, 2, 2, 2, 615, 613, 3, 2,
-	2, 2, 615, 616, 3, 2, 2, 2, 616, 618, 3, 2, 2, 2, 617, 615, 3, 2, 2, 2,
-	618, 619, 7, 5, 2, 2, 619, 621, 3, 2, 2, 2, 620, 580, 3, 2, 2, 2, 620,
-	581, 3, 2, 2, 2, 620, 594, 3, 2, 2, 2, 620, 607, 3, 2, 2, 2, 621, 33, 3,
-	2, 2, 2, 622, 631, 7, 3, 2, 2, 623, 628, 5, 58, 30, 2, 624, 625, 7, 4,
-	2, 2, 625, 627, 5, 58, 30, 2, 626, 624, 3, 2, 2, 2, 627, 630, 3, 2, 2,
-	2, 628, 626, 3, 2, 2, 2, 628, 629, 3, 2, 2, 2, 629, 632, 3, 2, 2, 2, 630,
-	628, 3, 2, 2, 2, 631, 623, 3, 2, 2, 2, 631, 632, 3, 2, 2, 2, 632, 633,
-	3, 2, 2, 2, 633, 636, 7, 5, 2, 2, 634, 636, 5, 58, 30, 2, 635, 622, 3,
-	2, 2, 2, 635, 634, 3, 2, 2, 2, 636, 35, 3, 2, 2, 2, 637, 646, 7, 3, 2,
-	2, 638, 643, 5, 106, 54, 2, 639, 640, 7, 4, 2, 2, 640, 642, 5, 106, 54,
-	2, 641, 639, 3, 2, 2, 2, 642, 645, 3, 2, 2, 2, 643, 641, 3, 2, 2, 2, 643,
-	644, 3, 2, 2, 2, 644, 647, 3, 2, 2, 2, 645, 643, 3, 2, 2, 2, 646, 638,
-	3, 2, 2, 2, 646, 647, 3, 2, 2, 2, 647, 648, 3, 2, 2, 2, 648, 651, 7, 5,
-	2, 2, 649, 651, 5, 106, 54, 2, 650, 637, 3, 2, 2, 2, 650, 649, 3, 2, 2,
-	2, 651, 37, 3, 2, 2, 2, 652, 654, 5, 108, 55, 2, 653, 655, 5, 54, 28, 2,
-	654, 653, 3, 2, 2, 2, 654, 655, 3, 2, 2, 2, 655, 656, 3, 2, 2, 2, 656,
-	657, 7, 15, 2, 2, 657, 658, 7, 3, 2, 2, 658, 659, 5, 6, 4, 2, 659, 660,
-	7, 5, 2, 2, 660, 39, 3, 2, 2, 2, 661, 662, 9, 8, 2, 2, 662, 41, 3, 2, 2,
-	2, 663, 668, 5, 58, 30, 2, 664, 666, 7, 15, 2, 2, 665, 664, 3, 2, 2, 2,
-	665, 666, 3, 2, 2, 2, 666, 667, 3, 2, 2, 2, 667, 669, 5, 108, 55, 2, 668,
-	665, 3, 2, 2, 2, 668, 669, 3, 2, 2, 2, 669, 676, 3, 2, 2, 2, 670, 671,
-	5, 106, 54, 2, 671, 672, 7, 203, 2, 2, 672, 673, 7, 199, 2, 2, 673, 676,
-	3, 2, 2, 2, 674, 676, 7, 199, 2, 2, 675, 663, 3, 2, 2, 2, 675, 670, 3,
-	2, 2, 2, 675, 674, 3, 2, 2, 2, 676, 43, 3, 2, 2, 2, 677, 678, 8, 23, 1,
-	2, 678, 679, 5, 50, 26, 2, 679, 691, 3, 2, 2, 2, 680, 682, 12, 4, 2, 2,
-	681, 683, 5, 46, 24, 2, 682, 681, 3, 2, 2, 2, 682, 683, 3, 2, 2, 2, 683,
-	684, 3, 2, 2, 2, 684, 685, 7, 77, 2, 2, 685, 687, 5, 44, 23, 2, 686, 688,
-	5, 48, 25, 2, 687, 686, 3, 2, 2, 2, 687, 688, 3, 2, 2, 2, 688, 690, 3,
-	2, 2, 2, 689, 680, 3, 2, 2, 2, 690, 693, 3, 2, 2, 2, 691, 689, 3, 2, 2,
-	2, 691, 692, 3, 2, 2, 2, 692, 45, 3, 2, 2, 2, 693, 691, 3, 2, 2, 2, 694,
-	705, 7, 80, 2, 2, 695, 696, 7, 81, 2, 2, 696, 705, 7, 79, 2, 2, 697, 698,
-	7, 82, 2, 2, 698, 705, 7, 79, 2, 2, 699, 700, 7, 83, 2, 2, 700, 705, 7,
-	79, 2, 2, 701, 705, 7, 78, 2, 2, 702, 705, 7, 84, 2, 2, 703, 705, 7, 81,
-	2, 2, 704, 694, 3, 2, 2, 2, 704, 695, 3, 2, 2, 2, 704, 697, 3, 2, 2, 2,
-	704, 699, 3, 2, 2, 2, 704, 701, 3, 2, 2, 2, 704, 702, 3, 2, 2, 2, 704,
-	703, 3, 2, 2, 2, 705, 47, 3, 2, 2, 2, 706, 707, 7, 86, 2, 2, 707, 721,
-	5, 60, 31, 2, 708, 709, 7, 85, 2, 2, 709, 710, 7, 3, 2, 2, 710, 715, 5,
-	108, 55, 2, 711, 712, 7, 4, 2, 2, 712, 714, 5, 108, 55, 2, 713, 711, 3,
-	2, 2, 2, 714, 717, 3, 2, 2, 2, 715, 713, 3, 2, 2, 2, 715, 716, 3, 2, 2,
-	2, 716, 718, 3, 2, 2, 2, 717, 715, 3, 2, 2, 2, 718, 719, 7, 5, 2, 2, 719,
-	721, 3, 2, 2, 2, 720, 706, 3, 2, 2, 2, 720, 708, 3, 2, 2, 2, 721, 49, 3,
-	2, 2, 2, 722, 729, 5, 52, 27, 2, 723, 724, 7, 144, 2, 2, 724, 725, 9, 9,
-	2, 2, 725, 726, 7, 3, 2, 2, 726, 727, 5, 58, 30, 2, 727, 728, 7, 5, 2,
-	2, 728, 730, 3, 2, 2, 2, 729, 723, 3, 2, 2, 2, 729, 730, 3, 2, 2, 2, 730,
-	51, 3, 2, 2, 2, 731, 739, 5, 56, 29, 2, 732, 734, 7, 15, 2, 2, 733, 732,
-	3, 2, 2, 2, 733, 734, 3, 2, 2, 2, 734, 735, 3, 2, 2, 2, 735, 737, 5, 108,
-	55, 2, 736, 738, 5, 54, 28, 2, 737, 736, 3, 2, 2, 2, 737, 738, 3, 2, 2,
-	2, 738, 740, 3, 2, 2, 2, 739, 733, 3, 2, 2, 2, 739, 740, 3, 2, 2, 2, 740,
-	53, 3, 2, 2, 2, 741, 742, 7, 3, 2, 2, 742, 747, 5, 108, 55, 2, 743, 744,
-	7, 4, 2, 2, 744, 746, 5, 108, 55, 2, 745, 743, 3, 2, 2, 2, 746, 749, 3,
-	2, 2, 2, 747, 745, 3, 2, 2, 2, 747, 748, 3, 2, 2, 2, 748, 750, 3, 2, 2,
-	2, 749, 747, 3, 2, 2, 2, 750, 751, 7, 5, 2, 2, 751, 55, 3, 2, 2, 2, 752,
-	777, 5, 106, 54, 2, 753, 754, 7, 3, 2, 2, 754, 755, 5, 6, 4, 2, 755, 756,
-	7, 5, 2, 2, 756, 777, 3, 2, 2, 2, 757, 758, 7, 147, 2, 2, 758, 759, 7,
-	3, 2, 2, 759, 764, 5, 58, 30, 2, 760, 761, 7, 4, 2, 2, 761, 763, 5, 58,
-	30, 2, 762, 760, 3, 2, 2, 2, 763, 766, 3, 2, 2, 2, 764, 762, 3, 2, 2, 2,
-	764, 765, 3, 2, 2, 2, 765, 767, 3, 2, 2, 2, 766, 764, 3, 2, 2, 2, 767,
-	770, 7, 5, 2, 2, 768, 769, 7, 97, 2, 2, 769, 771, 7, 148, 2, 2, 770, 768,
-	3, 2, 2, 2, 770, 771, 3, 2, 2, 2, 771, 777, 3, 2, 2, 2, 772, 773, 7, 3,
-	2, 2, 773, 774, 5, 44, 23, 2, 774, 775, 7, 5, 2, 2, 775, 777, 3, 2, 2,
-	2, 776, 752, 3, 2, 2, 2, 776, 753, 3, 2, 2, 2, 776, 757, 3, 2, 2, 2, 776,
-	772, 3, 2, 2, 2, 777, 57, 3, 2, 2, 2, 778, 781, 5, 60, 31, 2, 779, 781,
-	5, 66, 34, 2, 780, 778, 3, 2, 2, 2, 780, 779, 3, 2, 2, 2, 781, 59, 3, 2,
-	2, 2, 782, 783, 8, 31, 1, 2, 783, 784, 5, 66, 34, 2, 784, 785, 5, 64, 33,
-	2, 785, 790, 3, 2, 2, 2, 786, 787, 7, 34, 2, 2, 787, 790, 5, 60, 31, 5,
-	788, 790, 5, 62, 32, 2, 789, 782, 3, 2, 2, 2, 789, 786, 3, 2, 2, 2, 789,
-	788, 3, 2, 2, 2, 790, 796, 3, 2, 2, 2, 791, 792, 12, 4, 2, 2, 792, 793,
-	9, 10, 2, 2, 793, 795, 5, 58, 30, 2, 794, 791, 3, 2, 2, 2, 795, 798, 3,
-	2, 2, 2, 796, 794, 3, 2, 2, 2, 796, 797, 3, 2, 2, 2, 797, 61, 3, 2, 2,
-	2, 798, 796, 3, 2, 2, 2, 799, 800, 9, 11, 2, 2, 800, 63, 3, 2, 2, 2, 801,
-	802, 5, 72, 37, 2, 802, 803, 5, 66, 34, 2, 803, 863, 3, 2, 2, 2, 804, 805,
-	5, 72, 37, 2, 805, 806, 5, 74, 38, 2, 806, 807, 7, 3, 2, 2, 807, 808, 5,
-	6, 4, 2, 808, 809, 7, 5, 2, 2, 809, 863, 3, 2, 2, 2, 810, 812, 7, 34, 2,
-	2, 811, 810, 3, 2, 2, 2, 811, 812, 3, 2, 2, 2, 812, 813, 3, 2, 2, 2, 813,
-	814, 7, 37, 2, 2, 814, 815, 5, 66, 34, 2, 815, 816, 7, 32, 2, 2, 816, 817,
-	5, 66, 34, 2, 817, 863, 3, 2, 2, 2, 818, 820, 7, 34, 2, 2, 819, 818, 3,
-	2, 2, 2, 819, 820, 3, 2, 2, 2, 820, 821, 3, 2, 2, 2, 821, 822, 7, 33, 2,
-	2, 822, 823, 7, 3, 2, 2, 823, 828, 5, 66, 34, 2, 824, 825, 7, 4, 2, 2,
-	825, 827, 5, 66, 34, 2, 826, 824, 3, 2, 2, 2, 827, 830, 3, 2, 2, 2, 828,
-	826, 3, 2, 2, 2, 828, 829, 3, 2, 2, 2, 829, 831, 3, 2, 2, 2, 830, 828,
-	3, 2, 2, 2, 831, 832, 7, 5, 2, 2, 832, 863, 3, 2, 2, 2, 833, 835, 7, 34,
-	2, 2, 834, 833, 3, 2, 2, 2, 834, 835, 3, 2, 2, 2, 835, 836, 3, 2, 2, 2,
-	836, 837, 7, 33, 2, 2, 837, 838, 7, 3, 2, 2, 838, 839, 5, 6, 4, 2, 839,
-	840, 7, 5, 2, 2, 840, 863, 3, 2, 2, 2, 841, 843, 7, 34, 2, 2, 842, 841,
-	3, 2, 2, 2, 842, 843, 3, 2, 2, 2, 843, 844, 3, 2, 2, 2, 844, 845, 7, 38,
-	2, 2, 845, 848, 5, 66, 34, 2, 846, 847, 7, 46, 2, 2, 847, 849, 5, 66, 34,
-	2, 848, 846, 3, 2, 2, 2, 848, 849, 3, 2, 2, 2, 849, 863, 3, 2, 2, 2, 850,
-	852, 7, 39, 2, 2, 851, 853, 7, 34, 2, 2, 852, 851, 3, 2, 2, 2, 852, 853,
-	3, 2, 2, 2, 853, 854, 3, 2, 2, 2, 854, 863, 7, 40, 2, 2, 855, 857, 7, 39,
-	2, 2, 856, 858, 7, 34, 2, 2, 857, 856, 3, 2, 2, 2, 857, 858, 3, 2, 2, 2,
-	858, 859, 3, 2, 2, 2, 859, 860, 7, 19, 2, 2, 860, 861, 7, 13, 2, 2, 861,
-	863, 5, 66, 34, 2, 862, 801, 3, 2, 2, 2, 862, 804, 3, 2, 2, 2, 862, 811,
-	3, 2, 2, 2, 862, 819, 3, 2, 2, 2, 862, 834, 3, 2, 2, 2, 862, 842, 3, 2,
-	2, 2, 862, 850, 3, 2, 2, 2, 862, 855, 3, 2, 2, 2, 863, 65, 3, 2, 2, 2,
-	864, 865, 8, 34, 1, 2, 865, 869, 5, 68, 35, 2, 866, 867, 9, 12, 2, 2, 867,
-	869, 5, 66, 34, 5, 868, 864, 3, 2, 2, 2, 868, 866, 3, 2, 2, 2, 869, 881,
-	3, 2, 2, 2, 870, 871, 12, 4, 2, 2, 871, 872, 9, 13, 2, 2, 872, 880, 5,
-	66, 34, 5, 873, 874, 12, 3, 2, 2, 874, 875, 7, 202, 2, 2, 875, 880, 5,
-	66, 34, 4, 876, 877, 12, 6, 2, 2, 877, 878, 7, 30, 2, 2, 878, 880, 5, 70,
-	36, 2, 879, 870, 3, 2, 2, 2, 879, 873, 3, 2, 2, 2, 879, 876, 3, 2, 2, 2,
-	880, 883, 3, 2, 2, 2, 881, 879, 3, 2, 2, 2, 881, 882, 3, 2, 2, 2, 882,
-	67, 3, 2, 2, 2, 883, 881, 3, 2, 2, 2, 884, 885, 8, 35, 1, 2, 885, 1098,
-	7, 40, 2, 2, 886, 1098, 7, 204, 2, 2, 887, 1098, 7, 205, 2, 2, 888, 1098,
-	7, 207, 2, 2, 889, 1098, 7, 206, 2, 2, 890, 1098, 5, 62, 32, 2, 891, 892,
-	5, 84, 43, 2, 892, 893, 7, 204, 2, 2, 893, 1098, 3, 2, 2, 2, 894, 1098,
-	5, 76, 39, 2, 895, 1098, 7, 6, 2, 2, 896, 899, 5, 108, 55, 2, 897, 899,
-	7, 190, 2, 2, 898, 896, 3, 2, 2, 2, 898, 897, 3, 2, 2, 2, 899, 900, 3,
-	2, 2, 2, 900, 1098, 7, 204, 2, 2, 901, 902, 7, 50, 2, 2, 902, 903, 7, 3,
-	2, 2, 903, 904, 5, 66, 34, 2, 904, 905, 7, 33, 2, 2, 905, 906, 5, 66, 34,
-	2, 906, 907, 7, 5, 2, 2, 907, 1098, 3, 2, 2, 2, 908, 909, 7, 3, 2, 2, 909,
-	912, 5, 58, 30, 2, 910, 911, 7, 4, 2, 2, 911, 913, 5, 58, 30, 2, 912, 910,
-	3, 2, 2, 2, 913, 914, 3, 2, 2, 2, 914, 912, 3, 2, 2, 2, 914, 915, 3, 2,
-	2, 2, 915, 916, 3, 2, 2, 2, 916, 917, 7, 5, 2, 2, 917, 1098, 3, 2, 2, 2,
-	918, 919, 7, 96, 2, 2, 919, 920, 7, 3, 2, 2, 920, 925, 5, 58, 30, 2, 921,
-	922, 7, 4, 2, 2, 922, 924, 5, 58, 30, 2, 923, 921, 3, 2, 2, 2, 924, 927,
-	3, 2, 2, 2, 925, 923, 3, 2, 2, 2, 925, 926, 3, 2, 2, 2, 926, 928, 3, 2,
-	2, 2, 927, 925, 3, 2, 2, 2, 928, 929, 7, 5, 2, 2, 929, 1098, 3, 2, 2, 2,
-	930, 931, 5, 106, 54, 2, 931, 932, 7, 3, 2, 2, 932, 933, 7, 199, 2, 2,
-	933, 935, 7, 5, 2, 2, 934, 936, 5, 88, 45, 2, 935, 934, 3, 2, 2, 2, 935,
-	936, 3, 2, 2, 2, 936, 938, 3, 2, 2, 2, 937, 939, 5, 90, 46, 2, 938, 937,
-	3, 2, 2, 2, 938, 939, 3, 2, 2, 2, 939, 1098, 3, 2, 2, 2, 940, 941, 5, 106,
-	54, 2, 941, 953, 7, 3, 2, 2, 942, 944, 5, 40, 21, 2, 943, 942, 3, 2, 2,
-	2, 943, 944, 3, 2, 2, 2, 944, 945, 3, 2, 2, 2, 945, 950, 5, 58, 30, 2,
-	946, 947, 7, 4, 2, 2, 947, 949, 5, 58, 30, 2, 948, 946, 3, 2, 2, 2, 949,
-	952, 3, 2, 2, 2, 950, 948, 3, 2, 2, 2, 950, 951, 3, 2, 2, 2, 951, 954,
-	3, 2, 2, 2, 952, 950, 3, 2, 2, 2, 953, 943, 3, 2, 2, 2, 953, 954, 3, 2,
-	2, 2, 954, 955, 3, 2, 2, 2, 955, 957, 7, 5, 2, 2, 956, 958, 5, 88, 45,
-	2, 957, 956, 3, 2, 2, 2, 957, 958, 3, 2, 2, 2, 958, 960, 3, 2, 2, 2, 959,
-	961, 5, 90, 46, 2, 960, 959, 3, 2, 2, 2, 960, 961, 3, 2, 2, 2, 961, 1098,
-	3, 2, 2, 2, 962, 963, 5, 108, 55, 2, 963, 964, 7, 7, 2, 2, 964, 965, 5,
-	58, 30, 2, 965, 1098, 3, 2, 2, 2, 966, 967, 7, 3, 2, 2, 967, 972, 5, 108,
-	55, 2, 968, 969, 7, 4, 2, 2, 969, 971, 5, 108, 55, 2, 970, 968, 3, 2, 2,
-	2, 971, 974, 3, 2, 2, 2, 972, 970, 3, 2, 2, 2, 972, 973, 3, 2, 2, 2, 973,
-	975, 3, 2, 2, 2, 974, 972, 3, 2, 2, 2, 975, 976, 7, 5, 2, 2, 976, 977,
-	7, 7, 2, 2, 977, 978, 5, 58, 30, 2, 978, 1098, 3, 2, 2, 2, 979, 980, 7,
-	3, 2, 2, 980, 981, 5, 6, 4, 2, 981, 982, 7, 5, 2, 2, 982, 1098, 3, 2, 2,
-	2, 983, 984, 7, 36, 2, 2, 984, 985, 7, 3, 2, 2, 985, 986, 5, 6, 4, 2, 986,
-	987, 7, 5, 2, 2, 987, 1098, 3, 2, 2, 2, 988, 989, 7, 72, 2, 2, 989, 991,
-	5, 66, 34, 2, 990, 992, 5, 86, 44, 2, 991, 990, 3, 2, 2, 2, 992, 993, 3,
-	2, 2, 2, 993, 991, 3, 2, 2, 2, 993, 994, 3, 2, 2, 2, 994, 997, 3, 2, 2,
-	2, 995, 996, 7, 75, 2, 2, 996, 998, 5, 58, 30, 2, 997, 995, 3, 2, 2, 2,
-	997, 998, 3, 2, 2, 2, 998, 999, 3, 2, 2, 2, 999, 1000, 7, 76, 2, 2, 1000,
-	1098, 3, 2, 2, 2, 1001, 1003, 7, 72, 2, 2, 1002, 1004, 5, 86, 44, 2, 1003,
-	1002, 3, 2, 2, 2, 1004, 1005, 3, 2, 2, 2, 1005, 1003, 3, 2, 2, 2, 1005,
-	1006, 3, 2, 2, 2, 1006, 1009, 3, 2, 2, 2, 1007, 1008, 7, 75, 2, 2, 1008,
-	1010, 5, 58, 30, 2, 1009, 1007, 3, 2, 2, 2, 1009, 1010, 3, 2, 2, 2, 1010,
-	1011, 3, 2, 2, 2, 1011, 1012, 7, 76, 2, 2, 1012, 1098, 3, 2, 2, 2, 1013,
-	1014, 7, 125, 2, 2, 1014, 1015, 7, 3, 2, 2, 1015, 1016, 5, 58, 30, 2, 1016,
-	1017, 7, 15, 2, 2, 1017, 1018, 5, 80, 41, 2, 1018, 1019, 7, 5, 2, 2, 1019,
-	1098, 3, 2, 2, 2, 1020, 1021, 7, 126, 2, 2, 1021, 1022, 7, 3, 2, 2, 1022,
-	1023, 5, 58, 30, 2, 1023, 1024, 7, 15, 2, 2, 1024, 1025, 5, 80, 41, 2,
-	1025, 1026, 7, 5, 2, 2, 1026, 1098, 3, 2, 2, 2, 1027, 1028, 7, 149, 2,
-	2, 1028, 1037, 7, 8, 2, 2, 1029, 1034, 5, 58, 30, 2, 1030, 1031, 7, 4,
-	2, 2, 1031, 1033, 5, 58, 30, 2, 1032, 1030, 3, 2, 2, 2, 1033, 1036, 3,
-	2, 2, 2, 1034, 1032, 3, 2, 2, 2, 1034, 1035, 3, 2, 2, 2, 1035, 1038, 3,
-	2, 2, 2, 1036, 1034, 3, 2, 2, 2, 1037, 1029, 3, 2, 2, 2, 1037, 1038, 3,
-	2, 2, 2, 1038, 1039, 3, 2, 2, 2, 1039, 1098, 7, 9, 2, 2, 1040, 1098, 5,
-	108, 55, 2, 1041, 1098, 7, 66, 2, 2, 1042, 1046, 7, 67, 2, 2, 1043, 1044,
-	7, 3, 2, 2, 1044, 1045, 7, 206, 2, 2, 1045, 1047, 7, 5, 2, 2, 1046, 1043,
-	3, 2, 2, 2, 1046, 1047, 3, 2, 2, 2, 1047, 1098, 3, 2, 2, 2, 1048, 1052,
-	7, 68, 2, 2, 1049, 1050, 7, 3, 2, 2, 1050, 1051, 7, 206, 2, 2, 1051, 1053,
-	7, 5, 2, 2, 1052, 1049, 3, 2, 2, 2, 1052, 1053, 3, 2, 2, 2, 1053, 1098,
-	3, 2, 2, 2, 1054, 1058, 7, 69, 2, 2, 1055, 1056, 7, 3, 2, 2, 1056, 1057,
-	7, 206, 2, 2, 1057, 1059, 7, 5, 2, 2, 1058, 1055, 3, 2, 2, 2, 1058, 1059,
-	3, 2, 2, 2, 1059, 1098, 3, 2, 2, 2, 1060, 1064, 7, 70, 2, 2, 1061, 1062,
-	7, 3, 2, 2, 1062, 1063, 7, 206, 2, 2, 1063, 1065, 7, 5, 2, 2, 1064, 1061,
-	3, 2, 2, 2, 1064, 1065, 3, 2, 2, 2, 1065, 1098, 3, 2, 2, 2, 1066, 1067,
-	7, 49, 2, 2, 1067, 1068, 7, 3, 2, 2, 1068, 1069, 5, 66, 34, 2, 1069, 1070,
-	7, 13, 2, 2, 1070, 1073, 5, 66, 34, 2, 1071, 1072, 7, 51, 2, 2, 1072, 1074,
-	5, 66, 34, 2, 1073, 1071, 3, 2, 2, 2, 1073, 1074, 3, 2, 2, 2, 1074, 1075,
-	3, 2, 2, 2, 1075, 1076, 7, 5, 2, 2, 1076, 1098, 3, 2, 2, 2, 1077, 1078,
-	7, 180, 2, 2, 1078, 1079, 7, 3, 2, 2, 1079, 1082, 5, 66, 34, 2, 1080, 1081,
-	7, 4, 2, 2, 1081, 1083, 9, 14, 2, 2, 1082, 1080, 3, 2, 2, 2, 1082, 1083,
-	3, 2, 2, 2, 1083, 1084, 3, 2, 2, 2, 1084, 1085, 7, 5, 2, 2, 1085, 1098,
-	3, 2, 2, 2, 1086, 1087, 7, 71, 2, 2, 1087, 1088, 7, 3, 2, 2, 1088, 1089,
-	5, 108, 55, 2, 1089, 1090, 7, 13, 2, 2, 1090, 1091, 5, 66, 34, 2, 1091,
-	1092, 7, 5, 2, 2, 1092, 1098, 3, 2, 2, 2, 1093, 1094, 7, 3, 2, 2, 1094,
-	1095, 5, 58, 30, 2, 1095, 1096, 7, 5, 2, 2, 1096, 1098, 3, 2, 2, 2, 1097,
-	884, 3, 2, 2, 2, 1097, 886, 3, 2, 2, 2, 1097, 887, 3, 2, 2, 2, 1097, 888,
-	3, 2, 2, 2, 1097, 889, 3, 2, 2, 2, 1097, 890, 3, 2, 2, 2, 1097, 891, 3,
-	2, 2, 2, 1097, 894, 3, 2, 2, 2, 1097, 895, 3, 2, 2, 2, 1097, 898, 3, 2,
-	2, 2, 1097, 901, 3, 2, 2, 2, 1097, 908, 3, 2, 2, 2, 1097, 918, 3, 2, 2,
-	2, 1097, 930, 3, 2, 2, 2, 1097, 940, 3, 2, 2, 2, 1097, 962, 3, 2, 2, 2,
-	1097, 966, 3, 2, 2, 2, 1097, 979, 3, 2, 2, 2, 1097, 983, 3, 2, 2, 2, 1097,
-	988, 3, 2, 2, 2, 1097, 1001, 3, 2, 2, 2, 1097, 1013, 3, 2, 2, 2, 1097,
-	1020, 3, 2, 2, 2, 1097, 1027, 3, 2, 2, 2, 1097, 1040, 3, 2, 2, 2, 1097,
-	1041, 3, 2, 2, 2, 1097, 1042, 3, 2, 2, 2, 1097, 1048, 3, 2, 2, 2, 1097,
-	1054, 3, 2, 2, 2, 1097, 1060, 3, 2, 2, 2, 1097, 1066, 3, 2, 2, 2, 1097,
-	1077, 3, 2, 2, 2, 1097, 1086, 3, 2, 2, 2, 1097, 1093, 3, 2, 2, 2, 1098,
-	1109, 3, 2, 2, 2, 1099, 1100, 12, 14, 2, 2, 1100, 1101, 7, 8, 2, 2, 1101,
-	1102, 5, 66, 34, 2, 1102, 1103, 7, 9, 2, 2, 1103, 1108, 3, 2, 2, 2, 1104,
-	1105, 12, 12, 2, 2, 1105, 1106, 7, 203, 2, 2, 1106, 1108, 5, 108, 55, 2,
-	1107, 1099, 3, 2, 2, 2, 1107, 1104, 3, 2, 2, 2, 1108, 1111, 3, 2, 2, 2,
-	1109, 1107, 3, 2, 2, 2, 1109, 1110, 3, 2, 2, 2, 1110, 69, 3, 2, 2, 2, 1111,
-	1109, 3, 2, 2, 2, 1112, 1113, 7, 56, 2, 2, 1113, 1114, 7, 65, 2, 2, 1114,
-	1119, 5, 76, 39, 2, 1115, 1116, 7, 56, 2, 2, 1116, 1117, 7, 65, 2, 2, 1117,
-	1119, 7, 204, 2, 2, 1118, 1112, 3, 2, 2, 2, 1118, 1115, 3, 2, 2, 2, 1119,
-	71, 3, 2, 2, 2, 1120, 1121, 9, 15, 2, 2, 1121, 73, 3, 2, 2, 2, 1122, 1123,
-	9, 16, 2, 2, 1123, 75, 3, 2, 2, 2, 1124, 1126, 7, 58, 2, 2, 1125, 1127,
-	9, 12, 2, 2, 1126, 1125, 3, 2, 2, 2, 1126, 1127, 3, 2, 2, 2, 1127, 1128,
-	3, 2, 2, 2, 1128, 1129, 7, 204, 2, 2, 1129, 1132, 5, 78, 40, 2, 1130, 1131,
-	7, 140, 2, 2, 1131, 1133, 5, 78, 40, 2, 1132, 1130, 3, 2, 2, 2, 1132, 1133,
-	3, 2, 2, 2, 1133, 77, 3, 2, 2, 2, 1134, 1135, 9, 17, 2, 2, 1135, 79, 3,
-	2, 2, 2, 1136, 1137, 8, 41, 1, 2, 1137, 1138, 7, 149, 2, 2, 1138, 1139,
-	7, 193, 2, 2, 1139, 1140, 5, 80, 41, 2, 1140, 1141, 7, 195, 2, 2, 1141,
-	1179, 3, 2, 2, 2, 1142, 1143, 7, 150, 2, 2, 1143, 1144, 7, 193, 2, 2, 1144,
-	1145, 5, 80, 41, 2, 1145, 1146, 7, 4, 2, 2, 1146, 1147, 5, 80, 41, 2, 1147,
-	1148, 7, 195, 2, 2, 1148, 1179, 3, 2, 2, 2, 1149, 1150, 7, 96, 2, 2, 1150,
-	1151, 7, 3, 2, 2, 1151, 1152, 5, 108, 55, 2, 1152, 1159, 5, 80, 41, 2,
-	1153, 1154, 7, 4, 2, 2, 1154, 1155, 5, 108, 55, 2, 1155, 1156, 5, 80, 41,
-	2, 1156, 1158, 3, 2, 2, 2, 1157, 1153, 3, 2, 2, 2, 1158, 1161, 3, 2, 2,
-	2, 1159, 1157, 3, 2, 2, 2, 1159, 1160, 3, 2, 2, 2, 1160, 1162, 3, 2, 2,
-	2, 1161, 1159, 3, 2, 2, 2, 1162, 1163, 7, 5, 2, 2, 1163, 1179, 3, 2, 2,
-	2, 1164, 1176, 5, 84, 43, 2, 1165, 1166, 7, 3, 2, 2, 1166, 1171, 5, 82,
-	42, 2, 1167, 1168, 7, 4, 2, 2, 1168, 1170, 5, 82, 42, 2, 1169, 1167, 3,
-	2, 2, 2, 1170, 1173, 3, 2, 2, 2, 1171, 1169, 3, 2, 2, 2, 1171, 1172, 3,
-	2, 2, 2, 1172, 1174, 3, 2, 2, 2, 1173, 1171, 3, 2, 2, 2, 1174, 1175, 7,
-	5, 2, 2, 1175, 1177, 3, 2, 2, 2, 1176, 1165, 3, 2, 2, 2, 1176, 1177, 3,
-	2, 2, 2, 1177, 1179, 3, 2, 2, 2, 1178, 1136, 3, 2, 2, 2, 1178, 1142, 3,
-	2, 2, 2, 1178, 1149, 3, 2, 2, 2, 1178, 1164, 3, 2, 2, 2, 1179, 1184, 3,
-	2, 2, 2, 1180, 1181, 12, 7, 2, 2, 1181, 1183, 7, 149, 2, 2, 1182, 1180,
-	3, 2, 2, 2, 1183, 1186, 3, 2, 2, 2, 1184, 1182, 3, 2, 2, 2, 1184, 1185,
-	3, 2, 2, 2, 1185, 81, 3, 2, 2, 2, 1186, 1184, 3, 2, 2, 2, 1187, 1190, 7,
-	206, 2, 2, 1188, 1190, 5, 80, 41, 2, 1189, 1187, 3, 2, 2, 2, 1189, 1188,
-	3, 2, 2, 2, 1190, 83, 3, 2, 2, 2, 1191, 1197, 7, 188, 2, 2, 1192, 1197,
-	7, 189, 2, 2, 1193, 1197, 7, 55, 2, 2, 1194, 1197, 7, 190, 2, 2, 1195,
-	1197, 5, 108, 55, 2, 1196, 1191, 3, 2, 2, 2, 1196, 1192, 3, 2, 2, 2, 1196,
-	1193, 3, 2, 2, 2, 1196, 1194, 3, 2, 2, 2, 1196, 1195, 3, 2, 2, 2, 1197,
-	85, 3, 2, 2, 2, 1198, 1199, 7, 73, 2, 2, 1199, 1200, 5, 58, 30, 2, 1200,
-	1201, 7, 74, 2, 2, 1201, 1202, 5, 58, 30, 2, 1202, 87, 3, 2, 2, 2, 1203,
-	1204, 7, 87, 2, 2, 1204, 1205, 7, 3, 2, 2, 1205, 1206, 7, 20, 2, 2, 1206,
-	1207, 5, 60, 31, 2, 1207, 1208, 7, 5, 2, 2, 1208, 89, 3, 2, 2, 2, 1209,
-	1210, 7, 88, 2, 2, 1210, 1221, 7, 3, 2, 2, 1211, 1212, 7, 89, 2, 2, 1212,
-	1213, 7, 22, 2, 2, 1213, 1218, 5, 58, 30, 2, 1214, 1215, 7, 4, 2, 2, 1215,
-	1217, 5, 58, 30, 2, 1216, 1214, 3, 2, 2, 2, 1217, 1220, 3, 2, 2, 2, 1218,
-	1216, 3, 2, 2, 2, 1218, 1219, 3, 2, 2, 2, 1219, 1222, 3, 2, 2, 2, 1220,
-	1218, 3, 2, 2, 2, 1221, 1211, 3, 2, 2, 2, 1221, 1222, 3, 2, 2, 2, 1222,
-	1233, 3, 2, 2, 2, 1223, 1224, 7, 27, 2, 2, 1224, 1225, 7, 22, 2, 2, 1225,
-	1230, 5, 26, 14, 2, 1226, 1227, 7, 4, 2, 2, 1227, 1229, 5, 26, 14, 2, 1228,
-	1226, 3, 2, 2, 2, 1229, 1232, 3, 2, 2, 2, 1230, 1228, 3, 2, 2, 2, 1230,
-	1231, 3, 2, 2, 2, 1231, 1234, 3, 2, 2, 2, 1232, 1230, 3, 2, 2, 2, 1233,
-	1223, 3, 2, 2, 2, 1233, 1234, 3, 2, 2, 2, 1234, 1236, 3, 2, 2, 2, 1235,
-	1237, 5, 92, 47, 2, 1236, 1235, 3, 2, 2, 2, 1236, 1237, 3, 2, 2, 2, 1237,
-	1238, 3, 2, 2, 2, 1238, 1239, 7, 5, 2, 2, 1239, 91, 3, 2, 2, 2, 1240, 1241,
-	9, 18, 2, 2, 1241, 1249, 5, 94, 48, 2, 1242, 1243, 9, 18, 2, 2, 1243, 1244,
-	7, 37, 2, 2, 1244, 1245, 5, 94, 48, 2, 1245, 1246, 7, 32, 2, 2, 1246, 1247,
-	5, 94, 48, 2, 1247, 1249, 3, 2, 2, 2, 1248, 1240, 3, 2, 2, 2, 1248, 1242,
-	3, 2, 2, 2, 1249, 93, 3, 2, 2, 2, 1250, 1251, 7, 92, 2, 2, 1251, 1258,
-	9, 19, 2, 2, 1252, 1253, 7, 95, 2, 2, 1253, 1258, 7, 96, 2, 2, 1254, 1255,
-	5, 58, 30, 2, 1255, 1256, 9, 19, 2, 2, 1256, 1258, 3, 2, 2, 2, 1257, 1250,
-	3, 2, 2, 2, 1257, 1252, 3, 2, 2, 2, 1257, 1254, 3, 2, 2, 2, 1258, 95, 3,
-	2, 2, 2, 1259, 1260, 7, 118, 2, 2, 1260, 1264, 9, 20, 2, 2, 1261, 1262,
-	7, 119, 2, 2, 1262, 1264, 9, 21, 2, 2, 1263, 1259, 3, 2, 2, 2, 1263, 1261,
-	3, 2, 2, 2, 1264, 97, 3, 2, 2, 2, 1265, 1266, 7, 160, 2, 2, 1266, 1267,
-	7, 161, 2, 2, 1267, 1271, 5, 100, 51, 2, 1268, 1269, 7, 166, 2, 2, 1269,
-	1271, 9, 22, 2, 2, 1270, 1265, 3, 2, 2, 2, 1270, 1268, 3, 2, 2, 2, 1271,
-	99, 3, 2, 2, 2, 1272, 1273, 7, 166, 2, 2, 1273, 1280, 7, 165, 2, 2, 1274,
-	1275, 7, 166, 2, 2, 1275, 1280, 7, 164, 2, 2, 1276, 1277, 7, 163, 2, 2,
-	1277, 1280, 7, 166, 2, 2, 1278, 1280, 7, 162, 2, 2, 1279, 1272, 3, 2, 2,
-	2, 1279, 1274, 3, 2, 2, 2, 1279, 1276, 3, 2, 2, 2, 1279, 1278, 3, 2, 2,
-	2, 1280, 101, 3, 2, 2, 2, 1281, 1287, 5, 58, 30, 2, 1282, 1283, 5, 108,
-	55, 2, 1283, 1284, 7, 10, 2, 2, 1284, 1285, 5, 58, 30, 2, 1285, 1287, 3,
-	2, 2, 2, 1286, 1281, 3, 2, 2, 2, 1286, 1282, 3, 2, 2, 2, 1287, 103, 3,
-	2, 2, 2, 1288, 1293, 7, 12, 2, 2, 1289, 1293, 7, 107, 2, 2, 1290, 1293,
-	7, 106, 2, 2, 1291, 1293, 5, 108, 55, 2, 1292, 1288, 3, 2, 2, 2, 1292,
-	1289, 3, 2, 2, 2, 1292, 1290, 3, 2, 2, 2, 1292, 1291, 3, 2, 2, 2, 1293,
-	105, 3, 2, 2, 2, 1294, 1299, 5, 108, 55, 2, 1295, 1296, 7, 203, 2, 2, 1296,
-	1298, 5, 108, 55, 2, 1297, 1295, 3, 2, 2, 2, 1298, 1301, 3, 2, 2, 2, 1299,
-	1297, 3, 2, 2, 2, 1299, 1300, 3, 2, 2, 2, 1300, 107, 3, 2, 2, 2, 1301,
-	1299, 3, 2, 2, 2, 1302, 1308, 7, 208, 2, 2, 1303, 1308, 7, 209, 2, 2, 1304,
-	1308, 7, 210, 2, 2, 1305, 1308, 7, 211, 2, 2, 1306, 1308, 5, 110, 56, 2,
-	1307, 1302, 3, 2, 2, 2, 1307, 1303, 3, 2, 2, 2, 1307, 1304, 3, 2, 2, 2,
-	1307, 1305, 3, 2, 2, 2, 1307, 1306, 3, 2, 2, 2, 1308, 109, 3, 2, 2, 2,
-	1309, 1310, 9, 23, 2, 2, 1310, 111, 3, 2, 2, 2, 162, 121, 130, 136, 143,
-	148, 157, 164, 173, 201, 206, 215, 226, 229, 239, 244, 248, 256, 262, 269,
-	274, 278, 286, 294, 299, 314, 318, 324, 328, 334, 362, 365, 369, 373, 381,
-	390, 393, 397, 415, 418, 426, 429, 435, 442, 447, 453, 459, 467, 484, 487,
-	491, 499, 504, 516, 523, 527, 531, 535, 542, 551, 554, 558, 563, 567, 570,
-	577, 588, 591, 601, 604, 615, 620, 628, 631, 635, 643, 646, 650, 654, 665,
-	668, 675, 682, 687, 691, 704, 715, 720, 729, 733, 737, 739, 747, 764, 770,
-	776, 780, 789, 796, 811, 819, 828, 834, 842, 848, 852, 857, 862, 868, 879,
-	881, 898, 914, 925, 935, 938, 943, 950, 953, 957, 960, 972, 993, 997, 1005,
-	1009, 1034, 1037, 1046, 1052, 1058, 1064, 1073, 1082, 1097, 1107, 1109,
-	1118, 1126, 1132, 1159, 1171, 1176, 1178, 1184, 1189, 1196, 1218, 1221,
-	1230, 1233, 1236, 1248, 1257, 1263, 1270, 1279, 1286, 1292, 1299, 1307,
+	10, 55, 3, 56, 3, 56, 3, 56, 3, 33, 5, 33, 1314, 10, 33, 2, 8, 22, 44,
+	60, 66, 68, 80, 57, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28,
+	30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64,
+	66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100,
+	102, 104, 106, 108, 110, 2, 24, 4, 2, 13, 13, 33, 33, 4, 2, 48, 48, 110,
+	110, 3, 2, 177, 178, 3, 2, 137, 139, 3, 2, 47, 48, 3, 2, 44, 45, 4, 2,
+	16, 16, 19, 19, 3, 2, 141, 143, 3, 2, 31, 32, 3, 2, 41, 42, 3, 2, 197,
+	198, 3, 2, 197, 201, 3, 2, 181, 184, 3, 2, 191, 196, 3, 2, 16, 18, 3, 2,
+	59, 64, 3, 2, 90, 91, 3, 2, 93, 94, 3, 2, 120, 121, 3, 2, 122, 124, 3,
+	2, 167, 168, 19, 2, 14, 14, 16, 18, 30, 30, 35, 35, 49, 50, 52, 65, 87,
+	91, 93, 96, 101, 101, 103, 105, 111, 124, 127, 135, 140, 144, 149, 169,
+	173, 179, 181, 187, 215, 215, 1518, 2, 112, 3, 2, 2, 2, 4, 426, 3, 2, 2, 2, 6, 429,
+	3, 2, 2, 2, 8, 433, 3, 2, 2, 2, 10, 447, 3, 2, 2, 2, 12, 449, 3, 2, 2,
+	2, 14, 455, 3, 2, 2, 2, 16, 461, 3, 2, 2, 2, 18, 472, 3, 2, 2, 2, 20, 476,
+	3, 2, 2, 2, 22, 493, 3, 2, 2, 2, 24, 523, 3, 2, 2, 2, 26, 525, 3, 2, 2,
+	2, 28, 533, 3, 2, 2, 2, 30, 570, 3, 2, 2, 2, 32, 620, 3, 2, 2, 2, 34, 635,
+	3, 2, 2, 2, 36, 650, 3, 2, 2, 2, 38, 652, 3, 2, 2, 2, 40, 661, 3, 2, 2,
+	2, 42, 675, 3, 2, 2, 2, 44, 677, 3, 2, 2, 2, 46, 704, 3, 2, 2, 2, 48, 720,
+	3, 2, 2, 2, 50, 722, 3, 2, 2, 2, 52, 731, 3, 2, 2, 2, 54, 741, 3, 2, 2,
+	2, 56, 776, 3, 2, 2, 2, 58, 780, 3, 2, 2, 2, 60, 789, 3, 2, 2, 2, 62, 799,
+	3, 2, 2, 2, 64, 862, 3, 2, 2, 2, 66, 868, 3, 2, 2, 2, 68, 1097, 3, 2, 2,
+	2, 70, 1118, 3, 2, 2, 2, 72, 1120, 3, 2, 2, 2, 74, 1122, 3, 2, 2, 2, 76,
+	1124, 3, 2, 2, 2, 78, 1134, 3, 2, 2, 2, 80, 1178, 3, 2, 2, 2, 82, 1189,
+	3, 2, 2, 2, 84, 1196, 3, 2, 2, 2, 86, 1198, 3, 2, 2, 2, 88, 1203, 3, 2,
+	2, 2, 90, 1209, 3, 2, 2, 2, 92, 1248, 3, 2, 2, 2, 94, 1257, 3, 2, 2, 2,
+	96, 1263, 3, 2, 2, 2, 98, 1270, 3, 2, 2, 2, 100, 1279, 3, 2, 2, 2, 102,
+	1286, 3, 2, 2, 2, 104, 1292, 3, 2, 2, 2, 106, 1294, 3, 2, 2, 2, 108, 1307,
+	3, 2, 2, 2, 110, 1309, 3, 2, 2, 2, 112, 113, 5, 4, 3, 2, 113, 114, 7, 11,
+	2, 2, 114, 3, 3, 2, 2, 2, 115, 427, 5, 6, 4, 2, 116, 117, 7, 100, 2, 2,
+	117, 121, 7, 102, 2, 2, 118, 119, 7, 185, 2, 2, 119, 120, 7, 34, 2, 2,
+	120, 122, 7, 36, 2, 2, 121, 118, 3, 2, 2, 2, 121, 122, 3, 2, 2, 2, 122,
+	123, 3, 2, 2, 2, 123, 124, 5, 106, 54, 2, 124, 125, 7, 3, 2, 2, 125, 130,
+	5, 10, 6, 2, 126, 127, 7, 4, 2, 2, 127, 129, 5, 10, 6, 2, 128, 126, 3,
+	2, 2, 2, 129, 132, 3, 2, 2, 2, 130, 128, 3, 2, 2, 2, 130, 131, 3, 2, 2,
+	2, 131, 133, 3, 2, 2, 2, 132, 130, 3, 2, 2, 2, 133, 136, 7, 5, 2, 2, 134,
+	135, 7, 97, 2, 2, 135, 137, 5, 16, 9, 2, 136, 134, 3, 2, 2, 2, 136, 137,
+	3, 2, 2, 2, 137, 427, 3, 2, 2, 2, 138, 139, 7, 100, 2, 2, 139, 143, 7,
+	102, 2, 2, 140, 141, 7, 185, 2, 2, 141, 142, 7, 34, 2, 2, 142, 144, 7,
+	36, 2, 2, 143, 140, 3, 2, 2, 2, 143, 144, 3, 2, 2, 2, 144, 145, 3, 2, 2,
+	2, 145, 148, 5, 106, 54, 2, 146, 147, 7, 97, 2, 2, 147, 149, 5, 16, 9,
+	2, 148, 146, 3, 2, 2, 2, 148, 149, 3, 2, 2, 2, 149, 150, 3, 2, 2, 2, 150,
+	151, 7, 15, 2, 2, 151, 152, 5, 6, 4, 2, 152, 427, 3, 2, 2, 2, 153, 154,
+	7, 136, 2, 2, 154, 157, 7, 102, 2, 2, 155, 156, 7, 185, 2, 2, 156, 158,
+	7, 36, 2, 2, 157, 155, 3, 2, 2, 2, 157, 158, 3, 2, 2, 2, 158, 159, 3, 2,
+	2, 2, 159, 427, 5, 106, 54, 2, 160, 161, 7, 106, 2, 2, 161, 162, 7, 108,
+	2, 2, 162, 164, 5, 106, 54, 2, 163, 165, 5, 54, 28, 2, 164, 163, 3, 2,
+	2, 2, 164, 165, 3, 2, 2, 2, 165, 166, 3, 2, 2, 2, 166, 167, 5, 6, 4, 2,
+	167, 427, 3, 2, 2, 2, 168, 169, 7, 107, 2, 2, 169, 170, 7, 13, 2, 2, 170,
+	173, 5, 106, 54, 2, 171, 172, 7, 20, 2, 2, 172, 174, 5, 60, 31, 2, 173,
+	171, 3, 2, 2, 2, 173, 174, 3, 2, 2, 2, 174, 427, 3, 2, 2, 2, 175, 176,
+	7, 145, 2, 2, 176, 177, 7, 102, 2, 2, 177, 178, 5, 106, 54, 2, 178, 179,
+	7, 146, 2, 2, 179, 180, 7, 140, 2, 2, 180, 181, 5, 106, 54, 2, 181, 427,
+	3, 2, 2, 2, 182, 183, 7, 145, 2, 2, 183, 184, 7, 102, 2, 2, 184, 185, 5,
+	106, 54, 2, 185, 186, 7, 146, 2, 2, 186, 187, 7, 132, 2, 2, 187, 188, 5,
+	108, 55, 2, 188, 189, 7, 140, 2, 2, 189, 190, 5, 108, 55, 2, 190, 427,
+	3, 2, 2, 2, 191, 192, 7, 145, 2, 2, 192, 193, 7, 102, 2, 2, 193, 194, 5,
+	106, 54, 2, 194, 195, 7, 14, 2, 2, 195, 196, 7, 132, 2, 2, 196, 197, 5,
+	12, 7, 2, 197, 427, 3, 2, 2, 2, 198, 201, 7, 100, 2, 2, 199, 200, 7, 31,
+	2, 2, 200, 202, 7, 105, 2, 2, 201, 199, 3, 2, 2, 2, 201, 202, 3, 2, 2,
+	2, 202, 203, 3, 2, 2, 2, 203, 204, 7, 104, 2, 2, 204, 206, 5, 106, 54,
+	2, 205, 207, 5, 54, 28, 2, 206, 205, 3, 2, 2, 2, 206, 207, 3, 2, 2, 2,
+	207, 208, 3, 2, 2, 2, 208, 209, 7, 15, 2, 2, 209, 210, 5, 6, 4, 2, 210,
+	427, 3, 2, 2, 2, 211, 212, 7, 136, 2, 2, 212, 215, 7, 104, 2, 2, 213, 214,
+	7, 185, 2, 2, 214, 216, 7, 36, 2, 2, 215, 213, 3, 2, 2, 2, 215, 216, 3,
+	2, 2, 2, 216, 217, 3, 2, 2, 2, 217, 427, 5, 106, 54, 2, 218, 219, 7, 169,
+	2, 2, 219, 220, 5, 106, 54, 2, 220, 229, 7, 3, 2, 2, 221, 226, 5, 102,
+	52, 2, 222, 223, 7, 4, 2, 2, 223, 225, 5, 102, 52, 2, 224, 222, 3, 2, 2,
+	2, 225, 228, 3, 2, 2, 2, 226, 224, 3, 2, 2, 2, 226, 227, 3, 2, 2, 2, 227,
+	230, 3, 2, 2, 2, 228, 226, 3, 2, 2, 2, 229, 221, 3, 2, 2, 2, 229, 230,
+	3, 2, 2, 2, 230, 231, 3, 2, 2, 2, 231, 232, 7, 5, 2, 2, 232, 427, 3, 2,
+	2, 2, 233, 244, 7, 111, 2, 2, 234, 239, 5, 104, 53, 2, 235, 236, 7, 4,
+	2, 2, 236, 238, 5, 104, 53, 2, 237, 235, 3, 2, 2, 2, 238, 241, 3, 2, 2,
+	2, 239, 237, 3, 2, 2, 2, 239, 240, 3, 2, 2, 2, 240, 245, 3, 2, 2, 2, 241,
+	239, 3, 2, 2, 2, 242, 243, 7, 16, 2, 2, 243, 245, 7, 113, 2, 2, 244, 234,
+	3, 2, 2, 2, 244, 242, 3, 2, 2, 2, 245, 246, 3, 2, 2, 2, 246, 248, 7, 86,
+	2, 2, 247, 249, 7, 102, 2, 2, 248, 247, 3, 2, 2, 2, 248, 249, 3, 2, 2,
+	2, 249, 250, 3, 2, 2, 2, 250, 251, 5, 106, 54, 2, 251, 252, 7, 140, 2,
+	2, 252, 256, 5, 108, 55, 2, 253, 254, 7, 97, 2, 2, 254, 255, 7, 111, 2,
+	2, 255, 257, 7, 115, 2, 2, 256, 253, 3, 2, 2, 2, 256, 257, 3, 2, 2, 2,
+	257, 427, 3, 2, 2, 2, 258, 262, 7, 112, 2, 2, 259, 260, 7, 111, 2, 2, 260,
+	261, 7, 115, 2, 2, 261, 263, 7, 51, 2, 2, 262, 259, 3, 2, 2, 2, 262, 263,
+	3, 2, 2, 2, 263, 274, 3, 2, 2, 2, 264, 269, 5, 104, 53, 2, 265, 266, 7,
+	4, 2, 2, 266, 268, 5, 104, 53, 2, 267, 265, 3, 2, 2, 2, 268, 271, 3, 2,
+	2, 2, 269, 267, 3, 2, 2, 2, 269, 270, 3, 2, 2, 2, 270, 275, 3, 2, 2, 2,
+	271, 269, 3, 2, 2, 2, 272, 273, 7, 16, 2, 2, 273, 275, 7, 113, 2, 2, 274,
+	264, 3, 2, 2, 2, 274, 272, 3, 2, 2, 2, 275, 276, 3, 2, 2, 2, 276, 278,
+	7, 86, 2, 2, 277, 279, 7, 102, 2, 2, 278, 277, 3, 2, 2, 2, 278, 279, 3,
+	2, 2, 2, 279, 280, 3, 2, 2, 2, 280, 281, 5, 106, 54, 2, 281, 282, 7, 13,
+	2, 2, 282, 283, 5, 108, 55, 2, 283, 427, 3, 2, 2, 2, 284, 286, 7, 116,
+	2, 2, 285, 287, 7, 117, 2, 2, 286, 285, 3, 2, 2, 2, 286, 287, 3, 2, 2,
+	2, 287, 299, 3, 2, 2, 2, 288, 289, 7, 3, 2, 2, 289, 294, 5, 96, 49, 2,
+	290, 291, 7, 4, 2, 2, 291, 293, 5, 96, 49, 2, 292, 290, 3, 2, 2, 2, 293,
+	296, 3, 2, 2, 2, 294, 292, 3, 2, 2, 2, 294, 295, 3, 2, 2, 2, 295, 297,
+	3, 2, 2, 2, 296, 294, 3, 2, 2, 2, 297, 298, 7, 5, 2, 2, 298, 300, 3, 2,
+	2, 2, 299, 288, 3, 2, 2, 2, 299, 300, 3, 2, 2, 2, 300, 301, 3, 2, 2, 2,
+	301, 427, 5, 4, 3, 2, 302, 303, 7, 127, 2, 2, 303, 304, 7, 100, 2, 2, 304,
+	305, 7, 102, 2, 2, 305, 427, 5, 106, 54, 2, 306, 307, 7, 127, 2, 2, 307,
+	308, 7, 100, 2, 2, 308, 309, 7, 104, 2, 2, 309, 427, 5, 106, 54, 2, 310,
+	311, 7, 127, 2, 2, 311, 314, 7, 128, 2, 2, 312, 313, 9, 2, 2, 2, 313, 315,
+	5, 106, 54, 2, 314, 312, 3, 2, 2, 2, 314, 315, 3, 2, 2, 2, 315, 318, 3,
+	2, 2, 2, 316, 317, 7, 38, 2, 2, 317, 319, 7, 204, 2, 2, 318, 316, 3, 2,
+	2, 2, 318, 319, 3, 2, 2, 2, 319, 427, 3, 2, 2, 2, 320, 321, 7, 127, 2,
+	2, 321, 324, 7, 129, 2, 2, 322, 323, 9, 2, 2, 2, 323, 325, 5, 108, 55,
+	2, 324, 322, 3, 2, 2, 2, 324, 325, 3, 2, 2, 2, 325, 328, 3, 2, 2, 2, 326,
+	327, 7, 38, 2, 2, 327, 329, 7, 204, 2, 2, 328, 326, 3, 2, 2, 2, 328, 329,
+	3, 2, 2, 2, 329, 427, 3, 2, 2, 2, 330, 331, 7, 127, 2, 2, 331, 334, 7,
+	130, 2, 2, 332, 333, 7, 38, 2, 2, 333, 335, 7, 204, 2, 2, 334, 332, 3,
+	2, 2, 2, 334, 335, 3, 2, 2, 2, 335, 427, 3, 2, 2, 2, 336, 337, 7, 127,
+	2, 2, 337, 338, 7, 131, 2, 2, 338, 339, 9, 2, 2, 2, 339, 427, 5, 106, 54,
+	2, 340, 341, 9, 3, 2, 2, 341, 427, 5, 106, 54, 2, 342, 343, 7, 127, 2,
+	2, 343, 427, 7, 135, 2, 2, 344, 345, 7, 127, 2, 2, 345, 427, 7, 153, 2,
+	2, 346, 347, 7, 151, 2, 2, 347, 348, 7, 153, 2, 2, 348, 349, 5, 106, 54,
+	2, 349, 350, 7, 191, 2, 2, 350, 351, 5, 58, 30, 2, 351, 427, 3, 2, 2, 2,
+	352, 353, 7, 152, 2, 2, 353, 354, 7, 153, 2, 2, 354, 427, 5, 106, 54, 2,
+	355, 356, 7, 155, 2, 2, 356, 365, 7, 156, 2, 2, 357, 362, 5, 98, 50, 2,
+	358, 359, 7, 4, 2, 2, 359, 361, 5, 98, 50, 2, 360, 358, 3, 2, 2, 2, 361,
+	364, 3, 2, 2, 2, 362, 360, 3, 2, 2, 2, 362, 363, 3, 2, 2, 2, 363, 366,
+	3, 2, 2, 2, 364, 362, 3, 2, 2, 2, 365, 357, 3, 2, 2, 2, 365, 366, 3, 2,
+	2, 2, 366, 427, 3, 2, 2, 2, 367, 369, 7, 157, 2, 2, 368, 370, 7, 159, 2,
+	2, 369, 368, 3, 2, 2, 2, 369, 370, 3, 2, 2, 2, 370, 427, 3, 2, 2, 2, 371,
+	373, 7, 158, 2, 2, 372, 374, 7, 159, 2, 2, 373, 372, 3, 2, 2, 2, 373, 374,
+	3, 2, 2, 2, 374, 427, 3, 2, 2, 2, 375, 376, 7, 127, 2, 2, 376, 377, 7,
+	134, 2, 2, 377, 378, 9, 2, 2, 2, 378, 381, 5, 106, 54, 2, 379, 380, 7,
+	20, 2, 2, 380, 382, 5, 60, 31, 2, 381, 379, 3, 2, 2, 2, 381, 382, 3, 2,
+	2, 2, 382, 393, 3, 2, 2, 2, 383, 384, 7, 27, 2, 2, 384, 385, 7, 22, 2,
+	2, 385, 390, 5, 26, 14, 2, 386, 387, 7, 4, 2, 2, 387, 389, 5, 26, 14, 2,
+	388, 386, 3, 2, 2, 2, 389, 392, 3, 2, 2, 2, 390, 388, 3, 2, 2, 2, 390,
+	391, 3, 2, 2, 2, 391, 394, 3, 2, 2, 2, 392, 390, 3, 2, 2, 2, 393, 383,
+	3, 2, 2, 2, 393, 394, 3, 2, 2, 2, 394, 397, 3, 2, 2, 2, 395, 396, 7, 29,
+	2, 2, 396, 398, 7, 206, 2, 2, 397, 395, 3, 2, 2, 2, 397, 398, 3, 2, 2,
+	2, 398, 427, 3, 2, 2, 2, 399, 400, 7, 170, 2, 2, 400, 401, 5, 108, 55,
+	2, 401, 402, 7, 13, 2, 2, 402, 403, 5, 4, 3, 2, 403, 427, 3, 2, 2, 2, 404,
+	405, 7, 171, 2, 2, 405, 406, 7, 170, 2, 2, 406, 427, 5, 108, 55, 2, 407,
+	408, 7, 172, 2, 2, 408, 418, 5, 108, 55, 2, 409, 410, 7, 85, 2, 2, 410,
+	415, 5, 58, 30, 2, 411, 412, 7, 4, 2, 2, 412, 414, 5, 58, 30, 2, 413, 411,
+	3, 2, 2, 2, 414, 417, 3, 2, 2, 2, 415, 413, 3, 2, 2, 2, 415, 416, 3, 2,
+	2, 2, 416, 419, 3, 2, 2, 2, 417, 415, 3, 2, 2, 2, 418, 409, 3, 2, 2, 2,
+	418, 419, 3, 2, 2, 2, 419, 427, 3, 2, 2, 2, 420, 421, 7, 110, 2, 2, 421,
+	422, 7, 173, 2, 2, 422, 427, 5, 108, 55, 2, 423, 424, 7, 110, 2, 2, 424,
+	425, 7, 174, 2, 2, 425, 427, 5, 108, 55, 2, 426, 115, 3, 2, 2, 2, 426,
+	116, 3, 2, 2, 2, 426, 138, 3, 2, 2, 2, 426, 153, 3, 2, 2, 2, 426, 160,
+	3, 2, 2, 2, 426, 168, 3, 2, 2, 2, 426, 175, 3, 2, 2, 2, 426, 182, 3, 2,
+	2, 2, 426, 191, 3, 2, 2, 2, 426, 198, 3, 2, 2, 2, 426, 211, 3, 2, 2, 2,
+	426, 218, 3, 2, 2, 2, 426, 233, 3, 2, 2, 2, 426, 258, 3, 2, 2, 2, 426,
+	284, 3, 2, 2, 2, 426, 302, 3, 2, 2, 2, 426, 306, 3, 2, 2, 2, 426, 310,
+	3, 2, 2, 2, 426, 320, 3, 2, 2, 2, 426, 330, 3, 2, 2, 2, 426, 336, 3, 2,
+	2, 2, 426, 340, 3, 2, 2, 2, 426, 342, 3, 2, 2, 2, 426, 344, 3, 2, 2, 2,
+	426, 346, 3, 2, 2, 2, 426, 352, 3, 2, 2, 2, 426, 355, 3, 2, 2, 2, 426,
+	367, 3, 2, 2, 2, 426, 371, 3, 2, 2, 2, 426, 375, 3, 2, 2, 2, 426, 399,
+	3, 2, 2, 2, 426, 404, 3, 2, 2, 2, 426, 407, 3, 2, 2, 2, 426, 420, 3, 2,
+	2, 2, 426, 423, 3, 2, 2, 2, 427, 5, 3, 2, 2, 2, 428, 430, 5, 8, 5, 2, 429,
+	428, 3, 2, 2, 2, 429, 430, 3, 2, 2, 2, 430, 431, 3, 2, 2, 2, 431, 432,
+	5, 20, 11, 2, 432, 7, 3, 2, 2, 2, 433, 435, 7, 97, 2, 2, 434, 436, 7, 98,
+	2, 2, 435, 434, 3, 2, 2, 2, 435, 436, 3, 2, 2, 2, 436, 437, 3, 2, 2, 2,
+	437, 442, 5, 38, 20, 2, 438, 439, 7, 4, 2, 2, 439, 441, 5, 38, 20, 2, 440,
+	438, 3, 2, 2, 2, 441, 444, 3, 2, 2, 2, 442, 440, 3, 2, 2, 2, 442, 443,
+	3, 2, 2, 2, 443, 9, 3, 2, 2, 2, 444, 442, 3, 2, 2, 2, 445, 448, 5, 12,
+	7, 2, 446, 448, 5, 14, 8, 2, 447, 445, 3, 2, 2, 2, 447, 446, 3, 2, 2, 2,
+	448, 11, 3, 2, 2, 2, 449, 450, 5, 108, 55, 2, 450, 453, 5, 80, 41, 2, 451,
+	452, 7, 103, 2, 2, 452, 454, 7, 204, 2, 2, 453, 451, 3, 2, 2, 2, 453, 454,
+	3, 2, 2, 2, 454, 13, 3, 2, 2, 2, 455, 456, 7, 38, 2, 2, 456, 459, 5, 106,
+	54, 2, 457, 458, 9, 4, 2, 2, 458, 460, 7, 179, 2, 2, 459, 457, 3, 2, 2,
+	2, 459, 460, 3, 2, 2, 2, 460, 15, 3, 2, 2, 2, 461, 462, 7, 3, 2, 2, 462,
+	467, 5, 18, 10, 2, 463, 464, 7, 4, 2, 2, 464, 466, 5, 18, 10, 2, 465, 463,
+	3, 2, 2, 2, 466, 469, 3, 2, 2, 2, 467, 465, 3, 2, 2, 2, 467, 468, 3, 2,
+	2, 2, 468, 470, 3, 2, 2, 2, 469, 467, 3, 2, 2, 2, 470, 471, 7, 5, 2, 2,
+	471, 17, 3, 2, 2, 2, 472, 473, 5, 108, 55, 2, 473, 474, 7, 191, 2, 2, 474,
+	475, 5, 58, 30, 2, 475, 19, 3, 2, 2, 2, 476, 487, 5, 22, 12, 2, 477, 478,
+	7, 27, 2, 2, 478, 479, 7, 22, 2, 2, 479, 484, 5, 26, 14, 2, 480, 481, 7,
+	4, 2, 2, 481, 483, 5, 26, 14, 2, 482, 480, 3, 2, 2, 2, 483, 486, 3, 2,
+	2, 2, 484, 482, 3, 2, 2, 2, 484, 485, 3, 2, 2, 2, 485, 488, 3, 2, 2, 2,
+	486, 484, 3, 2, 2, 2, 487, 477, 3, 2, 2, 2, 487, 488, 3, 2, 2, 2, 488,
+	491, 3, 2, 2, 2, 489, 490, 7, 29, 2, 2, 490, 492, 7, 206, 2, 2, 491, 489,
+	3, 2, 2, 2, 491, 492, 3, 2, 2, 2, 492, 21, 3, 2, 2, 2, 493, 494, 8, 12,
+	1, 2, 494, 495, 5, 24, 13, 2, 495, 504, 3, 2, 2, 2, 496, 497, 12, 3, 2,
+	2, 497, 499, 9, 5, 2, 2, 498, 500, 5, 40, 21, 2, 499, 498, 3, 2, 2, 2,
+	499, 500, 3, 2, 2, 2, 500, 501, 3, 2, 2, 2, 501, 503, 5, 22, 12, 4, 502,
+	496, 3, 2, 2, 2, 503, 506, 3, 2, 2, 2, 504, 502, 3, 2, 2, 2, 504, 505,
+	3, 2, 2, 2, 505, 23, 3, 2, 2, 2, 506, 504, 3, 2, 2, 2, 507, 524, 5, 28,
+	15, 2, 508, 509, 7, 102, 2, 2, 509, 524, 5, 106, 54, 2, 510, 511, 7, 99,
+	2, 2, 511, 516, 5, 58, 30, 2, 512, 513, 7, 4, 2, 2, 513, 515, 5, 58, 30,
+	2, 514, 512, 3, 2, 2, 2, 515, 518, 3, 2, 2, 2, 516, 514, 3, 2, 2, 2, 516,
+	517, 3, 2, 2, 2, 517, 524, 3, 2, 2, 2, 518, 516, 3, 2, 2, 2, 519, 520,
+	7, 3, 2, 2, 520, 521, 5, 20, 11, 2, 521, 522, 7, 5, 2, 2, 522, 524, 3,
+	2, 2, 2, 523, 507, 3, 2, 2, 2, 523, 508, 3, 2, 2, 2, 523, 510, 3, 2, 2,
+	2, 523, 519, 3, 2, 2, 2, 524, 25, 3, 2, 2, 2, 525, 527, 5, 58, 30, 2, 526,
+	528, 9, 6, 2, 2, 527, 526, 3, 2, 2, 2, 527, 528, 3, 2, 2, 2, 528, 531,
+	3, 2, 2, 2, 529, 530, 7, 43, 2, 2, 530, 532, 9, 7, 2, 2, 531, 529, 3, 2,
+	2, 2, 531, 532, 3, 2, 2, 2, 532, 27, 3, 2, 2, 2, 533, 535, 7, 12, 2, 2,
+	534, 536, 5, 40, 21, 2, 535, 534, 3, 2, 2, 2, 535, 536, 3, 2, 2, 2, 536,
+	537, 3, 2, 2, 2, 537, 542, 5, 42, 22, 2, 538, 539, 7, 4, 2, 2, 539, 541,
+	5, 42, 22, 2, 540, 538, 3, 2, 2, 2, 541, 544, 3, 2, 2, 2, 542, 540, 3,
+	2, 2, 2, 542, 543, 3, 2, 2, 2, 543, 554, 3, 2, 2, 2, 544, 542, 3, 2, 2,
+	2, 545, 546, 7, 13, 2, 2, 546, 551, 5, 44, 23, 2, 547, 548, 7, 4, 2, 2,
+	548, 550, 5, 44, 23, 2, 549, 547, 3, 2, 2, 2, 550, 553, 3, 2, 2, 2, 551,
+	549, 3, 2, 2, 2, 551, 552, 3, 2, 2, 2, 552, 555, 3, 2, 2, 2, 553, 551,
+	3, 2, 2, 2, 554, 545, 3, 2, 2, 2, 554, 555, 3, 2, 2, 2, 555, 558, 3, 2,
+	2, 2, 556, 557, 7, 20, 2, 2, 557, 559, 5, 60, 31, 2, 558, 556, 3, 2, 2,
+	2, 558, 559, 3, 2, 2, 2, 559, 563, 3, 2, 2, 2, 560, 561, 7, 21, 2, 2, 561,
+	562, 7, 22, 2, 2, 562, 564, 5, 30, 16, 2, 563, 560, 3, 2, 2, 2, 563, 564,
+	3, 2, 2, 2, 564, 567, 3, 2, 2, 2, 565, 566, 7, 28, 2, 2, 566, 568, 5, 60,
+	31, 2, 567, 565, 3, 2, 2, 2, 567, 568, 3, 2, 2, 2, 568, 29, 3, 2, 2, 2,
+	569, 571, 5, 40, 21, 2, 570, 569, 3, 2, 2, 2, 570, 571, 3, 2, 2, 2, 571,
+	572, 3, 2, 2, 2, 572, 577, 5, 32, 17, 2, 573, 574, 7, 4, 2, 2, 574, 576,
+	5, 32, 17, 2, 575, 573, 3, 2, 2, 2, 576, 579, 3, 2, 2, 2, 577, 575, 3,
+	2, 2, 2, 577, 578, 3, 2, 2, 2, 578, 31, 3, 2, 2, 2, 579, 577, 3, 2, 2,
+	2, 580, 621, 5, 34, 18, 2, 581, 582, 7, 26, 2, 2, 582, 591, 7, 3, 2, 2,
+	583, 588, 5, 106, 54, 2, 584, 585, 7, 4, 2, 2, 585, 587, 5, 106, 54, 2,
+	586, 584, 3, 2, 2, 2, 587, 590, 3, 2, 2, 2, 588, 586, 3, 2, 2, 2, 588,
+	589, 3, 2, 2, 2, 589, 592, 3, 2, 2, 2, 590, 588, 3, 2, 2, 2, 591, 583,
+	3, 2, 2, 2, 591, 592, 3, 2, 2, 2, 592, 593, 3, 2, 2, 2, 593, 621, 7, 5,
+	2, 2, 594, 595, 7, 25, 2, 2, 595, 604, 7, 3, 2, 2, 596, 601, 5, 106, 54,
+	2, 597, 598, 7, 4, 2, 2, 598, 600, 5, 106, 54, 2, 599, 597, 3, 2, 2, 2,
+	600, 603, 3, 2, 2, 2, 601, 599, 3, 2, 2, 2, 601, 602, 3, 2, 2, 2, 602,
+	605, 3, 2, 2, 2, 603, 601, 3, 2, 2, 2, 604, 596, 3, 2, 2, 2, 604, 605,
+	3, 2, 2, 2, 605, 606, 3, 2, 2, 2, 606, 621, 7, 5, 2, 2, 607, 608, 7, 23,
+	2, 2, 608, 609, 7, 24, 2, 2, 609, 610, 7, 3, 2, 2, 610, 615, 5, 36, 19,
+	2, 611, 612, 7, 4, 2, 2, 612, 614, 5, 36, 19, 2, 613, 611, 3, 2, 2, 2,
+	614, 617, 3, 2, 2, 2, 615, 613, 3, 2, 2, 2, 615, 616, 3, 2, 2, 2, 616,
+	618, 3, 2, 2, 2, 617, 615, 3, 2, 2, 2, 618, 619, 7, 5, 2, 2, 619, 621,
+	3, 2, 2, 2, 620, 580, 3, 2, 2, 2, 620, 581, 3, 2, 2, 2, 620, 594, 3, 2,
+	2, 2, 620, 607, 3, 2, 2, 2, 621, 33, 3, 2, 2, 2, 622, 631, 7, 3, 2, 2,
+	623, 628, 5, 58, 30, 2, 624, 625, 7, 4, 2, 2, 625, 627, 5, 58, 30, 2, 626,
+	624, 3, 2, 2, 2, 627, 630, 3, 2, 2, 2, 628, 626, 3, 2, 2, 2, 628, 629,
+	3, 2, 2, 2, 629, 632, 3, 2, 2, 2, 630, 628, 3, 2, 2, 2, 631, 623, 3, 2,
+	2, 2, 631, 632, 3, 2, 2, 2, 632, 633, 3, 2, 2, 2, 633, 636, 7, 5, 2, 2,
+	634, 636, 5, 58, 30, 2, 635, 622, 3, 2, 2, 2, 635, 634, 3, 2, 2, 2, 636,
+	35, 3, 2, 2, 2, 637, 646, 7, 3, 2, 2, 638, 643, 5, 106, 54, 2, 639, 640,
+	7, 4, 2, 2, 640, 642, 5, 106, 54, 2, 641, 639, 3, 2, 2, 2, 642, 645, 3,
+	2, 2, 2, 643, 641, 3, 2, 2, 2, 643, 644, 3, 2, 2, 2, 644, 647, 3, 2, 2,
+	2, 645, 643, 3, 2, 2, 2, 646, 638, 3, 2, 2, 2, 646, 647, 3, 2, 2, 2, 647,
+	648, 3, 2, 2, 2, 648, 651, 7, 5, 2, 2, 649, 651, 5, 106, 54, 2, 650, 637,
+	3, 2, 2, 2, 650, 649, 3, 2, 2, 2, 651, 37, 3, 2, 2, 2, 652, 654, 5, 108,
+	55, 2, 653, 655, 5, 54, 28, 2, 654, 653, 3, 2, 2, 2, 654, 655, 3, 2, 2,
+	2, 655, 656, 3, 2, 2, 2, 656, 657, 7, 15, 2, 2, 657, 658, 7, 3, 2, 2, 658,
+	659, 5, 6, 4, 2, 659, 660, 7, 5, 2, 2, 660, 39, 3, 2, 2, 2, 661, 662, 9,
+	8, 2, 2, 662, 41, 3, 2, 2, 2, 663, 668, 5, 58, 30, 2, 664, 666, 7, 15,
+	2, 2, 665, 664, 3, 2, 2, 2, 665, 666, 3, 2, 2, 2, 666, 667, 3, 2, 2, 2,
+	667, 669, 5, 108, 55, 2, 668, 665, 3, 2, 2, 2, 668, 669, 3, 2, 2, 2, 669,
+	676, 3, 2, 2, 2, 670, 671, 5, 106, 54, 2, 671, 672, 7, 203, 2, 2, 672,
+	673, 7, 199, 2, 2, 673, 676, 3, 2, 2, 2, 674, 676, 7, 199, 2, 2, 675, 663,
+	3, 2, 2, 2, 675, 670, 3, 2, 2, 2, 675, 674, 3, 2, 2, 2, 676, 43, 3, 2,
+	2, 2, 677, 678, 8, 23, 1, 2, 678, 679, 5, 50, 26, 2, 679, 691, 3, 2, 2,
+	2, 680, 682, 12, 4, 2, 2, 681, 683, 5, 46, 24, 2, 682, 681, 3, 2, 2, 2,
+	682, 683, 3, 2, 2, 2, 683, 684, 3, 2, 2, 2, 684, 685, 7, 77, 2, 2, 685,
+	687, 5, 44, 23, 2, 686, 688, 5, 48, 25, 2, 687, 686, 3, 2, 2, 2, 687, 688,
+	3, 2, 2, 2, 688, 690, 3, 2, 2, 2, 689, 680, 3, 2, 2, 2, 690, 693, 3, 2,
+	2, 2, 691, 689, 3, 2, 2, 2, 691, 692, 3, 2, 2, 2, 692, 45, 3, 2, 2, 2,
+	693, 691, 3, 2, 2, 2, 694, 705, 7, 80, 2, 2, 695, 696, 7, 81, 2, 2, 696,
+	705, 7, 79, 2, 2, 697, 698, 7, 82, 2, 2, 698, 705, 7, 79, 2, 2, 699, 700,
+	7, 83, 2, 2, 700, 705, 7, 79, 2, 2, 701, 705, 7, 78, 2, 2, 702, 705, 7,
+	84, 2, 2, 703, 705, 7, 81, 2, 2, 704, 694, 3, 2, 2, 2, 704, 695, 3, 2,
+	2, 2, 704, 697, 3, 2, 2, 2, 704, 699, 3, 2, 2, 2, 704, 701, 3, 2, 2, 2,
+	704, 702, 3, 2, 2, 2, 704, 703, 3, 2, 2, 2, 705, 47, 3, 2, 2, 2, 706, 707,
+	7, 86, 2, 2, 707, 721, 5, 60, 31, 2, 708, 709, 7, 85, 2, 2, 709, 710, 7,
+	3, 2, 2, 710, 715, 5, 108, 55, 2, 711, 712, 7, 4, 2, 2, 712, 714, 5, 108,
+	55, 2, 713, 711, 3, 2, 2, 2, 714, 717, 3, 2, 2, 2, 715, 713, 3, 2, 2, 2,
+	715, 716, 3, 2, 2, 2, 716, 718, 3, 2, 2, 2, 717, 715, 3, 2, 2, 2, 718,
+	719, 7, 5, 2, 2, 719, 721, 3, 2, 2, 2, 720, 706, 3, 2, 2, 2, 720, 708,
+	3, 2, 2, 2, 721, 49, 3, 2, 2, 2, 722, 729, 5, 52, 27, 2, 723, 724, 7, 144,
+	2, 2, 724, 725, 9, 9, 2, 2, 725, 726, 7, 3, 2, 2, 726, 727, 5, 58, 30,
+	2, 727, 728, 7, 5, 2, 2, 728, 730, 3, 2, 2, 2, 729, 723, 3, 2, 2, 2, 729,
+	730, 3, 2, 2, 2, 730, 51, 3, 2, 2, 2, 731, 739, 5, 56, 29, 2, 732, 734,
+	7, 15, 2, 2, 733, 732, 3, 2, 2, 2, 733, 734, 3, 2, 2, 2, 734, 735, 3, 2,
+	2, 2, 735, 737, 5, 108, 55, 2, 736, 738, 5, 54, 28, 2, 737, 736, 3, 2,
+	2, 2, 737, 738, 3, 2, 2, 2, 738, 740, 3, 2, 2, 2, 739, 733, 3, 2, 2, 2,
+	739, 740, 3, 2, 2, 2, 740, 53, 3, 2, 2, 2, 741, 742, 7, 3, 2, 2, 742, 747,
+	5, 108, 55, 2, 743, 744, 7, 4, 2, 2, 744, 746, 5, 108, 55, 2, 745, 743,
+	3, 2, 2, 2, 746, 749, 3, 2, 2, 2, 747, 745, 3, 2, 2, 2, 747, 748, 3, 2,
+	2, 2, 748, 750, 3, 2, 2, 2, 749, 747, 3, 2, 2, 2, 750, 751, 7, 5, 2, 2,
+	751, 55, 3, 2, 2, 2, 752, 777, 5, 106, 54, 2, 753, 754, 7, 3, 2, 2, 754,
+	755, 5, 6, 4, 2, 755, 756, 7, 5, 2, 2, 756, 777, 3, 2, 2, 2, 757, 758,
+	7, 147, 2, 2, 758, 759, 7, 3, 2, 2, 759, 764, 5, 58, 30, 2, 760, 761, 7,
+	4, 2, 2, 761, 763, 5, 58, 30, 2, 762, 760, 3, 2, 2, 2, 763, 766, 3, 2,
+	2, 2, 764, 762, 3, 2, 2, 2, 764, 765, 3, 2, 2, 2, 765, 767, 3, 2, 2, 2,
+	766, 764, 3, 2, 2, 2, 767, 770, 7, 5, 2, 2, 768, 769, 7, 97, 2, 2, 769,
+	771, 7, 148, 2, 2, 770, 768, 3, 2, 2, 2, 770, 771, 3, 2, 2, 2, 771, 777,
+	3, 2, 2, 2, 772, 773, 7, 3, 2, 2, 773, 774, 5, 44, 23, 2, 774, 775, 7,
+	5, 2, 2, 775, 777, 3, 2, 2, 2, 776, 752, 3, 2, 2, 2, 776, 753, 3, 2, 2,
+	2, 776, 757, 3, 2, 2, 2, 776, 772, 3, 2, 2, 2, 777, 57, 3, 2, 2, 2, 778,
+	781, 5, 60, 31, 2, 779, 781, 5, 66, 34, 2, 780, 778, 3, 2, 2, 2, 780, 779,
+	3, 2, 2, 2, 781, 59, 3, 2, 2, 2, 782, 783, 8, 31, 1, 2, 783, 784, 5, 66,
+	34, 2, 784, 785, 5, 64, 33, 2, 785, 790, 3, 2, 2, 2, 786, 787, 7, 34, 2,
+	2, 787, 790, 5, 60, 31, 5, 788, 790, 5, 62, 32, 2, 789, 782, 3, 2, 2, 2,
+	789, 786, 3, 2, 2, 2, 789, 788, 3, 2, 2, 2, 790, 796, 3, 2, 2, 2, 791,
+	792, 12, 4, 2, 2, 792, 793, 9, 10, 2, 2, 793, 795, 5, 58, 30, 2, 794, 791,
+	3, 2, 2, 2, 795, 798, 3, 2, 2, 2, 796, 794, 3, 2, 2, 2, 796, 797, 3, 2,
+	2, 2, 797, 61, 3, 2, 2, 2, 798, 796, 3, 2, 2, 2, 799, 800, 9, 11, 2, 2,
+	800, 63, 3, 2, 2, 2, 801, 802, 5, 72, 37, 2, 802, 803, 5, 66, 34, 2, 803,
+	863, 3, 2, 2, 2, 804, 805, 5, 72, 37, 2, 805, 806, 5, 74, 38, 2, 806, 807,
+	7, 3, 2, 2, 807, 808, 5, 6, 4, 2, 808, 809, 7, 5, 2, 2, 809, 863, 3, 2,
+	2, 2, 810, 812, 7, 34, 2, 2, 811, 810, 3, 2, 2, 2, 811, 812, 3, 2, 2, 2,
+	812, 813, 3, 2, 2, 2, 813, 1313, 7, 37, 2, 2, 814, 815, 5, 66, 34, 2, 815,
+	816, 7, 32, 2, 2, 816, 817, 5, 66, 34, 2, 817, 863, 3, 2, 2, 2, 818, 820,
+	7, 34, 2, 2, 819, 818, 3, 2, 2, 2, 819, 820, 3, 2, 2, 2, 820, 821, 3, 2,
+	2, 2, 821, 822, 7, 33, 2, 2, 822, 823, 7, 3, 2, 2, 823, 828, 5, 66, 34,
+	2, 824, 825, 7, 4, 2, 2, 825, 827, 5, 66, 34, 2, 826, 824, 3, 2, 2, 2,
+	827, 830, 3, 2, 2, 2, 828, 826, 3, 2, 2, 2, 828, 829, 3, 2, 2, 2, 829,
+	831, 3, 2, 2, 2, 830, 828, 3, 2, 2, 2, 831, 832, 7, 5, 2, 2, 832, 863,
+	3, 2, 2, 2, 833, 835, 7, 34, 2, 2, 834, 833, 3, 2, 2, 2, 834, 835, 3, 2,
+	2, 2, 835, 836, 3, 2, 2, 2, 836, 837, 7, 33, 2, 2, 837, 838, 7, 3, 2, 2,
+	838, 839, 5, 6, 4, 2, 839, 840, 7, 5, 2, 2, 840, 863, 3, 2, 2, 2, 841,
+	843, 7, 34, 2, 2, 842, 841, 3, 2, 2, 2, 842, 843, 3, 2, 2, 2, 843, 844,
+	3, 2, 2, 2, 844, 845, 7, 38, 2, 2, 845, 848, 5, 66, 34, 2, 846, 847, 7,
+	46, 2, 2, 847, 849, 5, 66, 34, 2, 848, 846, 3, 2, 2, 2, 848, 849, 3, 2,
+	2, 2, 849, 863, 3, 2, 2, 2, 850, 852, 7, 39, 2, 2, 851, 853, 7, 34, 2,
+	2, 852, 851, 3, 2, 2, 2, 852, 853, 3, 2, 2, 2, 853, 854, 3, 2, 2, 2, 854,
+	863, 7, 40, 2, 2, 855, 857, 7, 39, 2, 2, 856, 858, 7, 34, 2, 2, 857, 856,
+	3, 2, 2, 2, 857, 858, 3, 2, 2, 2, 858, 859, 3, 2, 2, 2, 859, 860, 7, 19,
+	2, 2, 860, 861, 7, 13, 2, 2, 861, 863, 5, 66, 34, 2, 862, 801, 3, 2, 2,
+	2, 862, 804, 3, 2, 2, 2, 862, 811, 3, 2, 2, 2, 862, 819, 3, 2, 2, 2, 862,
+	834, 3, 2, 2, 2, 862, 842, 3, 2, 2, 2, 862, 850, 3, 2, 2, 2, 862, 855,
+	3, 2, 2, 2, 863, 65, 3, 2, 2, 2, 864, 865, 8, 34, 1, 2, 865, 869, 5, 68,
+	35, 2, 866, 867, 9, 12, 2, 2, 867, 869, 5, 66, 34, 5, 868, 864, 3, 2, 2,
+	2, 868, 866, 3, 2, 2, 2, 869, 881, 3, 2, 2, 2, 870, 871, 12, 4, 2, 2, 871,
+	872, 9, 13, 2, 2, 872, 880, 5, 66, 34, 5, 873, 874, 12, 3, 2, 2, 874, 875,
+	7, 202, 2, 2, 875, 880, 5, 66, 34, 4, 876, 877, 12, 6, 2, 2, 877, 878,
+	7, 30, 2, 2, 878, 880, 5, 70, 36, 2, 879, 870, 3, 2, 2, 2, 879, 873, 3,
+	2, 2, 2, 879, 876, 3, 2, 2, 2, 880, 883, 3, 2, 2, 2, 881, 879, 3, 2, 2,
+	2, 881, 882, 3, 2, 2, 2, 882, 67, 3, 2, 2, 2, 883, 881, 3, 2, 2, 2, 884,
+	885, 8, 35, 1, 2, 885, 1098, 7, 40, 2, 2, 886, 1098, 7, 204, 2, 2, 887,
+	1098, 7, 205, 2, 2, 888, 1098, 7, 207, 2, 2, 889, 1098, 7, 206, 2, 2, 890,
+	1098, 5, 62, 32, 2, 891, 892, 5, 84, 43, 2, 892, 893, 7, 204, 2, 2, 893,
+	1098, 3, 2, 2, 2, 894, 1098, 5, 76, 39, 2, 895, 1098, 7, 6, 2, 2, 896,
+	899, 5, 108, 55, 2, 897, 899, 7, 190, 2, 2, 898, 896, 3, 2, 2, 2, 898,
+	897, 3, 2, 2, 2, 899, 900, 3, 2, 2, 2, 900, 1098, 7, 204, 2, 2, 901, 902,
+	7, 50, 2, 2, 902, 903, 7, 3, 2, 2, 903, 904, 5, 66, 34, 2, 904, 905, 7,
+	33, 2, 2, 905, 906, 5, 66, 34, 2, 906, 907, 7, 5, 2, 2, 907, 1098, 3, 2,
+	2, 2, 908, 909, 7, 3, 2, 2, 909, 912, 5, 58, 30, 2, 910, 911, 7, 4, 2,
+	2, 911, 913, 5, 58, 30, 2, 912, 910, 3, 2, 2, 2, 913, 914, 3, 2, 2, 2,
+	914, 912, 3, 2, 2, 2, 914, 915, 3, 2, 2, 2, 915, 916, 3, 2, 2, 2, 916,
+	917, 7, 5, 2, 2, 917, 1098, 3, 2, 2, 2, 918, 919, 7, 96, 2, 2, 919, 920,
+	7, 3, 2, 2, 920, 925, 5, 58, 30, 2, 921, 922, 7, 4, 2, 2, 922, 924, 5,
+	58, 30, 2, 923, 921, 3, 2, 2, 2, 924, 927, 3, 2, 2, 2, 925, 923, 3, 2,
+	2, 2, 925, 926, 3, 2, 2, 2, 926, 928, 3, 2, 2, 2, 927, 925, 3, 2, 2, 2,
+	928, 929, 7, 5, 2, 2, 929, 1098, 3, 2, 2, 2, 930, 931, 5, 106, 54, 2, 931,
+	932, 7, 3, 2, 2, 932, 933, 7, 199, 2, 2, 933, 935, 7, 5, 2, 2, 934, 936,
+	5, 88, 45, 2, 935, 934, 3, 2, 2, 2, 935, 936, 3, 2, 2, 2, 936, 938, 3,
+	2, 2, 2, 937, 939, 5, 90, 46, 2, 938, 937, 3, 2, 2, 2, 938, 939, 3, 2,
+	2, 2, 939, 1098, 3, 2, 2, 2, 940, 941, 5, 106, 54, 2, 941, 953, 7, 3, 2,
+	2, 942, 944, 5, 40, 21, 2, 943, 942, 3, 2, 2, 2, 943, 944, 3, 2, 2, 2,
+	944, 945, 3, 2, 2, 2, 945, 950, 5, 58, 30, 2, 946, 947, 7, 4, 2, 2, 947,
+	949, 5, 58, 30, 2, 948, 946, 3, 2, 2, 2, 949, 952, 3, 2, 2, 2, 950, 948,
+	3, 2, 2, 2, 950, 951, 3, 2, 2, 2, 951, 954, 3, 2, 2, 2, 952, 950, 3, 2,
+	2, 2, 953, 943, 3, 2, 2, 2, 953, 954, 3, 2, 2, 2, 954, 955, 3, 2, 2, 2,
+	955, 957, 7, 5, 2, 2, 956, 958, 5, 88, 45, 2, 957, 956, 3, 2, 2, 2, 957,
+	958, 3, 2, 2, 2, 958, 960, 3, 2, 2, 2, 959, 961, 5, 90, 46, 2, 960, 959,
+	3, 2, 2, 2, 960, 961, 3, 2, 2, 2, 961, 1098, 3, 2, 2, 2, 962, 963, 5, 108,
+	55, 2, 963, 964, 7, 7, 2, 2, 964, 965, 5, 58, 30, 2, 965, 1098, 3, 2, 2,
+	2, 966, 967, 7, 3, 2, 2, 967, 972, 5, 108, 55, 2, 968, 969, 7, 4, 2, 2,
+	969, 971, 5, 108, 55, 2, 970, 968, 3, 2, 2, 2, 971, 974, 3, 2, 2, 2, 972,
+	970, 3, 2, 2, 2, 972, 973, 3, 2, 2, 2, 973, 975, 3, 2, 2, 2, 974, 972,
+	3, 2, 2, 2, 975, 976, 7, 5, 2, 2, 976, 977, 7, 7, 2, 2, 977, 978, 5, 58,
+	30, 2, 978, 1098, 3, 2, 2, 2, 979, 980, 7, 3, 2, 2, 980, 981, 5, 6, 4,
+	2, 981, 982, 7, 5, 2, 2, 982, 1098, 3, 2, 2, 2, 983, 984, 7, 36, 2, 2,
+	984, 985, 7, 3, 2, 2, 985, 986, 5, 6, 4, 2, 986, 987, 7, 5, 2, 2, 987,
+	1098, 3, 2, 2, 2, 988, 989, 7, 72, 2, 2, 989, 991, 5, 66, 34, 2, 990, 992,
+	5, 86, 44, 2, 991, 990, 3, 2, 2, 2, 992, 993, 3, 2, 2, 2, 993, 991, 3,
+	2, 2, 2, 993, 994, 3, 2, 2, 2, 994, 997, 3, 2, 2, 2, 995, 996, 7, 75, 2,
+	2, 996, 998, 5, 58, 30, 2, 997, 995, 3, 2, 2, 2, 997, 998, 3, 2, 2, 2,
+	998, 999, 3, 2, 2, 2, 999, 1000, 7, 76, 2, 2, 1000, 1098, 3, 2, 2, 2, 1001,
+	1003, 7, 72, 2, 2, 1002, 1004, 5, 86, 44, 2, 1003, 1002, 3, 2, 2, 2, 1004,
+	1005, 3, 2, 2, 2, 1005, 1003, 3, 2, 2, 2, 1005, 1006, 3, 2, 2, 2, 1006,
+	1009, 3, 2, 2, 2, 1007, 1008, 7, 75, 2, 2, 1008, 1010, 5, 58, 30, 2, 1009,
+	1007, 3, 2, 2, 2, 1009, 1010, 3, 2, 2, 2, 1010, 1011, 3, 2, 2, 2, 1011,
+	1012, 7, 76, 2, 2, 1012, 1098, 3, 2, 2, 2, 1013, 1014, 7, 125, 2, 2, 1014,
+	1015, 7, 3, 2, 2, 1015, 1016, 5, 58, 30, 2, 1016, 1017, 7, 15, 2, 2, 1017,
+	1018, 5, 80, 41, 2, 1018, 1019, 7, 5, 2, 2, 1019, 1098, 3, 2, 2, 2, 1020,
+	1021, 7, 126, 2, 2, 1021, 1022, 7, 3, 2, 2, 1022, 1023, 5, 58, 30, 2, 1023,
+	1024, 7, 15, 2, 2, 1024, 1025, 5, 80, 41, 2, 1025, 1026, 7, 5, 2, 2, 1026,
+	1098, 3, 2, 2, 2, 1027, 1028, 7, 149, 2, 2, 1028, 1037, 7, 8, 2, 2, 1029,
+	1034, 5, 58, 30, 2, 1030, 1031, 7, 4, 2, 2, 1031, 1033, 5, 58, 30, 2, 1032,
+	1030, 3, 2, 2, 2, 1033, 1036, 3, 2, 2, 2, 1034, 1032, 3, 2, 2, 2, 1034,
+	1035, 3, 2, 2, 2, 1035, 1038, 3, 2, 2, 2, 1036, 1034, 3, 2, 2, 2, 1037,
+	1029, 3, 2, 2, 2, 1037, 1038, 3, 2, 2, 2, 1038, 1039, 3, 2, 2, 2, 1039,
+	1098, 7, 9, 2, 2, 1040, 1098, 5, 108, 55, 2, 1041, 1098, 7, 66, 2, 2, 1042,
+	1046, 7, 67, 2, 2, 1043, 1044, 7, 3, 2, 2, 1044, 1045, 7, 206, 2, 2, 1045,
+	1047, 7, 5, 2, 2, 1046, 1043, 3, 2, 2, 2, 1046, 1047, 3, 2, 2, 2, 1047,
+	1098, 3, 2, 2, 2, 1048, 1052, 7, 68, 2, 2, 1049, 1050, 7, 3, 2, 2, 1050,
+	1051, 7, 206, 2, 2, 1051, 1053, 7, 5, 2, 2, 1052, 1049, 3, 2, 2, 2, 1052,
+	1053, 3, 2, 2, 2, 1053, 1098, 3, 2, 2, 2, 1054, 1058, 7, 69, 2, 2, 1055,
+	1056, 7, 3, 2, 2, 1056, 1057, 7, 206, 2, 2, 1057, 1059, 7, 5, 2, 2, 1058,
+	1055, 3, 2, 2, 2, 1058, 1059, 3, 2, 2, 2, 1059, 1098, 3, 2, 2, 2, 1060,
+	1064, 7, 70, 2, 2, 1061, 1062, 7, 3, 2, 2, 1062, 1063, 7, 206, 2, 2, 1063,
+	1065, 7, 5, 2, 2, 1064, 1061, 3, 2, 2, 2, 1064, 1065, 3, 2, 2, 2, 1065,
+	1098, 3, 2, 2, 2, 1066, 1067, 7, 49, 2, 2, 1067, 1068, 7, 3, 2, 2, 1068,
+	1069, 5, 66, 34, 2, 1069, 1070, 7, 13, 2, 2, 1070, 1073, 5, 66, 34, 2,
+	1071, 1072, 7, 51, 2, 2, 1072, 1074, 5, 66, 34, 2, 1073, 1071, 3, 2, 2,
+	2, 1073, 1074, 3, 2, 2, 2, 1074, 1075, 3, 2, 2, 2, 1075, 1076, 7, 5, 2,
+	2, 1076, 1098, 3, 2, 2, 2, 1077, 1078, 7, 180, 2, 2, 1078, 1079, 7, 3,
+	2, 2, 1079, 1082, 5, 66, 34, 2, 1080, 1081, 7, 4, 2, 2, 1081, 1083, 9,
+	14, 2, 2, 1082, 1080, 3, 2, 2, 2, 1082, 1083, 3, 2, 2, 2, 1083, 1084, 3,
+	2, 2, 2, 1084, 1085, 7, 5, 2, 2, 1085, 1098, 3, 2, 2, 2, 1086, 1087, 7,
+	71, 2, 2, 1087, 1088, 7, 3, 2, 2, 1088, 1089, 5, 108, 55, 2, 1089, 1090,
+	7, 13, 2, 2, 1090, 1091, 5, 66, 34, 2, 1091, 1092, 7, 5, 2, 2, 1092, 1098,
+	3, 2, 2, 2, 1093, 1094, 7, 3, 2, 2, 1094, 1095, 5, 58, 30, 2, 1095, 1096,
+	7, 5, 2, 2, 1096, 1098, 3, 2, 2, 2, 1097, 884, 3, 2, 2, 2, 1097, 886, 3,
+	2, 2, 2, 1097, 887, 3, 2, 2, 2, 1097, 888, 3, 2, 2, 2, 1097, 889, 3, 2,
+	2, 2, 1097, 890, 3, 2, 2, 2, 1097, 891, 3, 2, 2, 2, 1097, 894, 3, 2, 2,
+	2, 1097, 895, 3, 2, 2, 2, 1097, 898, 3, 2, 2, 2, 1097, 901, 3, 2, 2, 2,
+	1097, 908, 3, 2, 2, 2, 1097, 918, 3, 2, 2, 2, 1097, 930, 3, 2, 2, 2, 1097,
+	940, 3, 2, 2, 2, 1097, 962, 3, 2, 2, 2, 1097, 966, 3, 2, 2, 2, 1097, 979,
+	3, 2, 2, 2, 1097, 983, 3, 2, 2, 2, 1097, 988, 3, 2, 2, 2, 1097, 1001, 3,
+	2, 2, 2, 1097, 1013, 3, 2, 2, 2, 1097, 1020, 3, 2, 2, 2, 1097, 1027, 3,
+	2, 2, 2, 1097, 1040, 3, 2, 2, 2, 1097, 1041, 3, 2, 2, 2, 1097, 1042, 3,
+	2, 2, 2, 1097, 1048, 3, 2, 2, 2, 1097, 1054, 3, 2, 2, 2, 1097, 1060, 3,
+	2, 2, 2, 1097, 1066, 3, 2, 2, 2, 1097, 1077, 3, 2, 2, 2, 1097, 1086, 3,
+	2, 2, 2, 1097, 1093, 3, 2, 2, 2, 1098, 1109, 3, 2, 2, 2, 1099, 1100, 12,
+	14, 2, 2, 1100, 1101, 7, 8, 2, 2, 1101, 1102, 5, 66, 34, 2, 1102, 1103,
+	7, 9, 2, 2, 1103, 1108, 3, 2, 2, 2, 1104, 1105, 12, 12, 2, 2, 1105, 1106,
+	7, 203, 2, 2, 1106, 1108, 5, 108, 55, 2, 1107, 1099, 3, 2, 2, 2, 1107,
+	1104, 3, 2, 2, 2, 1108, 1111, 3, 2, 2, 2, 1109, 1107, 3, 2, 2, 2, 1109,
+	1110, 3, 2, 2, 2, 1110, 69, 3, 2, 2, 2, 1111, 1109, 3, 2, 2, 2, 1112, 1113,
+	7, 56, 2, 2, 1113, 1114, 7, 65, 2, 2, 1114, 1119, 5, 76, 39, 2, 1115, 1116,
+	7, 56, 2, 2, 1116, 1117, 7, 65, 2, 2, 1117, 1119, 7, 204, 2, 2, 1118, 1112,
+	3, 2, 2, 2, 1118, 1115, 3, 2, 2, 2, 1119, 71, 3, 2, 2, 2, 1120, 1121, 9,
+	15, 2, 2, 1121, 73, 3, 2, 2, 2, 1122, 1123, 9, 16, 2, 2, 1123, 75, 3, 2,
+	2, 2, 1124, 1126, 7, 58, 2, 2, 1125, 1127, 9, 12, 2, 2, 1126, 1125, 3,
+	2, 2, 2, 1126, 1127, 3, 2, 2, 2, 1127, 1128, 3, 2, 2, 2, 1128, 1129, 7,
+	204, 2, 2, 1129, 1132, 5, 78, 40, 2, 1130, 1131, 7, 140, 2, 2, 1131, 1133,
+	5, 78, 40, 2, 1132, 1130, 3, 2, 2, 2, 1132, 1133, 3, 2, 2, 2, 1133, 77,
+	3, 2, 2, 2, 1134, 1135, 9, 17, 2, 2, 1135, 79, 3, 2, 2, 2, 1136, 1137,
+	8, 41, 1, 2, 1137, 1138, 7, 149, 2, 2, 1138, 1139, 7, 193, 2, 2, 1139,
+	1140, 5, 80, 41, 2, 1140, 1141, 7, 195, 2, 2, 1141, 1179, 3, 2, 2, 2, 1142,
+	1143, 7, 150, 2, 2, 1143, 1144, 7, 193, 2, 2, 1144, 1145, 5, 80, 41, 2,
+	1145, 1146, 7, 4, 2, 2, 1146, 1147, 5, 80, 41, 2, 1147, 1148, 7, 195, 2,
+	2, 1148, 1179, 3, 2, 2, 2, 1149, 1150, 7, 96, 2, 2, 1150, 1151, 7, 3, 2,
+	2, 1151, 1152, 5, 108, 55, 2, 1152, 1159, 5, 80, 41, 2, 1153, 1154, 7,
+	4, 2, 2, 1154, 1155, 5, 108, 55, 2, 1155, 1156, 5, 80, 41, 2, 1156, 1158,
+	3, 2, 2, 2, 1157, 1153, 3, 2, 2, 2, 1158, 1161, 3, 2, 2, 2, 1159, 1157,
+	3, 2, 2, 2, 1159, 1160, 3, 2, 2, 2, 1160, 1162, 3, 2, 2, 2, 1161, 1159,
+	3, 2, 2, 2, 1162, 1163, 7, 5, 2, 2, 1163, 1179, 3, 2, 2, 2, 1164, 1176,
+	5, 84, 43, 2, 1165, 1166, 7, 3, 2, 2, 1166, 1171, 5, 82, 42, 2, 1167, 1168,
+	7, 4, 2, 2, 1168, 1170, 5, 82, 42, 2, 1169, 1167, 3, 2, 2, 2, 1170, 1173,
+	3, 2, 2, 2, 1171, 1169, 3, 2, 2, 2, 1171, 1172, 3, 2, 2, 2, 1172, 1174,
+	3, 2, 2, 2, 1173, 1171, 3, 2, 2, 2, 1174, 1175, 7, 5, 2, 2, 1175, 1177,
+	3, 2, 2, 2, 1176, 1165, 3, 2, 2, 2, 1176, 1177, 3, 2, 2, 2, 1177, 1179,
+	3, 2, 2, 2, 1178, 1136, 3, 2, 2, 2, 1178, 1142, 3, 2, 2, 2, 1178, 1149,
+	3, 2, 2, 2, 1178, 1164, 3, 2, 2, 2, 1179, 1184, 3, 2, 2, 2, 1180, 1181,
+	12, 7, 2, 2, 1181, 1183, 7, 149, 2, 2, 1182, 1180, 3, 2, 2, 2, 1183, 1186,
+	3, 2, 2, 2, 1184, 1182, 3, 2, 2, 2, 1184, 1185, 3, 2, 2, 2, 1185, 81, 3,
+	2, 2, 2, 1186, 1184, 3, 2, 2, 2, 1187, 1190, 7, 206, 2, 2, 1188, 1190,
+	5, 80, 41, 2, 1189, 1187, 3, 2, 2, 2, 1189, 1188, 3, 2, 2, 2, 1190, 83,
+	3, 2, 2, 2, 1191, 1197, 7, 188, 2, 2, 1192, 1197, 7, 189, 2, 2, 1193, 1197,
+	7, 55, 2, 2, 1194, 1197, 7, 190, 2, 2, 1195, 1197, 5, 108, 55, 2, 1196,
+	1191, 3, 2, 2, 2, 1196, 1192, 3, 2, 2, 2, 1196, 1193, 3, 2, 2, 2, 1196,
+	1194, 3, 2, 2, 2, 1196, 1195, 3, 2, 2, 2, 1197, 85, 3, 2, 2, 2, 1198, 1199,
+	7, 73, 2, 2, 1199, 1200, 5, 58, 30, 2, 1200, 1201, 7, 74, 2, 2, 1201, 1202,
+	5, 58, 30, 2, 1202, 87, 3, 2, 2, 2, 1203, 1204, 7, 87, 2, 2, 1204, 1205,
+	7, 3, 2, 2, 1205, 1206, 7, 20, 2, 2, 1206, 1207, 5, 60, 31, 2, 1207, 1208,
+	7, 5, 2, 2, 1208, 89, 3, 2, 2, 2, 1209, 1210, 7, 88, 2, 2, 1210, 1221,
+	7, 3, 2, 2, 1211, 1212, 7, 89, 2, 2, 1212, 1213, 7, 22, 2, 2, 1213, 1218,
+	5, 58, 30, 2, 1214, 1215, 7, 4, 2, 2, 1215, 1217, 5, 58, 30, 2, 1216, 1214,
+	3, 2, 2, 2, 1217, 1220, 3, 2, 2, 2, 1218, 1216, 3, 2, 2, 2, 1218, 1219,
+	3, 2, 2, 2, 1219, 1222, 3, 2, 2, 2, 1220, 1218, 3, 2, 2, 2, 1221, 1211,
+	3, 2, 2, 2, 1221, 1222, 3, 2, 2, 2, 1222, 1233, 3, 2, 2, 2, 1223, 1224,
+	7, 27, 2, 2, 1224, 1225, 7, 22, 2, 2, 1225, 1230, 5, 26, 14, 2, 1226, 1227,
+	7, 4, 2, 2, 1227, 1229, 5, 26, 14, 2, 1228, 1226, 3, 2, 2, 2, 1229, 1232,
+	3, 2, 2, 2, 1230, 1228, 3, 2, 2, 2, 1230, 1231, 3, 2, 2, 2, 1231, 1234,
+	3, 2, 2, 2, 1232, 1230, 3, 2, 2, 2, 1233, 1223, 3, 2, 2, 2, 1233, 1234,
+	3, 2, 2, 2, 1234, 1236, 3, 2, 2, 2, 1235, 1237, 5, 92, 47, 2, 1236, 1235,
+	3, 2, 2, 2, 1236, 1237, 3, 2, 2, 2, 1237, 1238, 3, 2, 2, 2, 1238, 1239,
+	7, 5, 2, 2, 1239, 91, 3, 2, 2, 2, 1240, 1241, 9, 18, 2, 2, 1241, 1249,
+	5, 94, 48, 2, 1242, 1243, 9, 18, 2, 2, 1243, 1244, 7, 37, 2, 2, 1244, 1245,
+	5, 94, 48, 2, 1245, 1246, 7, 32, 2, 2, 1246, 1247, 5, 94, 48, 2, 1247,
+	1249, 3, 2, 2, 2, 1248, 1240, 3, 2, 2, 2, 1248, 1242, 3, 2, 2, 2, 1249,
+	93, 3, 2, 2, 2, 1250, 1251, 7, 92, 2, 2, 1251, 1258, 9, 19, 2, 2, 1252,
+	1253, 7, 95, 2, 2, 1253, 1258, 7, 96, 2, 2, 1254, 1255, 5, 58, 30, 2, 1255,
+	1256, 9, 19, 2, 2, 1256, 1258, 3, 2, 2, 2, 1257, 1250, 3, 2, 2, 2, 1257,
+	1252, 3, 2, 2, 2, 1257, 1254, 3, 2, 2, 2, 1258, 95, 3, 2, 2, 2, 1259, 1260,
+	7, 118, 2, 2, 1260, 1264, 9, 20, 2, 2, 1261, 1262, 7, 119, 2, 2, 1262,
+	1264, 9, 21, 2, 2, 1263, 1259, 3, 2, 2, 2, 1263, 1261, 3, 2, 2, 2, 1264,
+	97, 3, 2, 2, 2, 1265, 1266, 7, 160, 2, 2, 1266, 1267, 7, 161, 2, 2, 1267,
+	1271, 5, 100, 51, 2, 1268, 1269, 7, 166, 2, 2, 1269, 1271, 9, 22, 2, 2,
+	1270, 1265, 3, 2, 2, 2, 1270, 1268, 3, 2, 2, 2, 1271, 99, 3, 2, 2, 2, 1272,
+	1273, 7, 166, 2, 2, 1273, 1280, 7, 165, 2, 2, 1274, 1275, 7, 166, 2, 2,
+	1275, 1280, 7, 164, 2, 2, 1276, 1277, 7, 163, 2, 2, 1277, 1280, 7, 166,
+	2, 2, 1278, 1280, 7, 162, 2, 2, 1279, 1272, 3, 2, 2, 2, 1279, 1274, 3,
+	2, 2, 2, 1279, 1276, 3, 2, 2, 2, 1279, 1278, 3, 2, 2, 2, 1280, 101, 3,
+	2, 2, 2, 1281, 1287, 5, 58, 30, 2, 1282, 1283, 5, 108, 55, 2, 1283, 1284,
+	7, 10, 2, 2, 1284, 1285, 5, 58, 30, 2, 1285, 1287, 3, 2, 2, 2, 1286, 1281,
+	3, 2, 2, 2, 1286, 1282, 3, 2, 2, 2, 1287, 103, 3, 2, 2, 2, 1288, 1293,
+	7, 12, 2, 2, 1289, 1293, 7, 107, 2, 2, 1290, 1293, 7, 106, 2, 2, 1291,
+	1293, 5, 108, 55, 2, 1292, 1288, 3, 2, 2, 2, 1292, 1289, 3, 2, 2, 2, 1292,
+	1290, 3, 2, 2, 2, 1292, 1291, 3, 2, 2, 2, 1293, 105, 3, 2, 2, 2, 1294,
+	1299, 5, 108, 55, 2, 1295, 1296, 7, 203, 2, 2, 1296, 1298, 5, 108, 55,
+	2, 1297, 1295, 3, 2, 2, 2, 1298, 1301, 3, 2, 2, 2, 1299, 1297, 3, 2, 2,
+	2, 1299, 1300, 3, 2, 2, 2, 1300, 107, 3, 2, 2, 2, 1301, 1299, 3, 2, 2,
+	2, 1302, 1308, 7, 208, 2, 2, 1303, 1308, 7, 209, 2, 2, 1304, 1308, 7, 210,
+	2, 2, 1305, 1308, 7, 211, 2, 2, 1306, 1308, 5, 110, 56, 2, 1307, 1302,
+	3, 2, 2, 2, 1307, 1303, 3, 2, 2, 2, 1307, 1304, 3, 2, 2, 2, 1307, 1305,
+	3, 2, 2, 2, 1307, 1306, 3, 2, 2, 2, 1308, 109, 3, 2, 2, 2, 1309, 1310,
+	9, 23, 2, 2, 1310, 111, 3, 2, 2, 2, 1312, 1314, 7, 215, 2, 2, 1313, 1312,
+	3, 2, 2, 2, 1313, 1314, 3, 2, 2, 2, 1314, 814, 3, 2, 2, 2, 163, 121, 130,
+	136, 143, 148, 157, 164, 173, 201, 206, 215, 226, 229, 239, 244, 248, 256,
+	262, 269, 274, 278, 286, 294, 299, 314, 318, 324, 328, 334, 362, 365, 369,
+	373, 381, 390, 393, 397, 415, 418, 426, 429, 435, 442, 447, 453, 459, 467,
+	484, 487, 491, 499, 504, 516, 523, 527, 531, 535, 542, 551, 554, 558, 563,
+	567, 570, 577, 588, 591, 601, 604, 615, 620, 628, 631, 635, 643, 646, 650,
+	654, 665, 668, 675, 682, 687, 691, 704, 715, 720, 729, 733, 737, 739, 747,
+	764, 770, 776, 780, 789, 796, 811, 819, 828, 834, 842, 848, 852, 857, 862,
+	868, 879, 881, 898, 914, 925, 935, 938, 943, 950, 953, 957, 960, 972, 993,
+	997, 1005, 1009, 1034, 1037, 1046, 1052, 1058, 1064, 1073, 1082, 1097,
+	1107, 1109, 1118, 1126, 1132, 1159, 1171, 1176, 1178, 1184, 1189, 1196,
+	1218, 1221, 1230, 1233, 1236, 1248, 1257, 1263, 1270, 1279, 1286, 1292,
+	1299, 1307, 1313,
 }
 
 var deserializer = antlr.NewATNDeserializer(nil)
@@ -710,7 +713,7 @@ var symbolicNames = []string{
 	"EQ", "NEQ", "LT", "LTE", "GT", "GTE", "PLUS", "MINUS", "ASTERISK", "SLASH",
 	"PERCENT", "CONCAT", "DOT", "STRING", "BINARY_LITERAL", "INTEGER_VALUE",
 	"DECIMAL_VALUE", "IDENTIFIER", "DIGIT_IDENTIFIER", "QUOTED_IDENTIFIER",
-	"BACKQUOTED_IDENTIFIER", "SIMPLE_COMMENT", "BRACKETED_COMMENT", "WS",
+	"BACKQUOTED_IDENTIFIER", "SIMPLE_COMMENT", "BRACKETED_COMMENT", "WS", "SYMMETRIC",
 }
 
 var ruleNames = []string{
@@ -968,6 +971,7 @@ const (
 	SQLBaseParserSIMPLE_COMMENT           = 210
 	SQLBaseParserBRACKETED_COMMENT        = 211
 	SQLBaseParserWS                       = 212
+	SQLBaseParserSYMMETRIC                = 213
 )
 
 // SQLBaseParser rules.
@@ -3888,7 +3892,7 @@ func (p *SQLBaseParser) Statement() (localctx IStatementContext) {
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLBaseParserT__0)|(1<<SQLBaseParserT__3)|(1<<SQLBaseParserADD)|(1<<SQLBaseParserALL)|(1<<SQLBaseParserSOME)|(1<<SQLBaseParserANY)|(1<<SQLBaseParserAT))) != 0) || (((_la-32)&-(0x1f+1)) == 0 && ((1<<uint((_la-32)))&((1<<(SQLBaseParserNOT-32))|(1<<(SQLBaseParserNO-32))|(1<<(SQLBaseParserEXISTS-32))|(1<<(SQLBaseParserNULL-32))|(1<<(SQLBaseParserTRUE-32))|(1<<(SQLBaseParserFALSE-32))|(1<<(SQLBaseParserSUBSTRING-32))|(1<<(SQLBaseParserPOSITION-32))|(1<<(SQLBaseParserTINYINT-32))|(1<<(SQLBaseParserSMALLINT-32))|(1<<(SQLBaseParserINTEGER-32))|(1<<(SQLBaseParserDATE-32))|(1<<(SQLBaseParserTIME-32))|(1<<(SQLBaseParserTIMESTAMP-32))|(1<<(SQLBaseParserINTERVAL-32))|(1<<(SQLBaseParserYEAR-32))|(1<<(SQLBaseParserMONTH-32))|(1<<(SQLBaseParserDAY-32))|(1<<(SQLBaseParserHOUR-32))|(1<<(SQLBaseParserMINUTE-32))|(1<<(SQLBaseParserSECOND-32))|(1<<(SQLBaseParserZONE-32)))) != 0) || (((_la-64)&-(0x1f+1)) == 0 && ((1<<uint((_la-64)))&((1<<(SQLBaseParserCURRENT_DATE-64))|(1<<(SQLBaseParserCURRENT_TIME-64))|(1<<(SQLBaseParserCURRENT_TIMESTAMP-64))|(1<<(SQLBaseParserLOCALTIME-64))|(1<<(SQLBaseParserLOCALTIMESTAMP-64))|(1<<(SQLBaseParserEXTRACT-64))|(1<<(SQLBaseParserCASE-64))|(1<<(SQLBaseParserFILTER-64))|(1<<(SQLBaseParserOVER-64))|(1<<(SQLBaseParserPARTITION-64))|(1<<(SQLBaseParserRANGE-64))|(1<<(SQLBaseParserROWS-64))|(1<<(SQLBaseParserPRECEDING-64))|(1<<(SQLBaseParserFOLLOWING-64))|(1<<(SQLBaseParserCURRENT-64))|(1<<(SQLBaseParserROW-64)))) != 0) || (((_la-99)&-(0x1f+1)) == 0 && ((1<<uint((_la-99)))&((1<<(SQLBaseParserSCHEMA-99))|(1<<(SQLBaseParserCOMMENT-99))|(1<<(SQLBaseParserVIEW-99))|(1<<(SQLBaseParserREPLACE-99))|(1<<(SQLBaseParserGRANT-99))|(1<<(SQLBaseParserREVOKE-99))|(1<<(SQLBaseParserPRIVILEGES-99))|(1<<(SQLBaseParserPUBLIC-99))|(1<<(SQLBaseParserOPTION-99))|(1<<(SQLBaseParserEXPLAIN-99))|(1<<(SQLBaseParserANALYZE-99))|(1<<(SQLBaseParserFORMAT-99))|(1<<(SQLBaseParserTYPE-99))|(1<<(SQLBaseParserTEXT-99))|(1<<(SQLBaseParserGRAPHVIZ-99))|(1<<(SQLBaseParserLOGICAL-99))|(1<<(SQLBaseParserDISTRIBUTED-99))|(1<<(SQLBaseParserVALIDATE-99))|(1<<(SQLBaseParserCAST-99))|(1<<(SQLBaseParserTRY_CAST-99))|(1<<(SQLBaseParserSHOW-99))|(1<<(SQLBaseParserTABLES-99))|(1<<(SQLBaseParserSCHEMAS-99))|(1<<(SQLBaseParserCATALOGS-99))|(1<<(SQLBaseParserCOLUMNS-99))|(1<<(SQLBaseParserCOLUMN-99)))) != 0) || (((_la-131)&-(0x1f+1)) == 0 && ((1<<uint((_la-131)))&((1<<(SQLBaseParserUSE-131))|(1<<(SQLBaseParserPARTITIONS-131))|(1<<(SQLBaseParserFUNCTIONS-131))|(1<<(SQLBaseParserTO-131))|(1<<(SQLBaseParserSYSTEM-131))|(1<<(SQLBaseParserBERNOULLI-131))|(1<<(SQLBaseParserPOISSONIZED-131))|(1<<(SQLBaseParserTABLESAMPLE-131))|(1<<(SQLBaseParserARRAY-131))|(1<<(SQLBaseParserMAP-131))|(1<<(SQLBaseParserSET-131))|(1<<(SQLBaseParserRESET-131))|(1<<(SQLBaseParserSESSION-131))|(1<<(SQLBaseParserDATA-131))|(1<<(SQLBaseParserSTART-131))|(1<<(SQLBaseParserTRANSACTION-131))|(1<<(SQLBaseParserCOMMIT-131))|(1<<(SQLBaseParserROLLBACK-131))|(1<<(SQLBaseParserWORK-131))|(1<<(SQLBaseParserISOLATION-131))|(1<<(SQLBaseParserLEVEL-131))|(1<<(SQLBaseParserSERIALIZABLE-131))|(1<<(SQLBaseParserREPEATABLE-131))|(1<<(SQLBaseParserCOMMITTED-131)))) != 0) || (((_la-163)&-(0x1f+1)) == 0 && ((1<<uint((_la-163)))&((1<<(SQLBaseParserUNCOMMITTED-163))|(1<<(SQLBaseParserREAD-163))|(1<<(SQLBaseParserWRITE-163))|(1<<(SQLBaseParserONLY-163))|(1<<(SQLBaseParserCALL-163))|(1<<(SQLBaseParserINPUT-163))|(1<<(SQLBaseParserOUTPUT-163))|(1<<(SQLBaseParserCASCADE-163))|(1<<(SQLBaseParserRESTRICT-163))|(1<<(SQLBaseParserINCLUDING-163))|(1<<(SQLBaseParserEXCLUDING-163))|(1<<(SQLBaseParserPROPERTIES-163))|(1<<(SQLBaseParserNORMALIZE-163))|(1<<(SQLBaseParserNFD-163))|(1<<(SQLBaseParserNFC-163))|(1<<(SQLBaseParserNFKD-163))|(1<<(SQLBaseParserNFKC-163))|(1<<(SQLBaseParserIF-163))|(1<<(SQLBaseParserNULLIF-163))|(1<<(SQLBaseParserCOALESCE-163))|(1<<(SQLBaseParserTIME_WITH_TIME_ZONE-163))|(1<<(SQLBaseParserTIMESTAMP_WITH_TIME_ZONE-163))|(1<<(SQLBaseParserDOUBLE_PRECISION-163)))) != 0) || (((_la-195)&-(0x1f+1)) == 0 && ((1<<uint((_la-195)))&((1<<(SQLBaseParserPLUS-195))|(1<<(SQLBaseParserMINUS-195))|(1<<(SQLBaseParserSTRING-195))|(1<<(SQLBaseParserBINARY_LITERAL-195))|(1<<(SQLBaseParserINTEGER_VALUE-195))|(1<<(SQLBaseParserDECIMAL_VALUE-195))|(1<<(SQLBaseParserIDENTIFIER-195))|(1<<(SQLBaseParserDIGIT_IDENTIFIER-195))|(1<<(SQLBaseParserQUOTED_IDENTIFIER-195))|(1<<(SQLBaseParserBACKQUOTED_IDENTIFIER-195)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLBaseParserT__0)|(1<<SQLBaseParserT__3)|(1<<SQLBaseParserADD)|(1<<SQLBaseParserALL)|(1<<SQLBaseParserSOME)|(1<<SQLBaseParserANY)|(1<<SQLBaseParserAT))) != 0) || (((_la-32)&-(0x1f+1)) == 0 && ((1<<uint((_la-32)))&((1<<(SQLBaseParserNOT-32))|(1<<(SQLBaseParserNO-32))|(1<<(SQLBaseParserEXISTS-32))|(1<<(SQLBaseParserNULL-32))|(1<<(SQLBaseParserTRUE-32))|(1<<(SQLBaseParserFALSE-32))|(1<<(SQLBaseParserSUBSTRING-32))|(1<<(SQLBaseParserPOSITION-32))|(1<<(SQLBaseParserTINYINT-32))|(1<<(SQLBaseParserSMALLINT-32))|(1<<(SQLBaseParserINTEGER-32))|(1<<(SQLBaseParserDATE-32))|(1<<(SQLBaseParserTIME-32))|(1<<(SQLBaseParserTIMESTAMP-32))|(1<<(SQLBaseParserINTERVAL-32))|(1<<(SQLBaseParserYEAR-32))|(1<<(SQLBaseParserMONTH-32))|(1<<(SQLBaseParserDAY-32))|(1<<(SQLBaseParserHOUR-32))|(1<<(SQLBaseParserMINUTE-32))|(1<<(SQLBaseParserSECOND-32))|(1<<(SQLBaseParserZONE-32)))) != 0) || (((_la-64)&-(0x1f+1)) == 0 && ((1<<uint((_la-64)))&((1<<(SQLBaseParserCURRENT_DATE-64))|(1<<(SQLBaseParserCURRENT_TIME-64))|(1<<(SQLBaseParserCURRENT_TIMESTAMP-64))|(1<<(SQLBaseParserLOCALTIME-64))|(1<<(SQLBaseParserLOCALTIMESTAMP-64))|(1<<(SQLBaseParserEXTRACT-64))|(1<<(SQLBaseParserCASE-64))|(1<<(SQLBaseParserFILTER-64))|(1<<(SQLBaseParserOVER-64))|(1<<(SQLBaseParserPARTITION-64))|(1<<(SQLBaseParserRANGE-64))|(1<<(SQLBaseParserROWS-64))|(1<<(SQLBaseParserPRECEDING-64))|(1<<(SQLBaseParserFOLLOWING-64))|(1<<(SQLBaseParserCURRENT-64))|(1<<(SQLBaseParserROW-64)))) != 0) || (((_la-99)&-(0x1f+1)) == 0 && ((1<<uint((_la-99)))&((1<<(SQLBaseParserSCHEMA-99))|(1<<(SQLBaseParserCOMMENT-99))|(1<<(SQLBaseParserVIEW-99))|(1<<(SQLBaseParserREPLACE-99))|(1<<(SQLBaseParserGRANT-99))|(1<<(SQLBaseParserREVOKE-99))|(1<<(SQLBaseParserPRIVILEGES-99))|(1<<(SQLBaseParserPUBLIC-99))|(1<<(SQLBaseParserOPTION-99))|(1<<(SQLBaseParserEXPLAIN-99))|(1<<(SQLBaseParserANALYZE-99))|(1<<(SQLBaseParserFORMAT-99))|(1<<(SQLBaseParserTYPE-99))|(1<<(SQLBaseParserTEXT-99))|(1<<(SQLBaseParserGRAPHVIZ-99))|(1<<(SQLBaseParserLOGICAL-99))|(1<<(SQLBaseParserDISTRIBUTED-99))|(1<<(SQLBaseParserVALIDATE-99))|(1<<(SQLBaseParserCAST-99))|(1<<(SQLBaseParserTRY_CAST-99))|(1<<(SQLBaseParserSHOW-99))|(1<<(SQLBaseParserTABLES-99))|(1<<(SQLBaseParserSCHEMAS-99))|(1<<(SQLBaseParserCATALOGS-99))|(1<<(SQLBaseParserCOLUMNS-99))|(1<<(SQLBaseParserCOLUMN-99)))) != 0) || (((_la-131)&-(0x1f+1)) == 0 && ((1<<uint((_la-131)))&((1<<(SQLBaseParserUSE-131))|(1<<(SQLBaseParserPARTITIONS-131))|(1<<(SQLBaseParserFUNCTIONS-131))|(1<<(SQLBaseParserTO-131))|(1<<(SQLBaseParserSYSTEM-131))|(1<<(SQLBaseParserBERNOULLI-131))|(1<<(SQLBaseParserPOISSONIZED-131))|(1<<(SQLBaseParserTABLESAMPLE-131))|(1<<(SQLBaseParserARRAY-131))|(1<<(SQLBaseParserMAP-131))|(1<<(SQLBaseParserSET-131))|(1<<(SQLBaseParserRESET-131))|(1<<(SQLBaseParserSESSION-131))|(1<<(SQLBaseParserDATA-131))|(1<<(SQLBaseParserSTART-131))|(1<<(SQLBaseParserTRANSACTION-131))|(1<<(SQLBaseParserCOMMIT-131))|(1<<(SQLBaseParserROLLBACK-131))|(1<<(SQLBaseParserWORK-131))|(1<<(SQLBaseParserISOLATION-131))|(1<<(SQLBaseParserLEVEL-131))|(1<<(SQLBaseParserSERIALIZABLE-131))|(1<<(SQLBaseParserREPEATABLE-131))|(1<<(SQLBaseParserCOMMITTED-131)))) != 0) || (((_la-163)&-(0x1f+1)) == 0 && ((1<<uint((_la-163)))&((1<<(SQLBaseParserUNCOMMITTED-163))|(1<<(SQLBaseParserREAD-163))|(1<<(SQLBaseParserWRITE-163))|(1<<(SQLBaseParserONLY-163))|(1<<(SQLBaseParserCALL-163))|(1<<(SQLBaseParserINPUT-163))|(1<<(SQLBaseParserOUTPUT-163))|(1<<(SQLBaseParserCASCADE-163))|(1<<(SQLBaseParserRESTRICT-163))|(1<<(SQLBaseParserINCLUDING-163))|(1<<(SQLBaseParserEXCLUDING-163))|(1<<(SQLBaseParserPROPERTIES-163))|(1<<(SQLBaseParserNORMALIZE-163))|(1<<(SQLBaseParserNFD-163))|(1<<(SQLBaseParserNFC-163))|(1<<(SQLBaseParserNFKD-163))|(1<<(SQLBaseParserNFKC-163))|(1<<(SQLBaseParserIF-163))|(1<<(SQLBaseParserNULLIF-163))|(1<<(SQLBaseParserCOALESCE-163))|(1<<(SQLBaseParserTIME_WITH_TIME_ZONE-163))|(1<<(SQLBaseParserTIMESTAMP_WITH_TIME_ZONE-163))|(1<<(SQLBaseParserDOUBLE_PRECISION-163)))) != 0) || (((_la-195)&-(0x1f+1)) == 0 && ((1<<uint((_la-195)))&((1<<(SQLBaseParserPLUS-195))|(1<<(SQLBaseParserMINUS-195))|(1<<(SQLBaseParserSTRING-195))|(1<<(SQLBaseParserBINARY_LITERAL-195))|(1<<(SQLBaseParserINTEGER_VALUE-195))|(1<<(SQLBaseParserDECIMAL_VALUE-195))|(1<<(SQLBaseParserIDENTIFIER-195))|(1<<(SQLBaseParserDIGIT_IDENTIFIER-195))|(1<<(SQLBaseParserQUOTED_IDENTIFIER-195))|(1<<(SQLBaseParserBACKQUOTED_IDENTIFIER-195)))) != 0) || _la == SQLBaseParserSYMMETRIC {
 			{
 				p.SetState(219)
 				p.CallArgument()
@@ -5107,7 +5111,7 @@ func (p *SQLBaseParser) TableElement() (localctx ITableElementContext) {
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case SQLBaseParserADD, SQLBaseParserALL, SQLBaseParserSOME, SQLBaseParserANY, SQLBaseParserAT, SQLBaseParserNO, SQLBaseParserSUBSTRING, SQLBaseParserPOSITION, SQLBaseParserTINYINT, SQLBaseParserSMALLINT, SQLBaseParserINTEGER, SQLBaseParserDATE, SQLBaseParserTIME, SQLBaseParserTIMESTAMP, SQLBaseParserINTERVAL, SQLBaseParserYEAR, SQLBaseParserMONTH, SQLBaseParserDAY, SQLBaseParserHOUR, SQLBaseParserMINUTE, SQLBaseParserSECOND, SQLBaseParserZONE, SQLBaseParserFILTER, SQLBaseParserOVER, SQLBaseParserPARTITION, SQLBaseParserRANGE, SQLBaseParserROWS, SQLBaseParserPRECEDING, SQLBaseParserFOLLOWING, SQLBaseParserCURRENT, SQLBaseParserROW, SQLBaseParserSCHEMA, SQLBaseParserCOMMENT, SQLBaseParserVIEW, SQLBaseParserREPLACE, SQLBaseParserGRANT, SQLBaseParserREVOKE, SQLBaseParserPRIVILEGES, SQLBaseParserPUBLIC, SQLBaseParserOPTION, SQLBaseParserEXPLAIN, SQLBaseParserANALYZE, SQLBaseParserFORMAT, SQLBaseParserTYPE, SQLBaseParserTEXT, SQLBaseParserGRAPHVIZ, SQLBaseParserLOGICAL, SQLBaseParserDISTRIBUTED, SQLBaseParserVALIDATE, SQLBaseParserSHOW, SQLBaseParserTABLES, SQLBaseParserSCHEMAS, SQLBaseParserCATALOGS, SQLBaseParserCOLUMNS, SQLBaseParserCOLUMN, SQLBaseParserUSE, SQLBaseParserPARTITIONS, SQLBaseParserFUNCTIONS, SQLBaseParserTO, SQLBaseParserSYSTEM, SQLBaseParserBERNOULLI, SQLBaseParserPOISSONIZED, SQLBaseParserTABLESAMPLE, SQLBaseParserARRAY, SQLBaseParserMAP, SQLBaseParserSET, SQLBaseParserRESET, SQLBaseParserSESSION, SQLBaseParserDATA, SQLBaseParserSTART, SQLBaseParserTRANSACTION, SQLBaseParserCOMMIT, SQLBaseParserROLLBACK, SQLBaseParserWORK, SQLBaseParserISOLATION, SQLBaseParserLEVEL, SQLBaseParserSERIALIZABLE, SQLBaseParserREPEATABLE, SQLBaseParserCOMMITTED, SQLBaseParserUNCOMMITTED, SQLBaseParserREAD, SQLBaseParserWRITE, SQLBaseParserONLY, SQLBaseParserCALL, SQLBaseParserINPUT, SQLBaseParserOUTPUT, SQLBaseParserCASCADE, SQLBaseParserRESTRICT, SQLBaseParserINCLUDING, SQLBaseParserEXCLUDING, SQLBaseParserPROPERTIES, SQLBaseParserNFD, SQLBaseParserNFC, SQLBaseParserNFKD, SQLBaseParserNFKC, SQLBaseParserIF, SQLBaseParserNULLIF, SQLBaseParserCOALESCE, SQLBaseParserIDENTIFIER, SQLBaseParserDIGIT_IDENTIFIER, SQLBaseParserQUOTED_IDENTIFIER, SQLBaseParserBACKQUOTED_IDENTIFIER:
+	case SQLBaseParserADD, SQLBaseParserALL, SQLBaseParserSOME, SQLBaseParserANY, SQLBaseParserAT, SQLBaseParserNO, SQLBaseParserSUBSTRING, SQLBaseParserPOSITION, SQLBaseParserTINYINT, SQLBaseParserSMALLINT, SQLBaseParserINTEGER, SQLBaseParserDATE, SQLBaseParserTIME, SQLBaseParserTIMESTAMP, SQLBaseParserINTERVAL, SQLBaseParserYEAR, SQLBaseParserMONTH, SQLBaseParserDAY, SQLBaseParserHOUR, SQLBaseParserMINUTE, SQLBaseParserSECOND, SQLBaseParserZONE, SQLBaseParserFILTER, SQLBaseParserOVER, SQLBaseParserPARTITION, SQLBaseParserRANGE, SQLBaseParserROWS, SQLBaseParserPRECEDING, SQLBaseParserFOLLOWING, SQLBaseParserCURRENT, SQLBaseParserROW, SQLBaseParserSCHEMA, SQLBaseParserCOMMENT, SQLBaseParserVIEW, SQLBaseParserREPLACE, SQLBaseParserGRANT, SQLBaseParserREVOKE, SQLBaseParserPRIVILEGES, SQLBaseParserPUBLIC, SQLBaseParserOPTION, SQLBaseParserEXPLAIN, SQLBaseParserANALYZE, SQLBaseParserFORMAT, SQLBaseParserTYPE, SQLBaseParserTEXT, SQLBaseParserGRAPHVIZ, SQLBaseParserLOGICAL, SQLBaseParserDISTRIBUTED, SQLBaseParserVALIDATE, SQLBaseParserSHOW, SQLBaseParserTABLES, SQLBaseParserSCHEMAS, SQLBaseParserCATALOGS, SQLBaseParserCOLUMNS, SQLBaseParserCOLUMN, SQLBaseParserUSE, SQLBaseParserPARTITIONS, SQLBaseParserFUNCTIONS, SQLBaseParserTO, SQLBaseParserSYSTEM, SQLBaseParserBERNOULLI, SQLBaseParserPOISSONIZED, SQLBaseParserTABLESAMPLE, SQLBaseParserARRAY, SQLBaseParserMAP, SQLBaseParserSET, SQLBaseParserRESET, SQLBaseParserSESSION, SQLBaseParserDATA, SQLBaseParserSTART, SQLBaseParserTRANSACTION, SQLBaseParserCOMMIT, SQLBaseParserROLLBACK, SQLBaseParserWORK, SQLBaseParserISOLATION, SQLBaseParserLEVEL, SQLBaseParserSERIALIZABLE, SQLBaseParserREPEATABLE, SQLBaseParserCOMMITTED, SQLBaseParserUNCOMMITTED, SQLBaseParserREAD, SQLBaseParserWRITE, SQLBaseParserONLY, SQLBaseParserCALL, SQLBaseParserINPUT, SQLBaseParserOUTPUT, SQLBaseParserCASCADE, SQLBaseParserRESTRICT, SQLBaseParserINCLUDING, SQLBaseParserEXCLUDING, SQLBaseParserPROPERTIES, SQLBaseParserNFD, SQLBaseParserNFC, SQLBaseParserNFKD, SQLBaseParserNFKC, SQLBaseParserIF, SQLBaseParserNULLIF, SQLBaseParserCOALESCE, SQLBaseParserIDENTIFIER, SQLBaseParserDIGIT_IDENTIFIER, SQLBaseParserQUOTED_IDENTIFIER, SQLBaseParserBACKQUOTED_IDENTIFIER, SQLBaseParserSYMMETRIC:
 		p.EnterOuterAlt(localctx, 1)
 		{
 			p.SetState(443)
@@ -7472,7 +7476,7 @@ func (p *SQLBaseParser) GroupingElement() (localctx IGroupingElementContext) {
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case SQLBaseParserT__0, SQLBaseParserT__3, SQLBaseParserADD, SQLBaseParserALL, SQLBaseParserSOME, SQLBaseParserANY, SQLBaseParserAT, SQLBaseParserNOT, SQLBaseParserNO, SQLBaseParserEXISTS, SQLBaseParserNULL, SQLBaseParserTRUE, SQLBaseParserFALSE, SQLBaseParserSUBSTRING, SQLBaseParserPOSITION, SQLBaseParserTINYINT, SQLBaseParserSMALLINT, SQLBaseParserINTEGER, SQLBaseParserDATE, SQLBaseParserTIME, SQLBaseParserTIMESTAMP, SQLBaseParserINTERVAL, SQLBaseParserYEAR, SQLBaseParserMONTH, SQLBaseParserDAY, SQLBaseParserHOUR, SQLBaseParserMINUTE, SQLBaseParserSECOND, SQLBaseParserZONE, SQLBaseParserCURRENT_DATE, SQLBaseParserCURRENT_TIME, SQLBaseParserCURRENT_TIMESTAMP, SQLBaseParserLOCALTIME, SQLBaseParserLOCALTIMESTAMP, SQLBaseParserEXTRACT, SQLBaseParserCASE, SQLBaseParserFILTER, SQLBaseParserOVER, SQLBaseParserPARTITION, SQLBaseParserRANGE, SQLBaseParserROWS, SQLBaseParserPRECEDING, SQLBaseParserFOLLOWING, SQLBaseParserCURRENT, SQLBaseParserROW, SQLBaseParserSCHEMA, SQLBaseParserCOMMENT, SQLBaseParserVIEW, SQLBaseParserREPLACE, SQLBaseParserGRANT, SQLBaseParserREVOKE, SQLBaseParserPRIVILEGES, SQLBaseParserPUBLIC, SQLBaseParserOPTION, SQLBaseParserEXPLAIN, SQLBaseParserANALYZE, SQLBaseParserFORMAT, SQLBaseParserTYPE, SQLBaseParserTEXT, SQLBaseParserGRAPHVIZ, SQLBaseParserLOGICAL, SQLBaseParserDISTRIBUTED, SQLBaseParserVALIDATE, SQLBaseParserCAST, SQLBaseParserTRY_CAST, SQLBaseParserSHOW, SQLBaseParserTABLES, SQLBaseParserSCHEMAS, SQLBaseParserCATALOGS, SQLBaseParserCOLUMNS, SQLBaseParserCOLUMN, SQLBaseParserUSE, SQLBaseParserPARTITIONS, SQLBaseParserFUNCTIONS, SQLBaseParserTO, SQLBaseParserSYSTEM, SQLBaseParserBERNOULLI, SQLBaseParserPOISSONIZED, SQLBaseParserTABLESAMPLE, SQLBaseParserARRAY, SQLBaseParserMAP, SQLBaseParserSET, SQLBaseParserRESET, SQLBaseParserSESSION, SQLBaseParserDATA, SQLBaseParserSTART, SQLBaseParserTRANSACTION, SQLBaseParserCOMMIT, SQLBaseParserROLLBACK, SQLBaseParserWORK, SQLBaseParserISOLATION, SQLBaseParserLEVEL, SQLBaseParserSERIALIZABLE, SQLBaseParserREPEATABLE, SQLBaseParserCOMMITTED, SQLBaseParserUNCOMMITTED, SQLBaseParserREAD, SQLBaseParserWRITE, SQLBaseParserONLY, SQLBaseParserCALL, SQLBaseParserINPUT, SQLBaseParserOUTPUT, SQLBaseParserCASCADE, SQLBaseParserRESTRICT, SQLBaseParserINCLUDING, SQLBaseParserEXCLUDING, SQLBaseParserPROPERTIES, SQLBaseParserNORMALIZE, SQLBaseParserNFD, SQLBaseParserNFC, SQLBaseParserNFKD, SQLBaseParserNFKC, SQLBaseParserIF, SQLBaseParserNULLIF, SQLBaseParserCOALESCE, SQLBaseParserTIME_WITH_TIME_ZONE, SQLBaseParserTIMESTAMP_WITH_TIME_ZONE, SQLBaseParserDOUBLE_PRECISION, SQLBaseParserPLUS, SQLBaseParserMINUS, SQLBaseParserSTRING, SQLBaseParserBINARY_LITERAL, SQLBaseParserINTEGER_VALUE, SQLBaseParserDECIMAL_VALUE, SQLBaseParserIDENTIFIER, SQLBaseParserDIGIT_IDENTIFIER, SQLBaseParserQUOTED_IDENTIFIER, SQLBaseParserBACKQUOTED_IDENTIFIER:
+	case SQLBaseParserT__0, SQLBaseParserT__3, SQLBaseParserADD, SQLBaseParserALL, SQLBaseParserSOME, SQLBaseParserANY, SQLBaseParserAT, SQLBaseParserNOT, SQLBaseParserNO, SQLBaseParserEXISTS, SQLBaseParserNULL, SQLBaseParserTRUE, SQLBaseParserFALSE, SQLBaseParserSUBSTRING, SQLBaseParserPOSITION, SQLBaseParserTINYINT, SQLBaseParserSMALLINT, SQLBaseParserINTEGER, SQLBaseParserDATE, SQLBaseParserTIME, SQLBaseParserTIMESTAMP, SQLBaseParserINTERVAL, SQLBaseParserYEAR, SQLBaseParserMONTH, SQLBaseParserDAY, SQLBaseParserHOUR, SQLBaseParserMINUTE, SQLBaseParserSECOND, SQLBaseParserZONE, SQLBaseParserCURRENT_DATE, SQLBaseParserCURRENT_TIME, SQLBaseParserCURRENT_TIMESTAMP, SQLBaseParserLOCALTIME, SQLBaseParserLOCALTIMESTAMP, SQLBaseParserEXTRACT, SQLBaseParserCASE, SQLBaseParserFILTER, SQLBaseParserOVER, SQLBaseParserPARTITION, SQLBaseParserRANGE, SQLBaseParserROWS, SQLBaseParserPRECEDING, SQLBaseParserFOLLOWING, SQLBaseParserCURRENT, SQLBaseParserROW, SQLBaseParserSCHEMA, SQLBaseParserCOMMENT, SQLBaseParserVIEW, SQLBaseParserREPLACE, SQLBaseParserGRANT, SQLBaseParserREVOKE, SQLBaseParserPRIVILEGES, SQLBaseParserPUBLIC, SQLBaseParserOPTION, SQLBaseParserEXPLAIN, SQLBaseParserANALYZE, SQLBaseParserFORMAT, SQLBaseParserTYPE, SQLBaseParserTEXT, SQLBaseParserGRAPHVIZ, SQLBaseParserLOGICAL, SQLBaseParserDISTRIBUTED, SQLBaseParserVALIDATE, SQLBaseParserCAST, SQLBaseParserTRY_CAST, SQLBaseParserSHOW, SQLBaseParserTABLES, SQLBaseParserSCHEMAS, SQLBaseParserCATALOGS, SQLBaseParserCOLUMNS, SQLBaseParserCOLUMN, SQLBaseParserUSE, SQLBaseParserPARTITIONS, SQLBaseParserFUNCTIONS, SQLBaseParserTO, SQLBaseParserSYSTEM, SQLBaseParserBERNOULLI, SQLBaseParserPOISSONIZED, SQLBaseParserTABLESAMPLE, SQLBaseParserARRAY, SQLBaseParserMAP, SQLBaseParserSET, SQLBaseParserRESET, SQLBaseParserSESSION, SQLBaseParserDATA, SQLBaseParserSTART, SQLBaseParserTRANSACTION, SQLBaseParserCOMMIT, SQLBaseParserROLLBACK, SQLBaseParserWORK, SQLBaseParserISOLATION, SQLBaseParserLEVEL, SQLBaseParserSERIALIZABLE, SQLBaseParserREPEATABLE, SQLBaseParserCOMMITTED, SQLBaseParserUNCOMMITTED, SQLBaseParserREAD, SQLBaseParserWRITE, SQLBaseParserONLY, SQLBaseParserCALL, SQLBaseParserINPUT, SQLBaseParserOUTPUT, SQLBaseParserCASCADE, SQLBaseParserRESTRICT, SQLBaseParserINCLUDING, SQLBaseParserEXCLUDING, SQLBaseParserPROPERTIES, SQLBaseParserNORMALIZE, SQLBaseParserNFD, SQLBaseParserNFC, SQLBaseParserNFKD, SQLBaseParserNFKC, SQLBaseParserIF, SQLBaseParserNULLIF, SQLBaseParserCOALESCE, SQLBaseParserTIME_WITH_TIME_ZONE, SQLBaseParserTIMESTAMP_WITH_TIME_ZONE, SQLBaseParserDOUBLE_PRECISION, SQLBaseParserPLUS, SQLBaseParserMINUS, SQLBaseParserSTRING, SQLBaseParserBINARY_LITERAL, SQLBaseParserINTEGER_VALUE, SQLBaseParserDECIMAL_VALUE, SQLBaseParserIDENTIFIER, SQLBaseParserDIGIT_IDENTIFIER, SQLBaseParserQUOTED_IDENTIFIER, SQLBaseParserBACKQUOTED_IDENTIFIER, SQLBaseParserSYMMETRIC:
 		localctx = NewSingleGroupingSetContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
@@ -7495,7 +7499,7 @@ func (p *SQLBaseParser) GroupingElement() (localctx IGroupingElementContext) {
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLBaseParserADD)|(1<<SQLBaseParserALL)|(1<<SQLBaseParserSOME)|(1<<SQLBaseParserANY)|(1<<SQLBaseParserAT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SQLBaseParserNO-33))|(1<<(SQLBaseParserSUBSTRING-33))|(1<<(SQLBaseParserPOSITION-33))|(1<<(SQLBaseParserTINYINT-33))|(1<<(SQLBaseParserSMALLINT-33))|(1<<(SQLBaseParserINTEGER-33))|(1<<(SQLBaseParserDATE-33))|(1<<(SQLBaseParserTIME-33))|(1<<(SQLBaseParserTIMESTAMP-33))|(1<<(SQLBaseParserINTERVAL-33))|(1<<(SQLBaseParserYEAR-33))|(1<<(SQLBaseParserMONTH-33))|(1<<(SQLBaseParserDAY-33))|(1<<(SQLBaseParserHOUR-33))|(1<<(SQLBaseParserMINUTE-33))|(1<<(SQLBaseParserSECOND-33))|(1<<(SQLBaseParserZONE-33)))) != 0) || (((_la-85)&-(0x1f+1)) == 0 && ((1<<uint((_la-85)))&((1<<(SQLBaseParserFILTER-85))|(1<<(SQLBaseParserOVER-85))|(1<<(SQLBaseParserPARTITION-85))|(1<<(SQLBaseParserRANGE-85))|(1<<(SQLBaseParserROWS-85))|(1<<(SQLBaseParserPRECEDING-85))|(1<<(SQLBaseParserFOLLOWING-85))|(1<<(SQLBaseParserCURRENT-85))|(1<<(SQLBaseParserROW-85))|(1<<(SQLBaseParserSCHEMA-85))|(1<<(SQLBaseParserCOMMENT-85))|(1<<(SQLBaseParserVIEW-85))|(1<<(SQLBaseParserREPLACE-85))|(1<<(SQLBaseParserGRANT-85))|(1<<(SQLBaseParserREVOKE-85))|(1<<(SQLBaseParserPRIVILEGES-85))|(1<<(SQLBaseParserPUBLIC-85))|(1<<(SQLBaseParserOPTION-85))|(1<<(SQLBaseParserEXPLAIN-85))|(1<<(SQLBaseParserANALYZE-85))|(1<<(SQLBaseParserFORMAT-85)))) != 0) || (((_la-117)&-(0x1f+1)) == 0 && ((1<<uint((_la-117)))&((1<<(SQLBaseParserTYPE-117))|(1<<(SQLBaseParserTEXT-117))|(1<<(SQLBaseParserGRAPHVIZ-117))|(1<<(SQLBaseParserLOGICAL-117))|(1<<(SQLBaseParserDISTRIBUTED-117))|(1<<(SQLBaseParserVALIDATE-117))|(1<<(SQLBaseParserSHOW-117))|(1<<(SQLBaseParserTABLES-117))|(1<<(SQLBaseParserSCHEMAS-117))|(1<<(SQLBaseParserCATALOGS-117))|(1<<(SQLBaseParserCOLUMNS-117))|(1<<(SQLBaseParserCOLUMN-117))|(1<<(SQLBaseParserUSE-117))|(1<<(SQLBaseParserPARTITIONS-117))|(1<<(SQLBaseParserFUNCTIONS-117))|(1<<(SQLBaseParserTO-117))|(1<<(SQLBaseParserSYSTEM-117))|(1<<(SQLBaseParserBERNOULLI-117))|(1<<(SQLBaseParserPOISSONIZED-117))|(1<<(SQLBaseParserTABLESAMPLE-117))|(1<<(SQLBaseParserARRAY-117))|(1<<(SQLBaseParserMAP-117)))) != 0) || (((_la-149)&-(0x1f+1)) == 0 && ((1<<uint((_la-149)))&((1<<(SQLBaseParserSET-149))|(1<<(SQLBaseParserRESET-149))|(1<<(SQLBaseParserSESSION-149))|(1<<(SQLBaseParserDATA-149))|(1<<(SQLBaseParserSTART-149))|(1<<(SQLBaseParserTRANSACTION-149))|(1<<(SQLBaseParserCOMMIT-149))|(1<<(SQLBaseParserROLLBACK-149))|(1<<(SQLBaseParserWORK-149))|(1<<(SQLBaseParserISOLATION-149))|(1<<(SQLBaseParserLEVEL-149))|(1<<(SQLBaseParserSERIALIZABLE-149))|(1<<(SQLBaseParserREPEATABLE-149))|(1<<(SQLBaseParserCOMMITTED-149))|(1<<(SQLBaseParserUNCOMMITTED-149))|(1<<(SQLBaseParserREAD-149))|(1<<(SQLBaseParserWRITE-149))|(1<<(SQLBaseParserONLY-149))|(1<<(SQLBaseParserCALL-149))|(1<<(SQLBaseParserINPUT-149))|(1<<(SQLBaseParserOUTPUT-149))|(1<<(SQLBaseParserCASCADE-149))|(1<<(SQLBaseParserRESTRICT-149))|(1<<(SQLBaseParserINCLUDING-149))|(1<<(SQLBaseParserEXCLUDING-149))|(1<<(SQLBaseParserPROPERTIES-149))|(1<<(SQLBaseParserNFD-149))|(1<<(SQLBaseParserNFC-149)))) != 0) || (((_la-181)&-(0x1f+1)) == 0 && ((1<<uint((_la-181)))&((1<<(SQLBaseParserNFKD-181))|(1<<(SQLBaseParserNFKC-181))|(1<<(SQLBaseParserIF-181))|(1<<(SQLBaseParserNULLIF-181))|(1<<(SQLBaseParserCOALESCE-181))|(1<<(SQLBaseParserIDENTIFIER-181))|(1<<(SQLBaseParserDIGIT_IDENTIFIER-181))|(1<<(SQLBaseParserQUOTED_IDENTIFIER-181))|(1<<(SQLBaseParserBACKQUOTED_IDENTIFIER-181)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLBaseParserADD)|(1<<SQLBaseParserALL)|(1<<SQLBaseParserSOME)|(1<<SQLBaseParserANY)|(1<<SQLBaseParserAT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SQLBaseParserNO-33))|(1<<(SQLBaseParserSUBSTRING-33))|(1<<(SQLBaseParserPOSITION-33))|(1<<(SQLBaseParserTINYINT-33))|(1<<(SQLBaseParserSMALLINT-33))|(1<<(SQLBaseParserINTEGER-33))|(1<<(SQLBaseParserDATE-33))|(1<<(SQLBaseParserTIME-33))|(1<<(SQLBaseParserTIMESTAMP-33))|(1<<(SQLBaseParserINTERVAL-33))|(1<<(SQLBaseParserYEAR-33))|(1<<(SQLBaseParserMONTH-33))|(1<<(SQLBaseParserDAY-33))|(1<<(SQLBaseParserHOUR-33))|(1<<(SQLBaseParserMINUTE-33))|(1<<(SQLBaseParserSECOND-33))|(1<<(SQLBaseParserZONE-33)))) != 0) || (((_la-85)&-(0x1f+1)) == 0 && ((1<<uint((_la-85)))&((1<<(SQLBaseParserFILTER-85))|(1<<(SQLBaseParserOVER-85))|(1<<(SQLBaseParserPARTITION-85))|(1<<(SQLBaseParserRANGE-85))|(1<<(SQLBaseParserROWS-85))|(1<<(SQLBaseParserPRECEDING-85))|(1<<(SQLBaseParserFOLLOWING-85))|(1<<(SQLBaseParserCURRENT-85))|(1<<(SQLBaseParserROW-85))|(1<<(SQLBaseParserSCHEMA-85))|(1<<(SQLBaseParserCOMMENT-85))|(1<<(SQLBaseParserVIEW-85))|(1<<(SQLBaseParserREPLACE-85))|(1<<(SQLBaseParserGRANT-85))|(1<<(SQLBaseParserREVOKE-85))|(1<<(SQLBaseParserPRIVILEGES-85))|(1<<(SQLBaseParserPUBLIC-85))|(1<<(SQLBaseParserOPTION-85))|(1<<(SQLBaseParserEXPLAIN-85))|(1<<(SQLBaseParserANALYZE-85))|(1<<(SQLBaseParserFORMAT-85)))) != 0) || (((_la-117)&-(0x1f+1)) == 0 && ((1<<uint((_la-117)))&((1<<(SQLBaseParserTYPE-117))|(1<<(SQLBaseParserTEXT-117))|(1<<(SQLBaseParserGRAPHVIZ-117))|(1<<(SQLBaseParserLOGICAL-117))|(1<<(SQLBaseParserDISTRIBUTED-117))|(1<<(SQLBaseParserVALIDATE-117))|(1<<(SQLBaseParserSHOW-117))|(1<<(SQLBaseParserTABLES-117))|(1<<(SQLBaseParserSCHEMAS-117))|(1<<(SQLBaseParserCATALOGS-117))|(1<<(SQLBaseParserCOLUMNS-117))|(1<<(SQLBaseParserCOLUMN-117))|(1<<(SQLBaseParserUSE-117))|(1<<(SQLBaseParserPARTITIONS-117))|(1<<(SQLBaseParserFUNCTIONS-117))|(1<<(SQLBaseParserTO-117))|(1<<(SQLBaseParserSYSTEM-117))|(1<<(SQLBaseParserBERNOULLI-117))|(1<<(SQLBaseParserPOISSONIZED-117))|(1<<(SQLBaseParserTABLESAMPLE-117))|(1<<(SQLBaseParserARRAY-117))|(1<<(SQLBaseParserMAP-117)))) != 0) || (((_la-149)&-(0x1f+1)) == 0 && ((1<<uint((_la-149)))&((1<<(SQLBaseParserSET-149))|(1<<(SQLBaseParserRESET-149))|(1<<(SQLBaseParserSESSION-149))|(1<<(SQLBaseParserDATA-149))|(1<<(SQLBaseParserSTART-149))|(1<<(SQLBaseParserTRANSACTION-149))|(1<<(SQLBaseParserCOMMIT-149))|(1<<(SQLBaseParserROLLBACK-149))|(1<<(SQLBaseParserWORK-149))|(1<<(SQLBaseParserISOLATION-149))|(1<<(SQLBaseParserLEVEL-149))|(1<<(SQLBaseParserSERIALIZABLE-149))|(1<<(SQLBaseParserREPEATABLE-149))|(1<<(SQLBaseParserCOMMITTED-149))|(1<<(SQLBaseParserUNCOMMITTED-149))|(1<<(SQLBaseParserREAD-149))|(1<<(SQLBaseParserWRITE-149))|(1<<(SQLBaseParserONLY-149))|(1<<(SQLBaseParserCALL-149))|(1<<(SQLBaseParserINPUT-149))|(1<<(SQLBaseParserOUTPUT-149))|(1<<(SQLBaseParserCASCADE-149))|(1<<(SQLBaseParserRESTRICT-149))|(1<<(SQLBaseParserINCLUDING-149))|(1<<(SQLBaseParserEXCLUDING-149))|(1<<(SQLBaseParserPROPERTIES-149))|(1<<(SQLBaseParserNFD-149))|(1<<(SQLBaseParserNFC-149)))) != 0) || (((_la-181)&-(0x1f+1)) == 0 && ((1<<uint((_la-181)))&((1<<(SQLBaseParserNFKD-181))|(1<<(SQLBaseParserNFKC-181))|(1<<(SQLBaseParserIF-181))|(1<<(SQLBaseParserNULLIF-181))|(1<<(SQLBaseParserCOALESCE-181))|(1<<(SQLBaseParserIDENTIFIER-181))|(1<<(SQLBaseParserDIGIT_IDENTIFIER-181))|(1<<(SQLBaseParserQUOTED_IDENTIFIER-181))|(1<<(SQLBaseParserBACKQUOTED_IDENTIFIER-181)))) != 0) || _la == SQLBaseParserSYMMETRIC {
 			{
 				p.SetState(581)
 				p.QualifiedName()
@@ -7540,7 +7544,7 @@ func (p *SQLBaseParser) GroupingElement() (localctx IGroupingElementContext) {
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLBaseParserADD)|(1<<SQLBaseParserALL)|(1<<SQLBaseParserSOME)|(1<<SQLBaseParserANY)|(1<<SQLBaseParserAT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SQLBaseParserNO-33))|(1<<(SQLBaseParserSUBSTRING-33))|(1<<(SQLBaseParserPOSITION-33))|(1<<(SQLBaseParserTINYINT-33))|(1<<(SQLBaseParserSMALLINT-33))|(1<<(SQLBaseParserINTEGER-33))|(1<<(SQLBaseParserDATE-33))|(1<<(SQLBaseParserTIME-33))|(1<<(SQLBaseParserTIMESTAMP-33))|(1<<(SQLBaseParserINTERVAL-33))|(1<<(SQLBaseParserYEAR-33))|(1<<(SQLBaseParserMONTH-33))|(1<<(SQLBaseParserDAY-33))|(1<<(SQLBaseParserHOUR-33))|(1<<(SQLBaseParserMINUTE-33))|(1<<(SQLBaseParserSECOND-33))|(1<<(SQLBaseParserZONE-33)))) != 0) || (((_la-85)&-(0x1f+1)) == 0 && ((1<<uint((_la-85)))&((1<<(SQLBaseParserFILTER-85))|(1<<(SQLBaseParserOVER-85))|(1<<(SQLBaseParserPARTITION-85))|(1<<(SQLBaseParserRANGE-85))|(1<<(SQLBaseParserROWS-85))|(1<<(SQLBaseParserPRECEDING-85))|(1<<(SQLBaseParserFOLLOWING-85))|(1<<(SQLBaseParserCURRENT-85))|(1<<(SQLBaseParserROW-85))|(1<<(SQLBaseParserSCHEMA-85))|(1<<(SQLBaseParserCOMMENT-85))|(1<<(SQLBaseParserVIEW-85))|(1<<(SQLBaseParserREPLACE-85))|(1<<(SQLBaseParserGRANT-85))|(1<<(SQLBaseParserREVOKE-85))|(1<<(SQLBaseParserPRIVILEGES-85))|(1<<(SQLBaseParserPUBLIC-85))|(1<<(SQLBaseParserOPTION-85))|(1<<(SQLBaseParserEXPLAIN-85))|(1<<(SQLBaseParserANALYZE-85))|(1<<(SQLBaseParserFORMAT-85)))) != 0) || (((_la-117)&-(0x1f+1)) == 0 && ((1<<uint((_la-117)))&((1<<(SQLBaseParserTYPE-117))|(1<<(SQLBaseParserTEXT-117))|(1<<(SQLBaseParserGRAPHVIZ-117))|(1<<(SQLBaseParserLOGICAL-117))|(1<<(SQLBaseParserDISTRIBUTED-117))|(1<<(SQLBaseParserVALIDATE-117))|(1<<(SQLBaseParserSHOW-117))|(1<<(SQLBaseParserTABLES-117))|(1<<(SQLBaseParserSCHEMAS-117))|(1<<(SQLBaseParserCATALOGS-117))|(1<<(SQLBaseParserCOLUMNS-117))|(1<<(SQLBaseParserCOLUMN-117))|(1<<(SQLBaseParserUSE-117))|(1<<(SQLBaseParserPARTITIONS-117))|(1<<(SQLBaseParserFUNCTIONS-117))|(1<<(SQLBaseParserTO-117))|(1<<(SQLBaseParserSYSTEM-117))|(1<<(SQLBaseParserBERNOULLI-117))|(1<<(SQLBaseParserPOISSONIZED-117))|(1<<(SQLBaseParserTABLESAMPLE-117))|(1<<(SQLBaseParserARRAY-117))|(1<<(SQLBaseParserMAP-117)))) != 0) || (((_la-149)&-(0x1f+1)) == 0 && ((1<<uint((_la-149)))&((1<<(SQLBaseParserSET-149))|(1<<(SQLBaseParserRESET-149))|(1<<(SQLBaseParserSESSION-149))|(1<<(SQLBaseParserDATA-149))|(1<<(SQLBaseParserSTART-149))|(1<<(SQLBaseParserTRANSACTION-149))|(1<<(SQLBaseParserCOMMIT-149))|(1<<(SQLBaseParserROLLBACK-149))|(1<<(SQLBaseParserWORK-149))|(1<<(SQLBaseParserISOLATION-149))|(1<<(SQLBaseParserLEVEL-149))|(1<<(SQLBaseParserSERIALIZABLE-149))|(1<<(SQLBaseParserREPEATABLE-149))|(1<<(SQLBaseParserCOMMITTED-149))|(1<<(SQLBaseParserUNCOMMITTED-149))|(1<<(SQLBaseParserREAD-149))|(1<<(SQLBaseParserWRITE-149))|(1<<(SQLBaseParserONLY-149))|(1<<(SQLBaseParserCALL-149))|(1<<(SQLBaseParserINPUT-149))|(1<<(SQLBaseParserOUTPUT-149))|(1<<(SQLBaseParserCASCADE-149))|(1<<(SQLBaseParserRESTRICT-149))|(1<<(SQLBaseParserINCLUDING-149))|(1<<(SQLBaseParserEXCLUDING-149))|(1<<(SQLBaseParserPROPERTIES-149))|(1<<(SQLBaseParserNFD-149))|(1<<(SQLBaseParserNFC-149)))) != 0) || (((_la-181)&-(0x1f+1)) == 0 && ((1<<uint((_la-181)))&((1<<(SQLBaseParserNFKD-181))|(1<<(SQLBaseParserNFKC-181))|(1<<(SQLBaseParserIF-181))|(1<<(SQLBaseParserNULLIF-181))|(1<<(SQLBaseParserCOALESCE-181))|(1<<(SQLBaseParserIDENTIFIER-181))|(1<<(SQLBaseParserDIGIT_IDENTIFIER-181))|(1<<(SQLBaseParserQUOTED_IDENTIFIER-181))|(1<<(SQLBaseParserBACKQUOTED_IDENTIFIER-181)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLBaseParserADD)|(1<<SQLBaseParserALL)|(1<<SQLBaseParserSOME)|(1<<SQLBaseParserANY)|(1<<SQLBaseParserAT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SQLBaseParserNO-33))|(1<<(SQLBaseParserSUBSTRING-33))|(1<<(SQLBaseParserPOSITION-33))|(1<<(SQLBaseParserTINYINT-33))|(1<<(SQLBaseParserSMALLINT-33))|(1<<(SQLBaseParserINTEGER-33))|(1<<(SQLBaseParserDATE-33))|(1<<(SQLBaseParserTIME-33))|(1<<(SQLBaseParserTIMESTAMP-33))|(1<<(SQLBaseParserINTERVAL-33))|(1<<(SQLBaseParserYEAR-33))|(1<<(SQLBaseParserMONTH-33))|(1<<(SQLBaseParserDAY-33))|(1<<(SQLBaseParserHOUR-33))|(1<<(SQLBaseParserMINUTE-33))|(1<<(SQLBaseParserSECOND-33))|(1<<(SQLBaseParserZONE-33)))) != 0) || (((_la-85)&-(0x1f+1)) == 0 && ((1<<uint((_la-85)))&((1<<(SQLBaseParserFILTER-85))|(1<<(SQLBaseParserOVER-85))|(1<<(SQLBaseParserPARTITION-85))|(1<<(SQLBaseParserRANGE-85))|(1<<(SQLBaseParserROWS-85))|(1<<(SQLBaseParserPRECEDING-85))|(1<<(SQLBaseParserFOLLOWING-85))|(1<<(SQLBaseParserCURRENT-85))|(1<<(SQLBaseParserROW-85))|(1<<(SQLBaseParserSCHEMA-85))|(1<<(SQLBaseParserCOMMENT-85))|(1<<(SQLBaseParserVIEW-85))|(1<<(SQLBaseParserREPLACE-85))|(1<<(SQLBaseParserGRANT-85))|(1<<(SQLBaseParserREVOKE-85))|(1<<(SQLBaseParserPRIVILEGES-85))|(1<<(SQLBaseParserPUBLIC-85))|(1<<(SQLBaseParserOPTION-85))|(1<<(SQLBaseParserEXPLAIN-85))|(1<<(SQLBaseParserANALYZE-85))|(1<<(SQLBaseParserFORMAT-85)))) != 0) || (((_la-117)&-(0x1f+1)) == 0 && ((1<<uint((_la-117)))&((1<<(SQLBaseParserTYPE-117))|(1<<(SQLBaseParserTEXT-117))|(1<<(SQLBaseParserGRAPHVIZ-117))|(1<<(SQLBaseParserLOGICAL-117))|(1<<(SQLBaseParserDISTRIBUTED-117))|(1<<(SQLBaseParserVALIDATE-117))|(1<<(SQLBaseParserSHOW-117))|(1<<(SQLBaseParserTABLES-117))|(1<<(SQLBaseParserSCHEMAS-117))|(1<<(SQLBaseParserCATALOGS-117))|(1<<(SQLBaseParserCOLUMNS-117))|(1<<(SQLBaseParserCOLUMN-117))|(1<<(SQLBaseParserUSE-117))|(1<<(SQLBaseParserPARTITIONS-117))|(1<<(SQLBaseParserFUNCTIONS-117))|(1<<(SQLBaseParserTO-117))|(1<<(SQLBaseParserSYSTEM-117))|(1<<(SQLBaseParserBERNOULLI-117))|(1<<(SQLBaseParserPOISSONIZED-117))|(1<<(SQLBaseParserTABLESAMPLE-117))|(1<<(SQLBaseParserARRAY-117))|(1<<(SQLBaseParserMAP-117)))) != 0) || (((_la-149)&-(0x1f+1)) == 0 && ((1<<uint((_la-149)))&((1<<(SQLBaseParserSET-149))|(1<<(SQLBaseParserRESET-149))|(1<<(SQLBaseParserSESSION-149))|(1<<(SQLBaseParserDATA-149))|(1<<(SQLBaseParserSTART-149))|(1<<(SQLBaseParserTRANSACTION-149))|(1<<(SQLBaseParserCOMMIT-149))|(1<<(SQLBaseParserROLLBACK-149))|(1<<(SQLBaseParserWORK-149))|(1<<(SQLBaseParserISOLATION-149))|(1<<(SQLBaseParserLEVEL-149))|(1<<(SQLBaseParserSERIALIZABLE-149))|(1<<(SQLBaseParserREPEATABLE-149))|(1<<(SQLBaseParserCOMMITTED-149))|(1<<(SQLBaseParserUNCOMMITTED-149))|(1<<(SQLBaseParserREAD-149))|(1<<(SQLBaseParserWRITE-149))|(1<<(SQLBaseParserONLY-149))|(1<<(SQLBaseParserCALL-149))|(1<<(SQLBaseParserINPUT-149))|(1<<(SQLBaseParserOUTPUT-149))|(1<<(SQLBaseParserCASCADE-149))|(1<<(SQLBaseParserRESTRICT-149))|(1<<(SQLBaseParserINCLUDING-149))|(1<<(SQLBaseParserEXCLUDING-149))|(1<<(SQLBaseParserPROPERTIES-149))|(1<<(SQLBaseParserNFD-149))|(1<<(SQLBaseParserNFC-149)))) != 0) || (((_la-181)&-(0x1f+1)) == 0 && ((1<<uint((_la-181)))&((1<<(SQLBaseParserNFKD-181))|(1<<(SQLBaseParserNFKC-181))|(1<<(SQLBaseParserIF-181))|(1<<(SQLBaseParserNULLIF-181))|(1<<(SQLBaseParserCOALESCE-181))|(1<<(SQLBaseParserIDENTIFIER-181))|(1<<(SQLBaseParserDIGIT_IDENTIFIER-181))|(1<<(SQLBaseParserQUOTED_IDENTIFIER-181))|(1<<(SQLBaseParserBACKQUOTED_IDENTIFIER-181)))) != 0) || _la == SQLBaseParserSYMMETRIC {
 			{
 				p.SetState(594)
 				p.QualifiedName()
@@ -7734,7 +7738,7 @@ func (p *SQLBaseParser) GroupingExpressions() (localctx IGroupingExpressionsCont
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLBaseParserT__0)|(1<<SQLBaseParserT__3)|(1<<SQLBaseParserADD)|(1<<SQLBaseParserALL)|(1<<SQLBaseParserSOME)|(1<<SQLBaseParserANY)|(1<<SQLBaseParserAT))) != 0) || (((_la-32)&-(0x1f+1)) == 0 && ((1<<uint((_la-32)))&((1<<(SQLBaseParserNOT-32))|(1<<(SQLBaseParserNO-32))|(1<<(SQLBaseParserEXISTS-32))|(1<<(SQLBaseParserNULL-32))|(1<<(SQLBaseParserTRUE-32))|(1<<(SQLBaseParserFALSE-32))|(1<<(SQLBaseParserSUBSTRING-32))|(1<<(SQLBaseParserPOSITION-32))|(1<<(SQLBaseParserTINYINT-32))|(1<<(SQLBaseParserSMALLINT-32))|(1<<(SQLBaseParserINTEGER-32))|(1<<(SQLBaseParserDATE-32))|(1<<(SQLBaseParserTIME-32))|(1<<(SQLBaseParserTIMESTAMP-32))|(1<<(SQLBaseParserINTERVAL-32))|(1<<(SQLBaseParserYEAR-32))|(1<<(SQLBaseParserMONTH-32))|(1<<(SQLBaseParserDAY-32))|(1<<(SQLBaseParserHOUR-32))|(1<<(SQLBaseParserMINUTE-32))|(1<<(SQLBaseParserSECOND-32))|(1<<(SQLBaseParserZONE-32)))) != 0) || (((_la-64)&-(0x1f+1)) == 0 && ((1<<uint((_la-64)))&((1<<(SQLBaseParserCURRENT_DATE-64))|(1<<(SQLBaseParserCURRENT_TIME-64))|(1<<(SQLBaseParserCURRENT_TIMESTAMP-64))|(1<<(SQLBaseParserLOCALTIME-64))|(1<<(SQLBaseParserLOCALTIMESTAMP-64))|(1<<(SQLBaseParserEXTRACT-64))|(1<<(SQLBaseParserCASE-64))|(1<<(SQLBaseParserFILTER-64))|(1<<(SQLBaseParserOVER-64))|(1<<(SQLBaseParserPARTITION-64))|(1<<(SQLBaseParserRANGE-64))|(1<<(SQLBaseParserROWS-64))|(1<<(SQLBaseParserPRECEDING-64))|(1<<(SQLBaseParserFOLLOWING-64))|(1<<(SQLBaseParserCURRENT-64))|(1<<(SQLBaseParserROW-64)))) != 0) || (((_la-99)&-(0x1f+1)) == 0 && ((1<<uint((_la-99)))&((1<<(SQLBaseParserSCHEMA-99))|(1<<(SQLBaseParserCOMMENT-99))|(1<<(SQLBaseParserVIEW-99))|(1<<(SQLBaseParserREPLACE-99))|(1<<(SQLBaseParserGRANT-99))|(1<<(SQLBaseParserREVOKE-99))|(1<<(SQLBaseParserPRIVILEGES-99))|(1<<(SQLBaseParserPUBLIC-99))|(1<<(SQLBaseParserOPTION-99))|(1<<(SQLBaseParserEXPLAIN-99))|(1<<(SQLBaseParserANALYZE-99))|(1<<(SQLBaseParserFORMAT-99))|(1<<(SQLBaseParserTYPE-99))|(1<<(SQLBaseParserTEXT-99))|(1<<(SQLBaseParserGRAPHVIZ-99))|(1<<(SQLBaseParserLOGICAL-99))|(1<<(SQLBaseParserDISTRIBUTED-99))|(1<<(SQLBaseParserVALIDATE-99))|(1<<(SQLBaseParserCAST-99))|(1<<(SQLBaseParserTRY_CAST-99))|(1<<(SQLBaseParserSHOW-99))|(1<<(SQLBaseParserTABLES-99))|(1<<(SQLBaseParserSCHEMAS-99))|(1<<(SQLBaseParserCATALOGS-99))|(1<<(SQLBaseParserCOLUMNS-99))|(1<<(SQLBaseParserCOLUMN-99)))) != 0) || (((_la-131)&-(0x1f+1)) == 0 && ((1<<uint((_la-131)))&((1<<(SQLBaseParserUSE-131))|(1<<(SQLBaseParserPARTITIONS-131))|(1<<(SQLBaseParserFUNCTIONS-131))|(1<<(SQLBaseParserTO-131))|(1<<(SQLBaseParserSYSTEM-131))|(1<<(SQLBaseParserBERNOULLI-131))|(1<<(SQLBaseParserPOISSONIZED-131))|(1<<(SQLBaseParserTABLESAMPLE-131))|(1<<(SQLBaseParserARRAY-131))|(1<<(SQLBaseParserMAP-131))|(1<<(SQLBaseParserSET-131))|(1<<(SQLBaseParserRESET-131))|(1<<(SQLBaseParserSESSION-131))|(1<<(SQLBaseParserDATA-131))|(1<<(SQLBaseParserSTART-131))|(1<<(SQLBaseParserTRANSACTION-131))|(1<<(SQLBaseParserCOMMIT-131))|(1<<(SQLBaseParserROLLBACK-131))|(1<<(SQLBaseParserWORK-131))|(1<<(SQLBaseParserISOLATION-131))|(1<<(SQLBaseParserLEVEL-131))|(1<<(SQLBaseParserSERIALIZABLE-131))|(1<<(SQLBaseParserREPEATABLE-131))|(1<<(SQLBaseParserCOMMITTED-131)))) != 0) || (((_la-163)&-(0x1f+1)) == 0 && ((1<<uint((_la-163)))&((1<<(SQLBaseParserUNCOMMITTED-163))|(1<<(SQLBaseParserREAD-163))|(1<<(SQLBaseParserWRITE-163))|(1<<(SQLBaseParserONLY-163))|(1<<(SQLBaseParserCALL-163))|(1<<(SQLBaseParserINPUT-163))|(1<<(SQLBaseParserOUTPUT-163))|(1<<(SQLBaseParserCASCADE-163))|(1<<(SQLBaseParserRESTRICT-163))|(1<<(SQLBaseParserINCLUDING-163))|(1<<(SQLBaseParserEXCLUDING-163))|(1<<(SQLBaseParserPROPERTIES-163))|(1<<(SQLBaseParserNORMALIZE-163))|(1<<(SQLBaseParserNFD-163))|(1<<(SQLBaseParserNFC-163))|(1<<(SQLBaseParserNFKD-163))|(1<<(SQLBaseParserNFKC-163))|(1<<(SQLBaseParserIF-163))|(1<<(SQLBaseParserNULLIF-163))|(1<<(SQLBaseParserCOALESCE-163))|(1<<(SQLBaseParserTIME_WITH_TIME_ZONE-163))|(1<<(SQLBaseParserTIMESTAMP_WITH_TIME_ZONE-163))|(1<<(SQLBaseParserDOUBLE_PRECISION-163)))) != 0) || (((_la-195)&-(0x1f+1)) == 0 && ((1<<uint((_la-195)))&((1<<(SQLBaseParserPLUS-195))|(1<<(SQLBaseParserMINUS-195))|(1<<(SQLBaseParserSTRING-195))|(1<<(SQLBaseParserBINARY_LITERAL-195))|(1<<(SQLBaseParserINTEGER_VALUE-195))|(1<<(SQLBaseParserDECIMAL_VALUE-195))|(1<<(SQLBaseParserIDENTIFIER-195))|(1<<(SQLBaseParserDIGIT_IDENTIFIER-195))|(1<<(SQLBaseParserQUOTED_IDENTIFIER-195))|(1<<(SQLBaseParserBACKQUOTED_IDENTIFIER-195)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLBaseParserT__0)|(1<<SQLBaseParserT__3)|(1<<SQLBaseParserADD)|(1<<SQLBaseParserALL)|(1<<SQLBaseParserSOME)|(1<<SQLBaseParserANY)|(1<<SQLBaseParserAT))) != 0) || (((_la-32)&-(0x1f+1)) == 0 && ((1<<uint((_la-32)))&((1<<(SQLBaseParserNOT-32))|(1<<(SQLBaseParserNO-32))|(1<<(SQLBaseParserEXISTS-32))|(1<<(SQLBaseParserNULL-32))|(1<<(SQLBaseParserTRUE-32))|(1<<(SQLBaseParserFALSE-32))|(1<<(SQLBaseParserSUBSTRING-32))|(1<<(SQLBaseParserPOSITION-32))|(1<<(SQLBaseParserTINYINT-32))|(1<<(SQLBaseParserSMALLINT-32))|(1<<(SQLBaseParserINTEGER-32))|(1<<(SQLBaseParserDATE-32))|(1<<(SQLBaseParserTIME-32))|(1<<(SQLBaseParserTIMESTAMP-32))|(1<<(SQLBaseParserINTERVAL-32))|(1<<(SQLBaseParserYEAR-32))|(1<<(SQLBaseParserMONTH-32))|(1<<(SQLBaseParserDAY-32))|(1<<(SQLBaseParserHOUR-32))|(1<<(SQLBaseParserMINUTE-32))|(1<<(SQLBaseParserSECOND-32))|(1<<(SQLBaseParserZONE-32)))) != 0) || (((_la-64)&-(0x1f+1)) == 0 && ((1<<uint((_la-64)))&((1<<(SQLBaseParserCURRENT_DATE-64))|(1<<(SQLBaseParserCURRENT_TIME-64))|(1<<(SQLBaseParserCURRENT_TIMESTAMP-64))|(1<<(SQLBaseParserLOCALTIME-64))|(1<<(SQLBaseParserLOCALTIMESTAMP-64))|(1<<(SQLBaseParserEXTRACT-64))|(1<<(SQLBaseParserCASE-64))|(1<<(SQLBaseParserFILTER-64))|(1<<(SQLBaseParserOVER-64))|(1<<(SQLBaseParserPARTITION-64))|(1<<(SQLBaseParserRANGE-64))|(1<<(SQLBaseParserROWS-64))|(1<<(SQLBaseParserPRECEDING-64))|(1<<(SQLBaseParserFOLLOWING-64))|(1<<(SQLBaseParserCURRENT-64))|(1<<(SQLBaseParserROW-64)))) != 0) || (((_la-99)&-(0x1f+1)) == 0 && ((1<<uint((_la-99)))&((1<<(SQLBaseParserSCHEMA-99))|(1<<(SQLBaseParserCOMMENT-99))|(1<<(SQLBaseParserVIEW-99))|(1<<(SQLBaseParserREPLACE-99))|(1<<(SQLBaseParserGRANT-99))|(1<<(SQLBaseParserREVOKE-99))|(1<<(SQLBaseParserPRIVILEGES-99))|(1<<(SQLBaseParserPUBLIC-99))|(1<<(SQLBaseParserOPTION-99))|(1<<(SQLBaseParserEXPLAIN-99))|(1<<(SQLBaseParserANALYZE-99))|(1<<(SQLBaseParserFORMAT-99))|(1<<(SQLBaseParserTYPE-99))|(1<<(SQLBaseParserTEXT-99))|(1<<(SQLBaseParserGRAPHVIZ-99))|(1<<(SQLBaseParserLOGICAL-99))|(1<<(SQLBaseParserDISTRIBUTED-99))|(1<<(SQLBaseParserVALIDATE-99))|(1<<(SQLBaseParserCAST-99))|(1<<(SQLBaseParserTRY_CAST-99))|(1<<(SQLBaseParserSHOW-99))|(1<<(SQLBaseParserTABLES-99))|(1<<(SQLBaseParserSCHEMAS-99))|(1<<(SQLBaseParserCATALOGS-99))|(1<<(SQLBaseParserCOLUMNS-99))|(1<<(SQLBaseParserCOLUMN-99)))) != 0) || (((_la-131)&-(0x1f+1)) == 0 && ((1<<uint((_la-131)))&((1<<(SQLBaseParserUSE-131))|(1<<(SQLBaseParserPARTITIONS-131))|(1<<(SQLBaseParserFUNCTIONS-131))|(1<<(SQLBaseParserTO-131))|(1<<(SQLBaseParserSYSTEM-131))|(1<<(SQLBaseParserBERNOULLI-131))|(1<<(SQLBaseParserPOISSONIZED-131))|(1<<(SQLBaseParserTABLESAMPLE-131))|(1<<(SQLBaseParserARRAY-131))|(1<<(SQLBaseParserMAP-131))|(1<<(SQLBaseParserSET-131))|(1<<(SQLBaseParserRESET-131))|(1<<(SQLBaseParserSESSION-131))|(1<<(SQLBaseParserDATA-131))|(1<<(SQLBaseParserSTART-131))|(1<<(SQLBaseParserTRANSACTION-131))|(1<<(SQLBaseParserCOMMIT-131))|(1<<(SQLBaseParserROLLBACK-131))|(1<<(SQLBaseParserWORK-131))|(1<<(SQLBaseParserISOLATION-131))|(1<<(SQLBaseParserLEVEL-131))|(1<<(SQLBaseParserSERIALIZABLE-131))|(1<<(SQLBaseParserREPEATABLE-131))|(1<<(SQLBaseParserCOMMITTED-131)))) != 0) || (((_la-163)&-(0x1f+1)) == 0 && ((1<<uint((_la-163)))&((1<<(SQLBaseParserUNCOMMITTED-163))|(1<<(SQLBaseParserREAD-163))|(1<<(SQLBaseParserWRITE-163))|(1<<(SQLBaseParserONLY-163))|(1<<(SQLBaseParserCALL-163))|(1<<(SQLBaseParserINPUT-163))|(1<<(SQLBaseParserOUTPUT-163))|(1<<(SQLBaseParserCASCADE-163))|(1<<(SQLBaseParserRESTRICT-163))|(1<<(SQLBaseParserINCLUDING-163))|(1<<(SQLBaseParserEXCLUDING-163))|(1<<(SQLBaseParserPROPERTIES-163))|(1<<(SQLBaseParserNORMALIZE-163))|(1<<(SQLBaseParserNFD-163))|(1<<(SQLBaseParserNFC-163))|(1<<(SQLBaseParserNFKD-163))|(1<<(SQLBaseParserNFKC-163))|(1<<(SQLBaseParserIF-163))|(1<<(SQLBaseParserNULLIF-163))|(1<<(SQLBaseParserCOALESCE-163))|(1<<(SQLBaseParserTIME_WITH_TIME_ZONE-163))|(1<<(SQLBaseParserTIMESTAMP_WITH_TIME_ZONE-163))|(1<<(SQLBaseParserDOUBLE_PRECISION-163)))) != 0) || (((_la-195)&-(0x1f+1)) == 0 && ((1<<uint((_la-195)))&((1<<(SQLBaseParserPLUS-195))|(1<<(SQLBaseParserMINUS-195))|(1<<(SQLBaseParserSTRING-195))|(1<<(SQLBaseParserBINARY_LITERAL-195))|(1<<(SQLBaseParserINTEGER_VALUE-195))|(1<<(SQLBaseParserDECIMAL_VALUE-195))|(1<<(SQLBaseParserIDENTIFIER-195))|(1<<(SQLBaseParserDIGIT_IDENTIFIER-195))|(1<<(SQLBaseParserQUOTED_IDENTIFIER-195))|(1<<(SQLBaseParserBACKQUOTED_IDENTIFIER-195)))) != 0) || _la == SQLBaseParserSYMMETRIC {
 			{
 				p.SetState(621)
 				p.Expression()
@@ -7892,7 +7896,7 @@ func (p *SQLBaseParser) GroupingSet() (localctx IGroupingSetContext) {
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLBaseParserADD)|(1<<SQLBaseParserALL)|(1<<SQLBaseParserSOME)|(1<<SQLBaseParserANY)|(1<<SQLBaseParserAT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SQLBaseParserNO-33))|(1<<(SQLBaseParserSUBSTRING-33))|(1<<(SQLBaseParserPOSITION-33))|(1<<(SQLBaseParserTINYINT-33))|(1<<(SQLBaseParserSMALLINT-33))|(1<<(SQLBaseParserINTEGER-33))|(1<<(SQLBaseParserDATE-33))|(1<<(SQLBaseParserTIME-33))|(1<<(SQLBaseParserTIMESTAMP-33))|(1<<(SQLBaseParserINTERVAL-33))|(1<<(SQLBaseParserYEAR-33))|(1<<(SQLBaseParserMONTH-33))|(1<<(SQLBaseParserDAY-33))|(1<<(SQLBaseParserHOUR-33))|(1<<(SQLBaseParserMINUTE-33))|(1<<(SQLBaseParserSECOND-33))|(1<<(SQLBaseParserZONE-33)))) != 0) || (((_la-85)&-(0x1f+1)) == 0 && ((1<<uint((_la-85)))&((1<<(SQLBaseParserFILTER-85))|(1<<(SQLBaseParserOVER-85))|(1<<(SQLBaseParserPARTITION-85))|(1<<(SQLBaseParserRANGE-85))|(1<<(SQLBaseParserROWS-85))|(1<<(SQLBaseParserPRECEDING-85))|(1<<(SQLBaseParserFOLLOWING-85))|(1<<(SQLBaseParserCURRENT-85))|(1<<(SQLBaseParserROW-85))|(1<<(SQLBaseParserSCHEMA-85))|(1<<(SQLBaseParserCOMMENT-85))|(1<<(SQLBaseParserVIEW-85))|(1<<(SQLBaseParserREPLACE-85))|(1<<(SQLBaseParserGRANT-85))|(1<<(SQLBaseParserREVOKE-85))|(1<<(SQLBaseParserPRIVILEGES-85))|(1<<(SQLBaseParserPUBLIC-85))|(1<<(SQLBaseParserOPTION-85))|(1<<(SQLBaseParserEXPLAIN-85))|(1<<(SQLBaseParserANALYZE-85))|(1<<(SQLBaseParserFORMAT-85)))) != 0) || (((_la-117)&-(0x1f+1)) == 0 && ((1<<uint((_la-117)))&((1<<(SQLBaseParserTYPE-117))|(1<<(SQLBaseParserTEXT-117))|(1<<(SQLBaseParserGRAPHVIZ-117))|(1<<(SQLBaseParserLOGICAL-117))|(1<<(SQLBaseParserDISTRIBUTED-117))|(1<<(SQLBaseParserVALIDATE-117))|(1<<(SQLBaseParserSHOW-117))|(1<<(SQLBaseParserTABLES-117))|(1<<(SQLBaseParserSCHEMAS-117))|(1<<(SQLBaseParserCATALOGS-117))|(1<<(SQLBaseParserCOLUMNS-117))|(1<<(SQLBaseParserCOLUMN-117))|(1<<(SQLBaseParserUSE-117))|(1<<(SQLBaseParserPARTITIONS-117))|(1<<(SQLBaseParserFUNCTIONS-117))|(1<<(SQLBaseParserTO-117))|(1<<(SQLBaseParserSYSTEM-117))|(1<<(SQLBaseParserBERNOULLI-117))|(1<<(SQLBaseParserPOISSONIZED-117))|(1<<(SQLBaseParserTABLESAMPLE-117))|(1<<(SQLBaseParserARRAY-117))|(1<<(SQLBaseParserMAP-117)))) != 0) || (((_la-149)&-(0x1f+1)) == 0 && ((1<<uint((_la-149)))&((1<<(SQLBaseParserSET-149))|(1<<(SQLBaseParserRESET-149))|(1<<(SQLBaseParserSESSION-149))|(1<<(SQLBaseParserDATA-149))|(1<<(SQLBaseParserSTART-149))|(1<<(SQLBaseParserTRANSACTION-149))|(1<<(SQLBaseParserCOMMIT-149))|(1<<(SQLBaseParserROLLBACK-149))|(1<<(SQLBaseParserWORK-149))|(1<<(SQLBaseParserISOLATION-149))|(1<<(SQLBaseParserLEVEL-149))|(1<<(SQLBaseParserSERIALIZABLE-149))|(1<<(SQLBaseParserREPEATABLE-149))|(1<<(SQLBaseParserCOMMITTED-149))|(1<<(SQLBaseParserUNCOMMITTED-149))|(1<<(SQLBaseParserREAD-149))|(1<<(SQLBaseParserWRITE-149))|(1<<(SQLBaseParserONLY-149))|(1<<(SQLBaseParserCALL-149))|(1<<(SQLBaseParserINPUT-149))|(1<<(SQLBaseParserOUTPUT-149))|(1<<(SQLBaseParserCASCADE-149))|(1<<(SQLBaseParserRESTRICT-149))|(1<<(SQLBaseParserINCLUDING-149))|(1<<(SQLBaseParserEXCLUDING-149))|(1<<(SQLBaseParserPROPERTIES-149))|(1<<(SQLBaseParserNFD-149))|(1<<(SQLBaseParserNFC-149)))) != 0) || (((_la-181)&-(0x1f+1)) == 0 && ((1<<uint((_la-181)))&((1<<(SQLBaseParserNFKD-181))|(1<<(SQLBaseParserNFKC-181))|(1<<(SQLBaseParserIF-181))|(1<<(SQLBaseParserNULLIF-181))|(1<<(SQLBaseParserCOALESCE-181))|(1<<(SQLBaseParserIDENTIFIER-181))|(1<<(SQLBaseParserDIGIT_IDENTIFIER-181))|(1<<(SQLBaseParserQUOTED_IDENTIFIER-181))|(1<<(SQLBaseParserBACKQUOTED_IDENTIFIER-181)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLBaseParserADD)|(1<<SQLBaseParserALL)|(1<<SQLBaseParserSOME)|(1<<SQLBaseParserANY)|(1<<SQLBaseParserAT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SQLBaseParserNO-33))|(1<<(SQLBaseParserSUBSTRING-33))|(1<<(SQLBaseParserPOSITION-33))|(1<<(SQLBaseParserTINYINT-33))|(1<<(SQLBaseParserSMALLINT-33))|(1<<(SQLBaseParserINTEGER-33))|(1<<(SQLBaseParserDATE-33))|(1<<(SQLBaseParserTIME-33))|(1<<(SQLBaseParserTIMESTAMP-33))|(1<<(SQLBaseParserINTERVAL-33))|(1<<(SQLBaseParserYEAR-33))|(1<<(SQLBaseParserMONTH-33))|(1<<(SQLBaseParserDAY-33))|(1<<(SQLBaseParserHOUR-33))|(1<<(SQLBaseParserMINUTE-33))|(1<<(SQLBaseParserSECOND-33))|(1<<(SQLBaseParserZONE-33)))) != 0) || (((_la-85)&-(0x1f+1)) == 0 && ((1<<uint((_la-85)))&((1<<(SQLBaseParserFILTER-85))|(1<<(SQLBaseParserOVER-85))|(1<<(SQLBaseParserPARTITION-85))|(1<<(SQLBaseParserRANGE-85))|(1<<(SQLBaseParserROWS-85))|(1<<(SQLBaseParserPRECEDING-85))|(1<<(SQLBaseParserFOLLOWING-85))|(1<<(SQLBaseParserCURRENT-85))|(1<<(SQLBaseParserROW-85))|(1<<(SQLBaseParserSCHEMA-85))|(1<<(SQLBaseParserCOMMENT-85))|(1<<(SQLBaseParserVIEW-85))|(1<<(SQLBaseParserREPLACE-85))|(1<<(SQLBaseParserGRANT-85))|(1<<(SQLBaseParserREVOKE-85))|(1<<(SQLBaseParserPRIVILEGES-85))|(1<<(SQLBaseParserPUBLIC-85))|(1<<(SQLBaseParserOPTION-85))|(1<<(SQLBaseParserEXPLAIN-85))|(1<<(SQLBaseParserANALYZE-85))|(1<<(SQLBaseParserFORMAT-85)))) != 0) || (((_la-117)&-(0x1f+1)) == 0 && ((1<<uint((_la-117)))&((1<<(SQLBaseParserTYPE-117))|(1<<(SQLBaseParserTEXT-117))|(1<<(SQLBaseParserGRAPHVIZ-117))|(1<<(SQLBaseParserLOGICAL-117))|(1<<(SQLBaseParserDISTRIBUTED-117))|(1<<(SQLBaseParserVALIDATE-117))|(1<<(SQLBaseParserSHOW-117))|(1<<(SQLBaseParserTABLES-117))|(1<<(SQLBaseParserSCHEMAS-117))|(1<<(SQLBaseParserCATALOGS-117))|(1<<(SQLBaseParserCOLUMNS-117))|(1<<(SQLBaseParserCOLUMN-117))|(1<<(SQLBaseParserUSE-117))|(1<<(SQLBaseParserPARTITIONS-117))|(1<<(SQLBaseParserFUNCTIONS-117))|(1<<(SQLBaseParserTO-117))|(1<<(SQLBaseParserSYSTEM-117))|(1<<(SQLBaseParserBERNOULLI-117))|(1<<(SQLBaseParserPOISSONIZED-117))|(1<<(SQLBaseParserTABLESAMPLE-117))|(1<<(SQLBaseParserARRAY-117))|(1<<(SQLBaseParserMAP-117)))) != 0) || (((_la-149)&-(0x1f+1)) == 0 && ((1<<uint((_la-149)))&((1<<(SQLBaseParserSET-149))|(1<<(SQLBaseParserRESET-149))|(1<<(SQLBaseParserSESSION-149))|(1<<(SQLBaseParserDATA-149))|(1<<(SQLBaseParserSTART-149))|(1<<(SQLBaseParserTRANSACTION-149))|(1<<(SQLBaseParserCOMMIT-149))|(1<<(SQLBaseParserROLLBACK-149))|(1<<(SQLBaseParserWORK-149))|(1<<(SQLBaseParserISOLATION-149))|(1<<(SQLBaseParserLEVEL-149))|(1<<(SQLBaseParserSERIALIZABLE-149))|(1<<(SQLBaseParserREPEATABLE-149))|(1<<(SQLBaseParserCOMMITTED-149))|(1<<(SQLBaseParserUNCOMMITTED-149))|(1<<(SQLBaseParserREAD-149))|(1<<(SQLBaseParserWRITE-149))|(1<<(SQLBaseParserONLY-149))|(1<<(SQLBaseParserCALL-149))|(1<<(SQLBaseParserINPUT-149))|(1<<(SQLBaseParserOUTPUT-149))|(1<<(SQLBaseParserCASCADE-149))|(1<<(SQLBaseParserRESTRICT-149))|(1<<(SQLBaseParserINCLUDING-149))|(1<<(SQLBaseParserEXCLUDING-149))|(1<<(SQLBaseParserPROPERTIES-149))|(1<<(SQLBaseParserNFD-149))|(1<<(SQLBaseParserNFC-149)))) != 0) || (((_la-181)&-(0x1f+1)) == 0 && ((1<<uint((_la-181)))&((1<<(SQLBaseParserNFKD-181))|(1<<(SQLBaseParserNFKC-181))|(1<<(SQLBaseParserIF-181))|(1<<(SQLBaseParserNULLIF-181))|(1<<(SQLBaseParserCOALESCE-181))|(1<<(SQLBaseParserIDENTIFIER-181))|(1<<(SQLBaseParserDIGIT_IDENTIFIER-181))|(1<<(SQLBaseParserQUOTED_IDENTIFIER-181))|(1<<(SQLBaseParserBACKQUOTED_IDENTIFIER-181)))) != 0) || _la == SQLBaseParserSYMMETRIC {
 			{
 				p.SetState(636)
 				p.QualifiedName()
@@ -7922,7 +7926,7 @@ func (p *SQLBaseParser) GroupingSet() (localctx IGroupingSetContext) {
 			p.Match(SQLBaseParserT__2)
 		}
 
-	case SQLBaseParserADD, SQLBaseParserALL, SQLBaseParserSOME, SQLBaseParserANY, SQLBaseParserAT, SQLBaseParserNO, SQLBaseParserSUBSTRING, SQLBaseParserPOSITION, SQLBaseParserTINYINT, SQLBaseParserSMALLINT, SQLBaseParserINTEGER, SQLBaseParserDATE, SQLBaseParserTIME, SQLBaseParserTIMESTAMP, SQLBaseParserINTERVAL, SQLBaseParserYEAR, SQLBaseParserMONTH, SQLBaseParserDAY, SQLBaseParserHOUR, SQLBaseParserMINUTE, SQLBaseParserSECOND, SQLBaseParserZONE, SQLBaseParserFILTER, SQLBaseParserOVER, SQLBaseParserPARTITION, SQLBaseParserRANGE, SQLBaseParserROWS, SQLBaseParserPRECEDING, SQLBaseParserFOLLOWING, SQLBaseParserCURRENT, SQLBaseParserROW, SQLBaseParserSCHEMA, SQLBaseParserCOMMENT, SQLBaseParserVIEW, SQLBaseParserREPLACE, SQLBaseParserGRANT, SQLBaseParserREVOKE, SQLBaseParserPRIVILEGES, SQLBaseParserPUBLIC, SQLBaseParserOPTION, SQLBaseParserEXPLAIN, SQLBaseParserANALYZE, SQLBaseParserFORMAT, SQLBaseParserTYPE, SQLBaseParserTEXT, SQLBaseParserGRAPHVIZ, SQLBaseParserLOGICAL, SQLBaseParserDISTRIBUTED, SQLBaseParserVALIDATE, SQLBaseParserSHOW, SQLBaseParserTABLES, SQLBaseParserSCHEMAS, SQLBaseParserCATALOGS, SQLBaseParserCOLUMNS, SQLBaseParserCOLUMN, SQLBaseParserUSE, SQLBaseParserPARTITIONS, SQLBaseParserFUNCTIONS, SQLBaseParserTO, SQLBaseParserSYSTEM, SQLBaseParserBERNOULLI, SQLBaseParserPOISSONIZED, SQLBaseParserTABLESAMPLE, SQLBaseParserARRAY, SQLBaseParserMAP, SQLBaseParserSET, SQLBaseParserRESET, SQLBaseParserSESSION, SQLBaseParserDATA, SQLBaseParserSTART, SQLBaseParserTRANSACTION, SQLBaseParserCOMMIT, SQLBaseParserROLLBACK, SQLBaseParserWORK, SQLBaseParserISOLATION, SQLBaseParserLEVEL, SQLBaseParserSERIALIZABLE, SQLBaseParserREPEATABLE, SQLBaseParserCOMMITTED, SQLBaseParserUNCOMMITTED, SQLBaseParserREAD, SQLBaseParserWRITE, SQLBaseParserONLY, SQLBaseParserCALL, SQLBaseParserINPUT, SQLBaseParserOUTPUT, SQLBaseParserCASCADE, SQLBaseParserRESTRICT, SQLBaseParserINCLUDING, SQLBaseParserEXCLUDING, SQLBaseParserPROPERTIES, SQLBaseParserNFD, SQLBaseParserNFC, SQLBaseParserNFKD, SQLBaseParserNFKC, SQLBaseParserIF, SQLBaseParserNULLIF, SQLBaseParserCOALESCE, SQLBaseParserIDENTIFIER, SQLBaseParserDIGIT_IDENTIFIER, SQLBaseParserQUOTED_IDENTIFIER, SQLBaseParserBACKQUOTED_IDENTIFIER:
+	case SQLBaseParserADD, SQLBaseParserALL, SQLBaseParserSOME, SQLBaseParserANY, SQLBaseParserAT, SQLBaseParserNO, SQLBaseParserSUBSTRING, SQLBaseParserPOSITION, SQLBaseParserTINYINT, SQLBaseParserSMALLINT, SQLBaseParserINTEGER, SQLBaseParserDATE, SQLBaseParserTIME, SQLBaseParserTIMESTAMP, SQLBaseParserINTERVAL, SQLBaseParserYEAR, SQLBaseParserMONTH, SQLBaseParserDAY, SQLBaseParserHOUR, SQLBaseParserMINUTE, SQLBaseParserSECOND, SQLBaseParserZONE, SQLBaseParserFILTER, SQLBaseParserOVER, SQLBaseParserPARTITION, SQLBaseParserRANGE, SQLBaseParserROWS, SQLBaseParserPRECEDING, SQLBaseParserFOLLOWING, SQLBaseParserCURRENT, SQLBaseParserROW, SQLBaseParserSCHEMA, SQLBaseParserCOMMENT, SQLBaseParserVIEW, SQLBaseParserREPLACE, SQLBaseParserGRANT, SQLBaseParserREVOKE, SQLBaseParserPRIVILEGES, SQLBaseParserPUBLIC, SQLBaseParserOPTION, SQLBaseParserEXPLAIN, SQLBaseParserANALYZE, SQLBaseParserFORMAT, SQLBaseParserTYPE, SQLBaseParserTEXT, SQLBaseParserGRAPHVIZ, SQLBaseParserLOGICAL, SQLBaseParserDISTRIBUTED, SQLBaseParserVALIDATE, SQLBaseParserSHOW, SQLBaseParserTABLES, SQLBaseParserSCHEMAS, SQLBaseParserCATALOGS, SQLBaseParserCOLUMNS, SQLBaseParserCOLUMN, SQLBaseParserUSE, SQLBaseParserPARTITIONS, SQLBaseParserFUNCTIONS, SQLBaseParserTO, SQLBaseParserSYSTEM, SQLBaseParserBERNOULLI, SQLBaseParserPOISSONIZED, SQLBaseParserTABLESAMPLE, SQLBaseParserARRAY, SQLBaseParserMAP, SQLBaseParserSET, SQLBaseParserRESET, SQLBaseParserSESSION, SQLBaseParserDATA, SQLBaseParserSTART, SQLBaseParserTRANSACTION, SQLBaseParserCOMMIT, SQLBaseParserROLLBACK, SQLBaseParserWORK, SQLBaseParserISOLATION, SQLBaseParserLEVEL, SQLBaseParserSERIALIZABLE, SQLBaseParserREPEATABLE, SQLBaseParserCOMMITTED, SQLBaseParserUNCOMMITTED, SQLBaseParserREAD, SQLBaseParserWRITE, SQLBaseParserONLY, SQLBaseParserCALL, SQLBaseParserINPUT, SQLBaseParserOUTPUT, SQLBaseParserCASCADE, SQLBaseParserRESTRICT, SQLBaseParserINCLUDING, SQLBaseParserEXCLUDING, SQLBaseParserPROPERTIES, SQLBaseParserNFD, SQLBaseParserNFC, SQLBaseParserNFKD, SQLBaseParserNFKC, SQLBaseParserIF, SQLBaseParserNULLIF, SQLBaseParserCOALESCE, SQLBaseParserIDENTIFIER, SQLBaseParserDIGIT_IDENTIFIER, SQLBaseParserQUOTED_IDENTIFIER, SQLBaseParserBACKQUOTED_IDENTIFIER, SQLBaseParserSYMMETRIC:
 		p.EnterOuterAlt(localctx, 2)
 		{
 			p.SetState(647)
@@ -10921,6 +10925,10 @@ func (s *BetweenContext) BETWEEN() antlr.TerminalNode {
 	return s.GetToken(SQLBaseParserBETWEEN, 0)
 }
 
+func (s *BetweenContext) SYMMETRIC() antlr.TerminalNode {
+	return s.GetToken(SQLBaseParserSYMMETRIC, 0)
+}
+
 func (s *BetweenContext) AND() antlr.TerminalNode {
 	return s.GetToken(SQLBaseParserAND, 0)
 }
@@ -11105,6 +11113,16 @@ func (p *SQLBaseParser) Predicate() (localctx IPredicateContext) {
 			p.SetState(811)
 			p.Match(SQLBaseParserBETWEEN)
 		}
+		p.SetState(1311)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 160, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(1310)
+				p.Match(SQLBaseParserSYMMETRIC)
+			}
+
+		}
 		{
 			p.SetState(812)
 
@@ -11698,7 +11716,7 @@ func (p *SQLBaseParser) valueExpression(_p int) (localctx IValueExpressionContex
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case SQLBaseParserT__0, SQLBaseParserT__3, SQLBaseParserADD, SQLBaseParserALL, SQLBaseParserSOME, SQLBaseParserANY, SQLBaseParserAT, SQLBaseParserNO, SQLBaseParserEXISTS, SQLBaseParserNULL, SQLBaseParserTRUE, SQLBaseParserFALSE, SQLBaseParserSUBSTRING, SQLBaseParserPOSITION, SQLBaseParserTINYINT, SQLBaseParserSMALLINT, SQLBaseParserINTEGER, SQLBaseParserDATE, SQLBaseParserTIME, SQLBaseParserTIMESTAMP, SQLBaseParserINTERVAL, SQLBaseParserYEAR, SQLBaseParserMONTH, SQLBaseParserDAY, SQLBaseParserHOUR, SQLBaseParserMINUTE, SQLBaseParserSECOND, SQLBaseParserZONE, SQLBaseParserCURRENT_DATE, SQLBaseParserCURRENT_TIME, SQLBaseParserCURRENT_TIMESTAMP, SQLBaseParserLOCALTIME, SQLBaseParserLOCALTIMESTAMP, SQLBaseParserEXTRACT, SQLBaseParserCASE, SQLBaseParserFILTER, SQLBaseParserOVER, SQLBaseParserPARTITION, SQLBaseParserRANGE, SQLBaseParserROWS, SQLBaseParserPRECEDING, SQLBaseParserFOLLOWING, SQLBaseParserCURRENT, SQLBaseParserROW, SQLBaseParserSCHEMA, SQLBaseParserCOMMENT, SQLBaseParserVIEW, SQLBaseParserREPLACE, SQLBaseParserGRANT, SQLBaseParserREVOKE, SQLBaseParserPRIVILEGES, SQLBaseParserPUBLIC, SQLBaseParserOPTION, SQLBaseParserEXPLAIN, SQLBaseParserANALYZE, SQLBaseParserFORMAT, SQLBaseParserTYPE, SQLBaseParserTEXT, SQLBaseParserGRAPHVIZ, SQLBaseParserLOGICAL, SQLBaseParserDISTRIBUTED, SQLBaseParserVALIDATE, SQLBaseParserCAST, SQLBaseParserTRY_CAST, SQLBaseParserSHOW, SQLBaseParserTABLES, SQLBaseParserSCHEMAS, SQLBaseParserCATALOGS, SQLBaseParserCOLUMNS, SQLBaseParserCOLUMN, SQLBaseParserUSE, SQLBaseParserPARTITIONS, SQLBaseParserFUNCTIONS, SQLBaseParserTO, SQLBaseParserSYSTEM, SQLBaseParserBERNOULLI, SQLBaseParserPOISSONIZED, SQLBaseParserTABLESAMPLE, SQLBaseParserARRAY, SQLBaseParserMAP, SQLBaseParserSET, SQLBaseParserRESET, SQLBaseParserSESSION, SQLBaseParserDATA, SQLBaseParserSTART, SQLBaseParserTRANSACTION, SQLBaseParserCOMMIT, SQLBaseParserROLLBACK, SQLBaseParserWORK, SQLBaseParserISOLATION, SQLBaseParserLEVEL, SQLBaseParserSERIALIZABLE, SQLBaseParserREPEATABLE, SQLBaseParserCOMMITTED, SQLBaseParserUNCOMMITTED, SQLBaseParserREAD, SQLBaseParserWRITE, SQLBaseParserONLY, SQLBaseParserCALL, SQLBaseParserINPUT, SQLBaseParserOUTPUT, SQLBaseParserCASCADE, SQLBaseParserRESTRICT, SQLBaseParserINCLUDING, SQLBaseParserEXCLUDING, SQLBaseParserPROPERTIES, SQLBaseParserNORMALIZE, SQLBaseParserNFD, SQLBaseParserNFC, SQLBaseParserNFKD, SQLBaseParserNFKC, SQLBaseParserIF, SQLBaseParserNULLIF, SQLBaseParserCOALESCE, SQLBaseParserTIME_WITH_TIME_ZONE, SQLBaseParserTIMESTAMP_WITH_TIME_ZONE, SQLBaseParserDOUBLE_PRECISION, SQLBaseParserSTRING, SQLBaseParserBINARY_LITERAL, SQLBaseParserINTEGER_VALUE, SQLBaseParserDECIMAL_VALUE, SQLBaseParserIDENTIFIER, SQLBaseParserDIGIT_IDENTIFIER, SQLBaseParserQUOTED_IDENTIFIER, SQLBaseParserBACKQUOTED_IDENTIFIER:
+	case SQLBaseParserT__0, SQLBaseParserT__3, SQLBaseParserADD, SQLBaseParserALL, SQLBaseParserSOME, SQLBaseParserANY, SQLBaseParserAT, SQLBaseParserNO, SQLBaseParserEXISTS, SQLBaseParserNULL, SQLBaseParserTRUE, SQLBaseParserFALSE, SQLBaseParserSUBSTRING, SQLBaseParserPOSITION, SQLBaseParserTINYINT, SQLBaseParserSMALLINT, SQLBaseParserINTEGER, SQLBaseParserDATE, SQLBaseParserTIME, SQLBaseParserTIMESTAMP, SQLBaseParserINTERVAL, SQLBaseParserYEAR, SQLBaseParserMONTH, SQLBaseParserDAY, SQLBaseParserHOUR, SQLBaseParserMINUTE, SQLBaseParserSECOND, SQLBaseParserZONE, SQLBaseParserCURRENT_DATE, SQLBaseParserCURRENT_TIME, SQLBaseParserCURRENT_TIMESTAMP, SQLBaseParserLOCALTIME, SQLBaseParserLOCALTIMESTAMP, SQLBaseParserEXTRACT, SQLBaseParserCASE, SQLBaseParserFILTER, SQLBaseParserOVER, SQLBaseParserPARTITION, SQLBaseParserRANGE, SQLBaseParserROWS, SQLBaseParserPRECEDING, SQLBaseParserFOLLOWING, SQLBaseParserCURRENT, SQLBaseParserROW, SQLBaseParserSCHEMA, SQLBaseParserCOMMENT, SQLBaseParserVIEW, SQLBaseParserREPLACE, SQLBaseParserGRANT, SQLBaseParserREVOKE, SQLBaseParserPRIVILEGES, SQLBaseParserPUBLIC, SQLBaseParserOPTION, SQLBaseParserEXPLAIN, SQLBaseParserANALYZE, SQLBaseParserFORMAT, SQLBaseParserTYPE, SQLBaseParserTEXT, SQLBaseParserGRAPHVIZ, SQLBaseParserLOGICAL, SQLBaseParserDISTRIBUTED, SQLBaseParserVALIDATE, SQLBaseParserCAST, SQLBaseParserTRY_CAST, SQLBaseParserSHOW, SQLBaseParserTABLES, SQLBaseParserSCHEMAS, SQLBaseParserCATALOGS, SQLBaseParserCOLUMNS, SQLBaseParserCOLUMN, SQLBaseParserUSE, SQLBaseParserPARTITIONS, SQLBaseParserFUNCTIONS, SQLBaseParserTO, SQLBaseParserSYSTEM, SQLBaseParserBERNOULLI, SQLBaseParserPOISSONIZED, SQLBaseParserTABLESAMPLE, SQLBaseParserARRAY, SQLBaseParserMAP, SQLBaseParserSET, SQLBaseParserRESET, SQLBaseParserSESSION, SQLBaseParserDATA, SQLBaseParserSTART, SQLBaseParserTRANSACTION, SQLBaseParserCOMMIT, SQLBaseParserROLLBACK, SQLBaseParserWORK, SQLBaseParserISOLATION, SQLBaseParserLEVEL, SQLBaseParserSERIALIZABLE, SQLBaseParserREPEATABLE, SQLBaseParserCOMMITTED, SQLBaseParserUNCOMMITTED, SQLBaseParserREAD, SQLBaseParserWRITE, SQLBaseParserONLY, SQLBaseParserCALL, SQLBaseParserINPUT, SQLBaseParserOUTPUT, SQLBaseParserCASCADE, SQLBaseParserRESTRICT, SQLBaseParserINCLUDING, SQLBaseParserEXCLUDING, SQLBaseParserPROPERTIES, SQLBaseParserNORMALIZE, SQLBaseParserNFD, SQLBaseParserNFC, SQLBaseParserNFKD, SQLBaseParserNFKC, SQLBaseParserIF, SQLBaseParserNULLIF, SQLBaseParserCOALESCE, SQLBaseParserTIME_WITH_TIME_ZONE, SQLBaseParserTIMESTAMP_WITH_TIME_ZONE, SQLBaseParserDOUBLE_PRECISION, SQLBaseParserSTRING, SQLBaseParserBINARY_LITERAL, SQLBaseParserINTEGER_VALUE, SQLBaseParserDECIMAL_VALUE, SQLBaseParserIDENTIFIER, SQLBaseParserDIGIT_IDENTIFIER, SQLBaseParserQUOTED_IDENTIFIER, SQLBaseParserBACKQUOTED_IDENTIFIER, SQLBaseParserSYMMETRIC:
 		localctx = NewValueExpressionDefaultContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
@@ -13504,7 +13522,7 @@ func (p *SQLBaseParser) primaryExpression(_p int) (localctx IPrimaryExpressionCo
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
-		case SQLBaseParserADD, SQLBaseParserALL, SQLBaseParserSOME, SQLBaseParserANY, SQLBaseParserAT, SQLBaseParserNO, SQLBaseParserSUBSTRING, SQLBaseParserPOSITION, SQLBaseParserTINYINT, SQLBaseParserSMALLINT, SQLBaseParserINTEGER, SQLBaseParserDATE, SQLBaseParserTIME, SQLBaseParserTIMESTAMP, SQLBaseParserINTERVAL, SQLBaseParserYEAR, SQLBaseParserMONTH, SQLBaseParserDAY, SQLBaseParserHOUR, SQLBaseParserMINUTE, SQLBaseParserSECOND, SQLBaseParserZONE, SQLBaseParserFILTER, SQLBaseParserOVER, SQLBaseParserPARTITION, SQLBaseParserRANGE, SQLBaseParserROWS, SQLBaseParserPRECEDING, SQLBaseParserFOLLOWING, SQLBaseParserCURRENT, SQLBaseParserROW, SQLBaseParserSCHEMA, SQLBaseParserCOMMENT, SQLBaseParserVIEW, SQLBaseParserREPLACE, SQLBaseParserGRANT, SQLBaseParserREVOKE, SQLBaseParserPRIVILEGES, SQLBaseParserPUBLIC, SQLBaseParserOPTION, SQLBaseParserEXPLAIN, SQLBaseParserANALYZE, SQLBaseParserFORMAT, SQLBaseParserTYPE, SQLBaseParserTEXT, SQLBaseParserGRAPHVIZ, SQLBaseParserLOGICAL, SQLBaseParserDISTRIBUTED, SQLBaseParserVALIDATE, SQLBaseParserSHOW, SQLBaseParserTABLES, SQLBaseParserSCHEMAS, SQLBaseParserCATALOGS, SQLBaseParserCOLUMNS, SQLBaseParserCOLUMN, SQLBaseParserUSE, SQLBaseParserPARTITIONS, SQLBaseParserFUNCTIONS, SQLBaseParserTO, SQLBaseParserSYSTEM, SQLBaseParserBERNOULLI, SQLBaseParserPOISSONIZED, SQLBaseParserTABLESAMPLE, SQLBaseParserARRAY, SQLBaseParserMAP, SQLBaseParserSET, SQLBaseParserRESET, SQLBaseParserSESSION, SQLBaseParserDATA, SQLBaseParserSTART, SQLBaseParserTRANSACTION, SQLBaseParserCOMMIT, SQLBaseParserROLLBACK, SQLBaseParserWORK, SQLBaseParserISOLATION, SQLBaseParserLEVEL, SQLBaseParserSERIALIZABLE, SQLBaseParserREPEATABLE, SQLBaseParserCOMMITTED, SQLBaseParserUNCOMMITTED, SQLBaseParserREAD, SQLBaseParserWRITE, SQLBaseParserONLY, SQLBaseParserCALL, SQLBaseParserINPUT, SQLBaseParserOUTPUT, SQLBaseParserCASCADE, SQLBaseParserRESTRICT, SQLBaseParserINCLUDING, SQLBaseParserEXCLUDING, SQLBaseParserPROPERTIES, SQLBaseParserNFD, SQLBaseParserNFC, SQLBaseParserNFKD, SQLBaseParserNFKC, SQLBaseParserIF, SQLBaseParserNULLIF, SQLBaseParserCOALESCE, SQLBaseParserIDENTIFIER, SQLBaseParserDIGIT_IDENTIFIER, SQLBaseParserQUOTED_IDENTIFIER, SQLBaseParserBACKQUOTED_IDENTIFIER:
+		case SQLBaseParserADD, SQLBaseParserALL, SQLBaseParserSOME, SQLBaseParserANY, SQLBaseParserAT, SQLBaseParserNO, SQLBaseParserSUBSTRING, SQLBaseParserPOSITION, SQLBaseParserTINYINT, SQLBaseParserSMALLINT, SQLBaseParserINTEGER, SQLBaseParserDATE, SQLBaseParserTIME, SQLBaseParserTIMESTAMP, SQLBaseParserINTERVAL, SQLBaseParserYEAR, SQLBaseParserMONTH, SQLBaseParserDAY, SQLBaseParserHOUR, SQLBaseParserMINUTE, SQLBaseParserSECOND, SQLBaseParserZONE, SQLBaseParserFILTER, SQLBaseParserOVER, SQLBaseParserPARTITION, SQLBaseParserRANGE, SQLBaseParserROWS, SQLBaseParserPRECEDING, SQLBaseParserFOLLOWING, SQLBaseParserCURRENT, SQLBaseParserROW, SQLBaseParserSCHEMA, SQLBaseParserCOMMENT, SQLBaseParserVIEW, SQLBaseParserREPLACE, SQLBaseParserGRANT, SQLBaseParserREVOKE, SQLBaseParserPRIVILEGES, SQLBaseParserPUBLIC, SQLBaseParserOPTION, SQLBaseParserEXPLAIN, SQLBaseParserANALYZE, SQLBaseParserFORMAT, SQLBaseParserTYPE, SQLBaseParserTEXT, SQLBaseParserGRAPHVIZ, SQLBaseParserLOGICAL, SQLBaseParserDISTRIBUTED, SQLBaseParserVALIDATE, SQLBaseParserSHOW, SQLBaseParserTABLES, SQLBaseParserSCHEMAS, SQLBaseParserCATALOGS, SQLBaseParserCOLUMNS, SQLBaseParserCOLUMN, SQLBaseParserUSE, SQLBaseParserPARTITIONS, SQLBaseParserFUNCTIONS, SQLBaseParserTO, SQLBaseParserSYSTEM, SQLBaseParserBERNOULLI, SQLBaseParserPOISSONIZED, SQLBaseParserTABLESAMPLE, SQLBaseParserARRAY, SQLBaseParserMAP, SQLBaseParserSET, SQLBaseParserRESET, SQLBaseParserSESSION, SQLBaseParserDATA, SQLBaseParserSTART, SQLBaseParserTRANSACTION, SQLBaseParserCOMMIT, SQLBaseParserROLLBACK, SQLBaseParserWORK, SQLBaseParserISOLATION, SQLBaseParserLEVEL, SQLBaseParserSERIALIZABLE, SQLBaseParserREPEATABLE, SQLBaseParserCOMMITTED, SQLBaseParserUNCOMMITTED, SQLBaseParserREAD, SQLBaseParserWRITE, SQLBaseParserONLY, SQLBaseParserCALL, SQLBaseParserINPUT, SQLBaseParserOUTPUT, SQLBaseParserCASCADE, SQLBaseParserRESTRICT, SQLBaseParserINCLUDING, SQLBaseParserEXCLUDING, SQLBaseParserPROPERTIES, SQLBaseParserNFD, SQLBaseParserNFC, SQLBaseParserNFKD, SQLBaseParserNFKC, SQLBaseParserIF, SQLBaseParserNULLIF, SQLBaseParserCOALESCE, SQLBaseParserIDENTIFIER, SQLBaseParserDIGIT_IDENTIFIER, SQLBaseParserQUOTED_IDENTIFIER, SQLBaseParserBACKQUOTED_IDENTIFIER, SQLBaseParserSYMMETRIC:
 			{
 				p.SetState(894)
 				p.Identifier()
@@ -13684,7 +13702,7 @@ func (p *SQLBaseParser) primaryExpression(_p int) (localctx IPrimaryExpressionCo
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLBaseParserT__0)|(1<<SQLBaseParserT__3)|(1<<SQLBaseParserADD)|(1<<SQLBaseParserALL)|(1<<SQLBaseParserSOME)|(1<<SQLBaseParserANY)|(1<<SQLBaseParserDISTINCT)|(1<<SQLBaseParserAT))) != 0) || (((_la-32)&-(0x1f+1)) == 0 && ((1<<uint((_la-32)))&((1<<(SQLBaseParserNOT-32))|(1<<(SQLBaseParserNO-32))|(1<<(SQLBaseParserEXISTS-32))|(1<<(SQLBaseParserNULL-32))|(1<<(SQLBaseParserTRUE-32))|(1<<(SQLBaseParserFALSE-32))|(1<<(SQLBaseParserSUBSTRING-32))|(1<<(SQLBaseParserPOSITION-32))|(1<<(SQLBaseParserTINYINT-32))|(1<<(SQLBaseParserSMALLINT-32))|(1<<(SQLBaseParserINTEGER-32))|(1<<(SQLBaseParserDATE-32))|(1<<(SQLBaseParserTIME-32))|(1<<(SQLBaseParserTIMESTAMP-32))|(1<<(SQLBaseParserINTERVAL-32))|(1<<(SQLBaseParserYEAR-32))|(1<<(SQLBaseParserMONTH-32))|(1<<(SQLBaseParserDAY-32))|(1<<(SQLBaseParserHOUR-32))|(1<<(SQLBaseParserMINUTE-32))|(1<<(SQLBaseParserSECOND-32))|(1<<(SQLBaseParserZONE-32)))) != 0) || (((_la-64)&-(0x1f+1)) == 0 && ((1<<uint((_la-64)))&((1<<(SQLBaseParserCURRENT_DATE-64))|(1<<(SQLBaseParserCURRENT_TIME-64))|(1<<(SQLBaseParserCURRENT_TIMESTAMP-64))|(1<<(SQLBaseParserLOCALTIME-64))|(1<<(SQLBaseParserLOCALTIMESTAMP-64))|(1<<(SQLBaseParserEXTRACT-64))|(1<<(SQLBaseParserCASE-64))|(1<<(SQLBaseParserFILTER-64))|(1<<(SQLBaseParserOVER-64))|(1<<(SQLBaseParserPARTITION-64))|(1<<(SQLBaseParserRANGE-64))|(1<<(SQLBaseParserROWS-64))|(1<<(SQLBaseParserPRECEDING-64))|(1<<(SQLBaseParserFOLLOWING-64))|(1<<(SQLBaseParserCURRENT-64))|(1<<(SQLBaseParserROW-64)))) != 0) || (((_la-99)&-(0x1f+1)) == 0 && ((1<<uint((_la-99)))&((1<<(SQLBaseParserSCHEMA-99))|(1<<(SQLBaseParserCOMMENT-99))|(1<<(SQLBaseParserVIEW-99))|(1<<(SQLBaseParserREPLACE-99))|(1<<(SQLBaseParserGRANT-99))|(1<<(SQLBaseParserREVOKE-99))|(1<<(SQLBaseParserPRIVILEGES-99))|(1<<(SQLBaseParserPUBLIC-99))|(1<<(SQLBaseParserOPTION-99))|(1<<(SQLBaseParserEXPLAIN-99))|(1<<(SQLBaseParserANALYZE-99))|(1<<(SQLBaseParserFORMAT-99))|(1<<(SQLBaseParserTYPE-99))|(1<<(SQLBaseParserTEXT-99))|(1<<(SQLBaseParserGRAPHVIZ-99))|(1<<(SQLBaseParserLOGICAL-99))|(1<<(SQLBaseParserDISTRIBUTED-99))|(1<<(SQLBaseParserVALIDATE-99))|(1<<(SQLBaseParserCAST-99))|(1<<(SQLBaseParserTRY_CAST-99))|(1<<(SQLBaseParserSHOW-99))|(1<<(SQLBaseParserTABLES-99))|(1<<(SQLBaseParserSCHEMAS-99))|(1<<(SQLBaseParserCATALOGS-99))|(1<<(SQLBaseParserCOLUMNS-99))|(1<<(SQLBaseParserCOLUMN-99)))) != 0) || (((_la-131)&-(0x1f+1)) == 0 && ((1<<uint((_la-131)))&((1<<(SQLBaseParserUSE-131))|(1<<(SQLBaseParserPARTITIONS-131))|(1<<(SQLBaseParserFUNCTIONS-131))|(1<<(SQLBaseParserTO-131))|(1<<(SQLBaseParserSYSTEM-131))|(1<<(SQLBaseParserBERNOULLI-131))|(1<<(SQLBaseParserPOISSONIZED-131))|(1<<(SQLBaseParserTABLESAMPLE-131))|(1<<(SQLBaseParserARRAY-131))|(1<<(SQLBaseParserMAP-131))|(1<<(SQLBaseParserSET-131))|(1<<(SQLBaseParserRESET-131))|(1<<(SQLBaseParserSESSION-131))|(1<<(SQLBaseParserDATA-131))|(1<<(SQLBaseParserSTART-131))|(1<<(SQLBaseParserTRANSACTION-131))|(1<<(SQLBaseParserCOMMIT-131))|(1<<(SQLBaseParserROLLBACK-131))|(1<<(SQLBaseParserWORK-131))|(1<<(SQLBaseParserISOLATION-131))|(1<<(SQLBaseParserLEVEL-131))|(1<<(SQLBaseParserSERIALIZABLE-131))|(1<<(SQLBaseParserREPEATABLE-131))|(1<<(SQLBaseParserCOMMITTED-131)))) != 0) || (((_la-163)&-(0x1f+1)) == 0 && ((1<<uint((_la-163)))&((1<<(SQLBaseParserUNCOMMITTED-163))|(1<<(SQLBaseParserREAD-163))|(1<<(SQLBaseParserWRITE-163))|(1<<(SQLBaseParserONLY-163))|(1<<(SQLBaseParserCALL-163))|(1<<(SQLBaseParserINPUT-163))|(1<<(SQLBaseParserOUTPUT-163))|(1<<(SQLBaseParserCASCADE-163))|(1<<(SQLBaseParserRESTRICT-163))|(1<<(SQLBaseParserINCLUDING-163))|(1<<(SQLBaseParserEXCLUDING-163))|(1<<(SQLBaseParserPROPERTIES-163))|(1<<(SQLBaseParserNORMALIZE-163))|(1<<(SQLBaseParserNFD-163))|(1<<(SQLBaseParserNFC-163))|(1<<(SQLBaseParserNFKD-163))|(1<<(SQLBaseParserNFKC-163))|(1<<(SQLBaseParserIF-163))|(1<<(SQLBaseParserNULLIF-163))|(1<<(SQLBaseParserCOALESCE-163))|(1<<(SQLBaseParserTIME_WITH_TIME_ZONE-163))|(1<<(SQLBaseParserTIMESTAMP_WITH_TIME_ZONE-163))|(1<<(SQLBaseParserDOUBLE_PRECISION-163)))) != 0) || (((_la-195)&-(0x1f+1)) == 0 && ((1<<uint((_la-195)))&((1<<(SQLBaseParserPLUS-195))|(1<<(SQLBaseParserMINUS-195))|(1<<(SQLBaseParserSTRING-195))|(1<<(SQLBaseParserBINARY_LITERAL-195))|(1<<(SQLBaseParserINTEGER_VALUE-195))|(1<<(SQLBaseParserDECIMAL_VALUE-195))|(1<<(SQLBaseParserIDENTIFIER-195))|(1<<(SQLBaseParserDIGIT_IDENTIFIER-195))|(1<<(SQLBaseParserQUOTED_IDENTIFIER-195))|(1<<(SQLBaseParserBACKQUOTED_IDENTIFIER-195)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLBaseParserT__0)|(1<<SQLBaseParserT__3)|(1<<SQLBaseParserADD)|(1<<SQLBaseParserALL)|(1<<SQLBaseParserSOME)|(1<<SQLBaseParserANY)|(1<<SQLBaseParserDISTINCT)|(1<<SQLBaseParserAT))) != 0) || (((_la-32)&-(0x1f+1)) == 0 && ((1<<uint((_la-32)))&((1<<(SQLBaseParserNOT-32))|(1<<(SQLBaseParserNO-32))|(1<<(SQLBaseParserEXISTS-32))|(1<<(SQLBaseParserNULL-32))|(1<<(SQLBaseParserTRUE-32))|(1<<(SQLBaseParserFALSE-32))|(1<<(SQLBaseParserSUBSTRING-32))|(1<<(SQLBaseParserPOSITION-32))|(1<<(SQLBaseParserTINYINT-32))|(1<<(SQLBaseParserSMALLINT-32))|(1<<(SQLBaseParserINTEGER-32))|(1<<(SQLBaseParserDATE-32))|(1<<(SQLBaseParserTIME-32))|(1<<(SQLBaseParserTIMESTAMP-32))|(1<<(SQLBaseParserINTERVAL-32))|(1<<(SQLBaseParserYEAR-32))|(1<<(SQLBaseParserMONTH-32))|(1<<(SQLBaseParserDAY-32))|(1<<(SQLBaseParserHOUR-32))|(1<<(SQLBaseParserMINUTE-32))|(1<<(SQLBaseParserSECOND-32))|(1<<(SQLBaseParserZONE-32)))) != 0) || (((_la-64)&-(0x1f+1)) == 0 && ((1<<uint((_la-64)))&((1<<(SQLBaseParserCURRENT_DATE-64))|(1<<(SQLBaseParserCURRENT_TIME-64))|(1<<(SQLBaseParserCURRENT_TIMESTAMP-64))|(1<<(SQLBaseParserLOCALTIME-64))|(1<<(SQLBaseParserLOCALTIMESTAMP-64))|(1<<(SQLBaseParserEXTRACT-64))|(1<<(SQLBaseParserCASE-64))|(1<<(SQLBaseParserFILTER-64))|(1<<(SQLBaseParserOVER-64))|(1<<(SQLBaseParserPARTITION-64))|(1<<(SQLBaseParserRANGE-64))|(1<<(SQLBaseParserROWS-64))|(1<<(SQLBaseParserPRECEDING-64))|(1<<(SQLBaseParserFOLLOWING-64))|(1<<(SQLBaseParserCURRENT-64))|(1<<(SQLBaseParserROW-64)))) != 0) || (((_la-99)&-(0x1f+1)) == 0 && ((1<<uint((_la-99)))&((1<<(SQLBaseParserSCHEMA-99))|(1<<(SQLBaseParserCOMMENT-99))|(1<<(SQLBaseParserVIEW-99))|(1<<(SQLBaseParserREPLACE-99))|(1<<(SQLBaseParserGRANT-99))|(1<<(SQLBaseParserREVOKE-99))|(1<<(SQLBaseParserPRIVILEGES-99))|(1<<(SQLBaseParserPUBLIC-99))|(1<<(SQLBaseParserOPTION-99))|(1<<(SQLBaseParserEXPLAIN-99))|(1<<(SQLBaseParserANALYZE-99))|(1<<(SQLBaseParserFORMAT-99))|(1<<(SQLBaseParserTYPE-99))|(1<<(SQLBaseParserTEXT-99))|(1<<(SQLBaseParserGRAPHVIZ-99))|(1<<(SQLBaseParserLOGICAL-99))|(1<<(SQLBaseParserDISTRIBUTED-99))|(1<<(SQLBaseParserVALIDATE-99))|(1<<(SQLBaseParserCAST-99))|(1<<(SQLBaseParserTRY_CAST-99))|(1<<(SQLBaseParserSHOW-99))|(1<<(SQLBaseParserTABLES-99))|(1<<(SQLBaseParserSCHEMAS-99))|(1<<(SQLBaseParserCATALOGS-99))|(1<<(SQLBaseParserCOLUMNS-99))|(1<<(SQLBaseParserCOLUMN-99)))) != 0) || (((_la-131)&-(0x1f+1)) == 0 && ((1<<uint((_la-131)))&((1<<(SQLBaseParserUSE-131))|(1<<(SQLBaseParserPARTITIONS-131))|(1<<(SQLBaseParserFUNCTIONS-131))|(1<<(SQLBaseParserTO-131))|(1<<(SQLBaseParserSYSTEM-131))|(1<<(SQLBaseParserBERNOULLI-131))|(1<<(SQLBaseParserPOISSONIZED-131))|(1<<(SQLBaseParserTABLESAMPLE-131))|(1<<(SQLBaseParserARRAY-131))|(1<<(SQLBaseParserMAP-131))|(1<<(SQLBaseParserSET-131))|(1<<(SQLBaseParserRESET-131))|(1<<(SQLBaseParserSESSION-131))|(1<<(SQLBaseParserDATA-131))|(1<<(SQLBaseParserSTART-131))|(1<<(SQLBaseParserTRANSACTION-131))|(1<<(SQLBaseParserCOMMIT-131))|(1<<(SQLBaseParserROLLBACK-131))|(1<<(SQLBaseParserWORK-131))|(1<<(SQLBaseParserISOLATION-131))|(1<<(SQLBaseParserLEVEL-131))|(1<<(SQLBaseParserSERIALIZABLE-131))|(1<<(SQLBaseParserREPEATABLE-131))|(1<<(SQLBaseParserCOMMITTED-131)))) != 0) || (((_la-163)&-(0x1f+1)) == 0 && ((1<<uint((_la-163)))&((1<<(SQLBaseParserUNCOMMITTED-163))|(1<<(SQLBaseParserREAD-163))|(1<<(SQLBaseParserWRITE-163))|(1<<(SQLBaseParserONLY-163))|(1<<(SQLBaseParserCALL-163))|(1<<(SQLBaseParserINPUT-163))|(1<<(SQLBaseParserOUTPUT-163))|(1<<(SQLBaseParserCASCADE-163))|(1<<(SQLBaseParserRESTRICT-163))|(1<<(SQLBaseParserINCLUDING-163))|(1<<(SQLBaseParserEXCLUDING-163))|(1<<(SQLBaseParserPROPERTIES-163))|(1<<(SQLBaseParserNORMALIZE-163))|(1<<(SQLBaseParserNFD-163))|(1<<(SQLBaseParserNFC-163))|(1<<(SQLBaseParserNFKD-163))|(1<<(SQLBaseParserNFKC-163))|(1<<(SQLBaseParserIF-163))|(1<<(SQLBaseParserNULLIF-163))|(1<<(SQLBaseParserCOALESCE-163))|(1<<(SQLBaseParserTIME_WITH_TIME_ZONE-163))|(1<<(SQLBaseParserTIMESTAMP_WITH_TIME_ZONE-163))|(1<<(SQLBaseParserDOUBLE_PRECISION-163)))) != 0) || (((_la-195)&-(0x1f+1)) == 0 && ((1<<uint((_la-195)))&((1<<(SQLBaseParserPLUS-195))|(1<<(SQLBaseParserMINUS-195))|(1<<(SQLBaseParserSTRING-195))|(1<<(SQLBaseParserBINARY_LITERAL-195))|(1<<(SQLBaseParserINTEGER_VALUE-195))|(1<<(SQLBaseParserDECIMAL_VALUE-195))|(1<<(SQLBaseParserIDENTIFIER-195))|(1<<(SQLBaseParserDIGIT_IDENTIFIER-195))|(1<<(SQLBaseParserQUOTED_IDENTIFIER-195))|(1<<(SQLBaseParserBACKQUOTED_IDENTIFIER-195)))) != 0) || _la == SQLBaseParserSYMMETRIC {
 			p.SetState(941)
 			p.GetErrorHandler().Sync(p)
 
@@ -14010,7 +14028,7 @@ func (p *SQLBaseParser) primaryExpression(_p int) (localctx IPrimaryExpressionCo
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLBaseParserT__0)|(1<<SQLBaseParserT__3)|(1<<SQLBaseParserADD)|(1<<SQLBaseParserALL)|(1<<SQLBaseParserSOME)|(1<<SQLBaseParserANY)|(1<<SQLBaseParserAT))) != 0) || (((_la-32)&-(0x1f+1)) == 0 && ((1<<uint((_la-32)))&((1<<(SQLBaseParserNOT-32))|(1<<(SQLBaseParserNO-32))|(1<<(SQLBaseParserEXISTS-32))|(1<<(SQLBaseParserNULL-32))|(1<<(SQLBaseParserTRUE-32))|(1<<(SQLBaseParserFALSE-32))|(1<<(SQLBaseParserSUBSTRING-32))|(1<<(SQLBaseParserPOSITION-32))|(1<<(SQLBaseParserTINYINT-32))|(1<<(SQLBaseParserSMALLINT-32))|(1<<(SQLBaseParserINTEGER-32))|(1<<(SQLBaseParserDATE-32))|(1<<(SQLBaseParserTIME-32))|(1<<(SQLBaseParserTIMESTAMP-32))|(1<<(SQLBaseParserINTERVAL-32))|(1<<(SQLBaseParserYEAR-32))|(1<<(SQLBaseParserMONTH-32))|(1<<(SQLBaseParserDAY-32))|(1<<(SQLBaseParserHOUR-32))|(1<<(SQLBaseParserMINUTE-32))|(1<<(SQLBaseParserSECOND-32))|(1<<(SQLBaseParserZONE-32)))) != 0) || (((_la-64)&-(0x1f+1)) == 0 && ((1<<uint((_la-64)))&((1<<(SQLBaseParserCURRENT_DATE-64))|(1<<(SQLBaseParserCURRENT_TIME-64))|(1<<(SQLBaseParserCURRENT_TIMESTAMP-64))|(1<<(SQLBaseParserLOCALTIME-64))|(1<<(SQLBaseParserLOCALTIMESTAMP-64))|(1<<(SQLBaseParserEXTRACT-64))|(1<<(SQLBaseParserCASE-64))|(1<<(SQLBaseParserFILTER-64))|(1<<(SQLBaseParserOVER-64))|(1<<(SQLBaseParserPARTITION-64))|(1<<(SQLBaseParserRANGE-64))|(1<<(SQLBaseParserROWS-64))|(1<<(SQLBaseParserPRECEDING-64))|(1<<(SQLBaseParserFOLLOWING-64))|(1<<(SQLBaseParserCURRENT-64))|(1<<(SQLBaseParserROW-64)))) != 0) || (((_la-99)&-(0x1f+1)) == 0 && ((1<<uint((_la-99)))&((1<<(SQLBaseParserSCHEMA-99))|(1<<(SQLBaseParserCOMMENT-99))|(1<<(SQLBaseParserVIEW-99))|(1<<(SQLBaseParserREPLACE-99))|(1<<(SQLBaseParserGRANT-99))|(1<<(SQLBaseParserREVOKE-99))|(1<<(SQLBaseParserPRIVILEGES-99))|(1<<(SQLBaseParserPUBLIC-99))|(1<<(SQLBaseParserOPTION-99))|(1<<(SQLBaseParserEXPLAIN-99))|(1<<(SQLBaseParserANALYZE-99))|(1<<(SQLBaseParserFORMAT-99))|(1<<(SQLBaseParserTYPE-99))|(1<<(SQLBaseParserTEXT-99))|(1<<(SQLBaseParserGRAPHVIZ-99))|(1<<(SQLBaseParserLOGICAL-99))|(1<<(SQLBaseParserDISTRIBUTED-99))|(1<<(SQLBaseParserVALIDATE-99))|(1<<(SQLBaseParserCAST-99))|(1<<(SQLBaseParserTRY_CAST-99))|(1<<(SQLBaseParserSHOW-99))|(1<<(SQLBaseParserTABLES-99))|(1<<(SQLBaseParserSCHEMAS-99))|(1<<(SQLBaseParserCATALOGS-99))|(1<<(SQLBaseParserCOLUMNS-99))|(1<<(SQLBaseParserCOLUMN-99)))) != 0) || (((_la-131)&-(0x1f+1)) == 0 && ((1<<uint((_la-131)))&((1<<(SQLBaseParserUSE-131))|(1<<(SQLBaseParserPARTITIONS-131))|(1<<(SQLBaseParserFUNCTIONS-131))|(1<<(SQLBaseParserTO-131))|(1<<(SQLBaseParserSYSTEM-131))|(1<<(SQLBaseParserBERNOULLI-131))|(1<<(SQLBaseParserPOISSONIZED-131))|(1<<(SQLBaseParserTABLESAMPLE-131))|(1<<(SQLBaseParserARRAY-131))|(1<<(SQLBaseParserMAP-131))|(1<<(SQLBaseParserSET-131))|(1<<(SQLBaseParserRESET-131))|(1<<(SQLBaseParserSESSION-131))|(1<<(SQLBaseParserDATA-131))|(1<<(SQLBaseParserSTART-131))|(1<<(SQLBaseParserTRANSACTION-131))|(1<<(SQLBaseParserCOMMIT-131))|(1<<(SQLBaseParserROLLBACK-131))|(1<<(SQLBaseParserWORK-131))|(1<<(SQLBaseParserISOLATION-131))|(1<<(SQLBaseParserLEVEL-131))|(1<<(SQLBaseParserSERIALIZABLE-131))|(1<<(SQLBaseParserREPEATABLE-131))|(1<<(SQLBaseParserCOMMITTED-131)))) != 0) || (((_la-163)&-(0x1f+1)) == 0 && ((1<<uint((_la-163)))&((1<<(SQLBaseParserUNCOMMITTED-163))|(1<<(SQLBaseParserREAD-163))|(1<<(SQLBaseParserWRITE-163))|(1<<(SQLBaseParserONLY-163))|(1<<(SQLBaseParserCALL-163))|(1<<(SQLBaseParserINPUT-163))|(1<<(SQLBaseParserOUTPUT-163))|(1<<(SQLBaseParserCASCADE-163))|(1<<(SQLBaseParserRESTRICT-163))|(1<<(SQLBaseParserINCLUDING-163))|(1<<(SQLBaseParserEXCLUDING-163))|(1<<(SQLBaseParserPROPERTIES-163))|(1<<(SQLBaseParserNORMALIZE-163))|(1<<(SQLBaseParserNFD-163))|(1<<(SQLBaseParserNFC-163))|(1<<(SQLBaseParserNFKD-163))|(1<<(SQLBaseParserNFKC-163))|(1<<(SQLBaseParserIF-163))|(1<<(SQLBaseParserNULLIF-163))|(1<<(SQLBaseParserCOALESCE-163))|(1<<(SQLBaseParserTIME_WITH_TIME_ZONE-163))|(1<<(SQLBaseParserTIMESTAMP_WITH_TIME_ZONE-163))|(1<<(SQLBaseParserDOUBLE_PRECISION-163)))) != 0) || (((_la-195)&-(0x1f+1)) == 0 && ((1<<uint((_la-195)))&((1<<(SQLBaseParserPLUS-195))|(1<<(SQLBaseParserMINUS-195))|(1<<(SQLBaseParserSTRING-195))|(1<<(SQLBaseParserBINARY_LITERAL-195))|(1<<(SQLBaseParserINTEGER_VALUE-195))|(1<<(SQLBaseParserDECIMAL_VALUE-195))|(1<<(SQLBaseParserIDENTIFIER-195))|(1<<(SQLBaseParserDIGIT_IDENTIFIER-195))|(1<<(SQLBaseParserQUOTED_IDENTIFIER-195))|(1<<(SQLBaseParserBACKQUOTED_IDENTIFIER-195)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLBaseParserT__0)|(1<<SQLBaseParserT__3)|(1<<SQLBaseParserADD)|(1<<SQLBaseParserALL)|(1<<SQLBaseParserSOME)|(1<<SQLBaseParserANY)|(1<<SQLBaseParserAT))) != 0) || (((_la-32)&-(0x1f+1)) == 0 && ((1<<uint((_la-32)))&((1<<(SQLBaseParserNOT-32))|(1<<(SQLBaseParserNO-32))|(1<<(SQLBaseParserEXISTS-32))|(1<<(SQLBaseParserNULL-32))|(1<<(SQLBaseParserTRUE-32))|(1<<(SQLBaseParserFALSE-32))|(1<<(SQLBaseParserSUBSTRING-32))|(1<<(SQLBaseParserPOSITION-32))|(1<<(SQLBaseParserTINYINT-32))|(1<<(SQLBaseParserSMALLINT-32))|(1<<(SQLBaseParserINTEGER-32))|(1<<(SQLBaseParserDATE-32))|(1<<(SQLBaseParserTIME-32))|(1<<(SQLBaseParserTIMESTAMP-32))|(1<<(SQLBaseParserINTERVAL-32))|(1<<(SQLBaseParserYEAR-32))|(1<<(SQLBaseParserMONTH-32))|(1<<(SQLBaseParserDAY-32))|(1<<(SQLBaseParserHOUR-32))|(1<<(SQLBaseParserMINUTE-32))|(1<<(SQLBaseParserSECOND-32))|(1<<(SQLBaseParserZONE-32)))) != 0) || (((_la-64)&-(0x1f+1)) == 0 && ((1<<uint((_la-64)))&((1<<(SQLBaseParserCURRENT_DATE-64))|(1<<(SQLBaseParserCURRENT_TIME-64))|(1<<(SQLBaseParserCURRENT_TIMESTAMP-64))|(1<<(SQLBaseParserLOCALTIME-64))|(1<<(SQLBaseParserLOCALTIMESTAMP-64))|(1<<(SQLBaseParserEXTRACT-64))|(1<<(SQLBaseParserCASE-64))|(1<<(SQLBaseParserFILTER-64))|(1<<(SQLBaseParserOVER-64))|(1<<(SQLBaseParserPARTITION-64))|(1<<(SQLBaseParserRANGE-64))|(1<<(SQLBaseParserROWS-64))|(1<<(SQLBaseParserPRECEDING-64))|(1<<(SQLBaseParserFOLLOWING-64))|(1<<(SQLBaseParserCURRENT-64))|(1<<(SQLBaseParserROW-64)))) != 0) || (((_la-99)&-(0x1f+1)) == 0 && ((1<<uint((_la-99)))&((1<<(SQLBaseParserSCHEMA-99))|(1<<(SQLBaseParserCOMMENT-99))|(1<<(SQLBaseParserVIEW-99))|(1<<(SQLBaseParserREPLACE-99))|(1<<(SQLBaseParserGRANT-99))|(1<<(SQLBaseParserREVOKE-99))|(1<<(SQLBaseParserPRIVILEGES-99))|(1<<(SQLBaseParserPUBLIC-99))|(1<<(SQLBaseParserOPTION-99))|(1<<(SQLBaseParserEXPLAIN-99))|(1<<(SQLBaseParserANALYZE-99))|(1<<(SQLBaseParserFORMAT-99))|(1<<(SQLBaseParserTYPE-99))|(1<<(SQLBaseParserTEXT-99))|(1<<(SQLBaseParserGRAPHVIZ-99))|(1<<(SQLBaseParserLOGICAL-99))|(1<<(SQLBaseParserDISTRIBUTED-99))|(1<<(SQLBaseParserVALIDATE-99))|(1<<(SQLBaseParserCAST-99))|(1<<(SQLBaseParserTRY_CAST-99))|(1<<(SQLBaseParserSHOW-99))|(1<<(SQLBaseParserTABLES-99))|(1<<(SQLBaseParserSCHEMAS-99))|(1<<(SQLBaseParserCATALOGS-99))|(1<<(SQLBaseParserCOLUMNS-99))|(1<<(SQLBaseParserCOLUMN-99)))) != 0) || (((_la-131)&-(0x1f+1)) == 0 && ((1<<uint((_la-131)))&((1<<(SQLBaseParserUSE-131))|(1<<(SQLBaseParserPARTITIONS-131))|(1<<(SQLBaseParserFUNCTIONS-131))|(1<<(SQLBaseParserTO-131))|(1<<(SQLBaseParserSYSTEM-131))|(1<<(SQLBaseParserBERNOULLI-131))|(1<<(SQLBaseParserPOISSONIZED-131))|(1<<(SQLBaseParserTABLESAMPLE-131))|(1<<(SQLBaseParserARRAY-131))|(1<<(SQLBaseParserMAP-131))|(1<<(SQLBaseParserSET-131))|(1<<(SQLBaseParserRESET-131))|(1<<(SQLBaseParserSESSION-131))|(1<<(SQLBaseParserDATA-131))|(1<<(SQLBaseParserSTART-131))|(1<<(SQLBaseParserTRANSACTION-131))|(1<<(SQLBaseParserCOMMIT-131))|(1<<(SQLBaseParserROLLBACK-131))|(1<<(SQLBaseParserWORK-131))|(1<<(SQLBaseParserISOLATION-131))|(1<<(SQLBaseParserLEVEL-131))|(1<<(SQLBaseParserSERIALIZABLE-131))|(1<<(SQLBaseParserREPEATABLE-131))|(1<<(SQLBaseParserCOMMITTED-131)))) != 0) || (((_la-163)&-(0x1f+1)) == 0 && ((1<<uint((_la-163)))&((1<<(SQLBaseParserUNCOMMITTED-163))|(1<<(SQLBaseParserREAD-163))|(1<<(SQLBaseParserWRITE-163))|(1<<(SQLBaseParserONLY-163))|(1<<(SQLBaseParserCALL-163))|(1<<(SQLBaseParserINPUT-163))|(1<<(SQLBaseParserOUTPUT-163))|(1<<(SQLBaseParserCASCADE-163))|(1<<(SQLBaseParserRESTRICT-163))|(1<<(SQLBaseParserINCLUDING-163))|(1<<(SQLBaseParserEXCLUDING-163))|(1<<(SQLBaseParserPROPERTIES-163))|(1<<(SQLBaseParserNORMALIZE-163))|(1<<(SQLBaseParserNFD-163))|(1<<(SQLBaseParserNFC-163))|(1<<(SQLBaseParserNFKD-163))|(1<<(SQLBaseParserNFKC-163))|(1<<(SQLBaseParserIF-163))|(1<<(SQLBaseParserNULLIF-163))|(1<<(SQLBaseParserCOALESCE-163))|(1<<(SQLBaseParserTIME_WITH_TIME_ZONE-163))|(1<<(SQLBaseParserTIMESTAMP_WITH_TIME_ZONE-163))|(1<<(SQLBaseParserDOUBLE_PRECISION-163)))) != 0) || (((_la-195)&-(0x1f+1)) == 0 && ((1<<uint((_la-195)))&((1<<(SQLBaseParserPLUS-195))|(1<<(SQLBaseParserMINUS-195))|(1<<(SQLBaseParserSTRING-195))|(1<<(SQLBaseParserBINARY_LITERAL-195))|(1<<(SQLBaseParserINTEGER_VALUE-195))|(1<<(SQLBaseParserDECIMAL_VALUE-195))|(1<<(SQLBaseParserIDENTIFIER-195))|(1<<(SQLBaseParserDIGIT_IDENTIFIER-195))|(1<<(SQLBaseParserQUOTED_IDENTIFIER-195))|(1<<(SQLBaseParserBACKQUOTED_IDENTIFIER-195)))) != 0) || _la == SQLBaseParserSYMMETRIC {
 			{
 				p.SetState(1027)
 				p.Expression()
@@ -15728,7 +15746,7 @@ func (p *SQLBaseParser) TypeParameter() (localctx ITypeParameterContext) {
 			p.Match(SQLBaseParserINTEGER_VALUE)
 		}
 
-	case SQLBaseParserADD, SQLBaseParserALL, SQLBaseParserSOME, SQLBaseParserANY, SQLBaseParserAT, SQLBaseParserNO, SQLBaseParserSUBSTRING, SQLBaseParserPOSITION, SQLBaseParserTINYINT, SQLBaseParserSMALLINT, SQLBaseParserINTEGER, SQLBaseParserDATE, SQLBaseParserTIME, SQLBaseParserTIMESTAMP, SQLBaseParserINTERVAL, SQLBaseParserYEAR, SQLBaseParserMONTH, SQLBaseParserDAY, SQLBaseParserHOUR, SQLBaseParserMINUTE, SQLBaseParserSECOND, SQLBaseParserZONE, SQLBaseParserFILTER, SQLBaseParserOVER, SQLBaseParserPARTITION, SQLBaseParserRANGE, SQLBaseParserROWS, SQLBaseParserPRECEDING, SQLBaseParserFOLLOWING, SQLBaseParserCURRENT, SQLBaseParserROW, SQLBaseParserSCHEMA, SQLBaseParserCOMMENT, SQLBaseParserVIEW, SQLBaseParserREPLACE, SQLBaseParserGRANT, SQLBaseParserREVOKE, SQLBaseParserPRIVILEGES, SQLBaseParserPUBLIC, SQLBaseParserOPTION, SQLBaseParserEXPLAIN, SQLBaseParserANALYZE, SQLBaseParserFORMAT, SQLBaseParserTYPE, SQLBaseParserTEXT, SQLBaseParserGRAPHVIZ, SQLBaseParserLOGICAL, SQLBaseParserDISTRIBUTED, SQLBaseParserVALIDATE, SQLBaseParserSHOW, SQLBaseParserTABLES, SQLBaseParserSCHEMAS, SQLBaseParserCATALOGS, SQLBaseParserCOLUMNS, SQLBaseParserCOLUMN, SQLBaseParserUSE, SQLBaseParserPARTITIONS, SQLBaseParserFUNCTIONS, SQLBaseParserTO, SQLBaseParserSYSTEM, SQLBaseParserBERNOULLI, SQLBaseParserPOISSONIZED, SQLBaseParserTABLESAMPLE, SQLBaseParserARRAY, SQLBaseParserMAP, SQLBaseParserSET, SQLBaseParserRESET, SQLBaseParserSESSION, SQLBaseParserDATA, SQLBaseParserSTART, SQLBaseParserTRANSACTION, SQLBaseParserCOMMIT, SQLBaseParserROLLBACK, SQLBaseParserWORK, SQLBaseParserISOLATION, SQLBaseParserLEVEL, SQLBaseParserSERIALIZABLE, SQLBaseParserREPEATABLE, SQLBaseParserCOMMITTED, SQLBaseParserUNCOMMITTED, SQLBaseParserREAD, SQLBaseParserWRITE, SQLBaseParserONLY, SQLBaseParserCALL, SQLBaseParserINPUT, SQLBaseParserOUTPUT, SQLBaseParserCASCADE, SQLBaseParserRESTRICT, SQLBaseParserINCLUDING, SQLBaseParserEXCLUDING, SQLBaseParserPROPERTIES, SQLBaseParserNFD, SQLBaseParserNFC, SQLBaseParserNFKD, SQLBaseParserNFKC, SQLBaseParserIF, SQLBaseParserNULLIF, SQLBaseParserCOALESCE, SQLBaseParserTIME_WITH_TIME_ZONE, SQLBaseParserTIMESTAMP_WITH_TIME_ZONE, SQLBaseParserDOUBLE_PRECISION, SQLBaseParserIDENTIFIER, SQLBaseParserDIGIT_IDENTIFIER, SQLBaseParserQUOTED_IDENTIFIER, SQLBaseParserBACKQUOTED_IDENTIFIER:
+	case SQLBaseParserADD, SQLBaseParserALL, SQLBaseParserSOME, SQLBaseParserANY, SQLBaseParserAT, SQLBaseParserNO, SQLBaseParserSUBSTRING, SQLBaseParserPOSITION, SQLBaseParserTINYINT, SQLBaseParserSMALLINT, SQLBaseParserINTEGER, SQLBaseParserDATE, SQLBaseParserTIME, SQLBaseParserTIMESTAMP, SQLBaseParserINTERVAL, SQLBaseParserYEAR, SQLBaseParserMONTH, SQLBaseParserDAY, SQLBaseParserHOUR, SQLBaseParserMINUTE, SQLBaseParserSECOND, SQLBaseParserZONE, SQLBaseParserFILTER, SQLBaseParserOVER, SQLBaseParserPARTITION, SQLBaseParserRANGE, SQLBaseParserROWS, SQLBaseParserPRECEDING, SQLBaseParserFOLLOWING, SQLBaseParserCURRENT, SQLBaseParserROW, SQLBaseParserSCHEMA, SQLBaseParserCOMMENT, SQLBaseParserVIEW, SQLBaseParserREPLACE, SQLBaseParserGRANT, SQLBaseParserREVOKE, SQLBaseParserPRIVILEGES, SQLBaseParserPUBLIC, SQLBaseParserOPTION, SQLBaseParserEXPLAIN, SQLBaseParserANALYZE, SQLBaseParserFORMAT, SQLBaseParserTYPE, SQLBaseParserTEXT, SQLBaseParserGRAPHVIZ, SQLBaseParserLOGICAL, SQLBaseParserDISTRIBUTED, SQLBaseParserVALIDATE, SQLBaseParserSHOW, SQLBaseParserTABLES, SQLBaseParserSCHEMAS, SQLBaseParserCATALOGS, SQLBaseParserCOLUMNS, SQLBaseParserCOLUMN, SQLBaseParserUSE, SQLBaseParserPARTITIONS, SQLBaseParserFUNCTIONS, SQLBaseParserTO, SQLBaseParserSYSTEM, SQLBaseParserBERNOULLI, SQLBaseParserPOISSONIZED, SQLBaseParserTABLESAMPLE, SQLBaseParserARRAY, SQLBaseParserMAP, SQLBaseParserSET, SQLBaseParserRESET, SQLBaseParserSESSION, SQLBaseParserDATA, SQLBaseParserSTART, SQLBaseParserTRANSACTION, SQLBaseParserCOMMIT, SQLBaseParserROLLBACK, SQLBaseParserWORK, SQLBaseParserISOLATION, SQLBaseParserLEVEL, SQLBaseParserSERIALIZABLE, SQLBaseParserREPEATABLE, SQLBaseParserCOMMITTED, SQLBaseParserUNCOMMITTED, SQLBaseParserREAD, SQLBaseParserWRITE, SQLBaseParserONLY, SQLBaseParserCALL, SQLBaseParserINPUT, SQLBaseParserOUTPUT, SQLBaseParserCASCADE, SQLBaseParserRESTRICT, SQLBaseParserINCLUDING, SQLBaseParserEXCLUDING, SQLBaseParserPROPERTIES, SQLBaseParserNFD, SQLBaseParserNFC, SQLBaseParserNFKD, SQLBaseParserNFKC, SQLBaseParserIF, SQLBaseParserNULLIF, SQLBaseParserCOALESCE, SQLBaseParserTIME_WITH_TIME_ZONE, SQLBaseParserTIMESTAMP_WITH_TIME_ZONE, SQLBaseParserDOUBLE_PRECISION, SQLBaseParserIDENTIFIER, SQLBaseParserDIGIT_IDENTIFIER, SQLBaseParserQUOTED_IDENTIFIER, SQLBaseParserBACKQUOTED_IDENTIFIER, SQLBaseParserSYMMETRIC:
 		p.EnterOuterAlt(localctx, 2)
 		{
 			p.SetState(1186)
@@ -18020,7 +18038,7 @@ func (p *SQLBaseParser) Privilege() (localctx IPrivilegeContext) {
 			p.Match(SQLBaseParserINSERT)
 		}
 
-	case SQLBaseParserADD, SQLBaseParserALL, SQLBaseParserSOME, SQLBaseParserANY, SQLBaseParserAT, SQLBaseParserNO, SQLBaseParserSUBSTRING, SQLBaseParserPOSITION, SQLBaseParserTINYINT, SQLBaseParserSMALLINT, SQLBaseParserINTEGER, SQLBaseParserDATE, SQLBaseParserTIME, SQLBaseParserTIMESTAMP, SQLBaseParserINTERVAL, SQLBaseParserYEAR, SQLBaseParserMONTH, SQLBaseParserDAY, SQLBaseParserHOUR, SQLBaseParserMINUTE, SQLBaseParserSECOND, SQLBaseParserZONE, SQLBaseParserFILTER, SQLBaseParserOVER, SQLBaseParserPARTITION, SQLBaseParserRANGE, SQLBaseParserROWS, SQLBaseParserPRECEDING, SQLBaseParserFOLLOWING, SQLBaseParserCURRENT, SQLBaseParserROW, SQLBaseParserSCHEMA, SQLBaseParserCOMMENT, SQLBaseParserVIEW, SQLBaseParserREPLACE, SQLBaseParserGRANT, SQLBaseParserREVOKE, SQLBaseParserPRIVILEGES, SQLBaseParserPUBLIC, SQLBaseParserOPTION, SQLBaseParserEXPLAIN, SQLBaseParserANALYZE, SQLBaseParserFORMAT, SQLBaseParserTYPE, SQLBaseParserTEXT, SQLBaseParserGRAPHVIZ, SQLBaseParserLOGICAL, SQLBaseParserDISTRIBUTED, SQLBaseParserVALIDATE, SQLBaseParserSHOW, SQLBaseParserTABLES, SQLBaseParserSCHEMAS, SQLBaseParserCATALOGS, SQLBaseParserCOLUMNS, SQLBaseParserCOLUMN, SQLBaseParserUSE, SQLBaseParserPARTITIONS, SQLBaseParserFUNCTIONS, SQLBaseParserTO, SQLBaseParserSYSTEM, SQLBaseParserBERNOULLI, SQLBaseParserPOISSONIZED, SQLBaseParserTABLESAMPLE, SQLBaseParserARRAY, SQLBaseParserMAP, SQLBaseParserSET, SQLBaseParserRESET, SQLBaseParserSESSION, SQLBaseParserDATA, SQLBaseParserSTART, SQLBaseParserTRANSACTION, SQLBaseParserCOMMIT, SQLBaseParserROLLBACK, SQLBaseParserWORK, SQLBaseParserISOLATION, SQLBaseParserLEVEL, SQLBaseParserSERIALIZABLE, SQLBaseParserREPEATABLE, SQLBaseParserCOMMITTED, SQLBaseParserUNCOMMITTED, SQLBaseParserREAD, SQLBaseParserWRITE, SQLBaseParserONLY, SQLBaseParserCALL, SQLBaseParserINPUT, SQLBaseParserOUTPUT, SQLBaseParserCASCADE, SQLBaseParserRESTRICT, SQLBaseParserINCLUDING, SQLBaseParserEXCLUDING, SQLBaseParserPROPERTIES, SQLBaseParserNFD, SQLBaseParserNFC, SQLBaseParserNFKD, SQLBaseParserNFKC, SQLBaseParserIF, SQLBaseParserNULLIF, SQLBaseParserCOALESCE, SQLBaseParserIDENTIFIER, SQLBaseParserDIGIT_IDENTIFIER, SQLBaseParserQUOTED_IDENTIFIER, SQLBaseParserBACKQUOTED_IDENTIFIER:
+	case SQLBaseParserADD, SQLBaseParserALL, SQLBaseParserSOME, SQLBaseParserANY, SQLBaseParserAT, SQLBaseParserNO, SQLBaseParserSUBSTRING, SQLBaseParserPOSITION, SQLBaseParserTINYINT, SQLBaseParserSMALLINT, SQLBaseParserINTEGER, SQLBaseParserDATE, SQLBaseParserTIME, SQLBaseParserTIMESTAMP, SQLBaseParserINTERVAL, SQLBaseParserYEAR, SQLBaseParserMONTH, SQLBaseParserDAY, SQLBaseParserHOUR, SQLBaseParserMINUTE, SQLBaseParserSECOND, SQLBaseParserZONE, SQLBaseParserFILTER, SQLBaseParserOVER, SQLBaseParserPARTITION, SQLBaseParserRANGE, SQLBaseParserROWS, SQLBaseParserPRECEDING, SQLBaseParserFOLLOWING, SQLBaseParserCURRENT, SQLBaseParserROW, SQLBaseParserSCHEMA, SQLBaseParserCOMMENT, SQLBaseParserVIEW, SQLBaseParserREPLACE, SQLBaseParserGRANT, SQLBaseParserREVOKE, SQLBaseParserPRIVILEGES, SQLBaseParserPUBLIC, SQLBaseParserOPTION, SQLBaseParserEXPLAIN, SQLBaseParserANALYZE, SQLBaseParserFORMAT, SQLBaseParserTYPE, SQLBaseParserTEXT, SQLBaseParserGRAPHVIZ, SQLBaseParserLOGICAL, SQLBaseParserDISTRIBUTED, SQLBaseParserVALIDATE, SQLBaseParserSHOW, SQLBaseParserTABLES, SQLBaseParserSCHEMAS, SQLBaseParserCATALOGS, SQLBaseParserCOLUMNS, SQLBaseParserCOLUMN, SQLBaseParserUSE, SQLBaseParserPARTITIONS, SQLBaseParserFUNCTIONS, SQLBaseParserTO, SQLBaseParserSYSTEM, SQLBaseParserBERNOULLI, SQLBaseParserPOISSONIZED, SQLBaseParserTABLESAMPLE, SQLBaseParserARRAY, SQLBaseParserMAP, SQLBaseParserSET, SQLBaseParserRESET, SQLBaseParserSESSION, SQLBaseParserDATA, SQLBaseParserSTART, SQLBaseParserTRANSACTION, SQLBaseParserCOMMIT, SQLBaseParserROLLBACK, SQLBaseParserWORK, SQLBaseParserISOLATION, SQLBaseParserLEVEL, SQLBaseParserSERIALIZABLE, SQLBaseParserREPEATABLE, SQLBaseParserCOMMITTED, SQLBaseParserUNCOMMITTED, SQLBaseParserREAD, SQLBaseParserWRITE, SQLBaseParserONLY, SQLBaseParserCALL, SQLBaseParserINPUT, SQLBaseParserOUTPUT, SQLBaseParserCASCADE, SQLBaseParserRESTRICT, SQLBaseParserINCLUDING, SQLBaseParserEXCLUDING, SQLBaseParserPROPERTIES, SQLBaseParserNFD, SQLBaseParserNFC, SQLBaseParserNFKD, SQLBaseParserNFKC, SQLBaseParserIF, SQLBaseParserNULLIF, SQLBaseParserCOALESCE, SQLBaseParserIDENTIFIER, SQLBaseParserDIGIT_IDENTIFIER, SQLBaseParserQUOTED_IDENTIFIER, SQLBaseParserBACKQUOTED_IDENTIFIER, SQLBaseParserSYMMETRIC:
 		p.EnterOuterAlt(localctx, 4)
 		{
 			p.SetState(1289)
@@ -18471,7 +18489,7 @@ func (p *SQLBaseParser) Identifier() (localctx IIdentifierContext) {
 			p.Match(SQLBaseParserBACKQUOTED_IDENTIFIER)
 		}
 
-	case SQLBaseParserADD, SQLBaseParserALL, SQLBaseParserSOME, SQLBaseParserANY, SQLBaseParserAT, SQLBaseParserNO, SQLBaseParserSUBSTRING, SQLBaseParserPOSITION, SQLBaseParserTINYINT, SQLBaseParserSMALLINT, SQLBaseParserINTEGER, SQLBaseParserDATE, SQLBaseParserTIME, SQLBaseParserTIMESTAMP, SQLBaseParserINTERVAL, SQLBaseParserYEAR, SQLBaseParserMONTH, SQLBaseParserDAY, SQLBaseParserHOUR, SQLBaseParserMINUTE, SQLBaseParserSECOND, SQLBaseParserZONE, SQLBaseParserFILTER, SQLBaseParserOVER, SQLBaseParserPARTITION, SQLBaseParserRANGE, SQLBaseParserROWS, SQLBaseParserPRECEDING, SQLBaseParserFOLLOWING, SQLBaseParserCURRENT, SQLBaseParserROW, SQLBaseParserSCHEMA, SQLBaseParserCOMMENT, SQLBaseParserVIEW, SQLBaseParserREPLACE, SQLBaseParserGRANT, SQLBaseParserREVOKE, SQLBaseParserPRIVILEGES, SQLBaseParserPUBLIC, SQLBaseParserOPTION, SQLBaseParserEXPLAIN, SQLBaseParserANALYZE, SQLBaseParserFORMAT, SQLBaseParserTYPE, SQLBaseParserTEXT, SQLBaseParserGRAPHVIZ, SQLBaseParserLOGICAL, SQLBaseParserDISTRIBUTED, SQLBaseParserVALIDATE, SQLBaseParserSHOW, SQLBaseParserTABLES, SQLBaseParserSCHEMAS, SQLBaseParserCATALOGS, SQLBaseParserCOLUMNS, SQLBaseParserCOLUMN, SQLBaseParserUSE, SQLBaseParserPARTITIONS, SQLBaseParserFUNCTIONS, SQLBaseParserTO, SQLBaseParserSYSTEM, SQLBaseParserBERNOULLI, SQLBaseParserPOISSONIZED, SQLBaseParserTABLESAMPLE, SQLBaseParserARRAY, SQLBaseParserMAP, SQLBaseParserSET, SQLBaseParserRESET, SQLBaseParserSESSION, SQLBaseParserDATA, SQLBaseParserSTART, SQLBaseParserTRANSACTION, SQLBaseParserCOMMIT, SQLBaseParserROLLBACK, SQLBaseParserWORK, SQLBaseParserISOLATION, SQLBaseParserLEVEL, SQLBaseParserSERIALIZABLE, SQLBaseParserREPEATABLE, SQLBaseParserCOMMITTED, SQLBaseParserUNCOMMITTED, SQLBaseParserREAD, SQLBaseParserWRITE, SQLBaseParserONLY, SQLBaseParserCALL, SQLBaseParserINPUT, SQLBaseParserOUTPUT, SQLBaseParserCASCADE, SQLBaseParserRESTRICT, SQLBaseParserINCLUDING, SQLBaseParserEXCLUDING, SQLBaseParserPROPERTIES, SQLBaseParserNFD, SQLBaseParserNFC, SQLBaseParserNFKD, SQLBaseParserNFKC, SQLBaseParserIF, SQLBaseParserNULLIF, SQLBaseParserCOALESCE:
+	case SQLBaseParserADD, SQLBaseParserALL, SQLBaseParserSOME, SQLBaseParserANY, SQLBaseParserAT, SQLBaseParserNO, SQLBaseParserSUBSTRING, SQLBaseParserPOSITION, SQLBaseParserTINYINT, SQLBaseParserSMALLINT, SQLBaseParserINTEGER, SQLBaseParserDATE, SQLBaseParserTIME, SQLBaseParserTIMESTAMP, SQLBaseParserINTERVAL, SQLBaseParserYEAR, SQLBaseParserMONTH, SQLBaseParserDAY, SQLBaseParserHOUR, SQLBaseParserMINUTE, SQLBaseParserSECOND, SQLBaseParserZONE, SQLBaseParserFILTER, SQLBaseParserOVER, SQLBaseParserPARTITION, SQLBaseParserRANGE, SQLBaseParserROWS, SQLBaseParserPRECEDING, SQLBaseParserFOLLOWING, SQLBaseParserCURRENT, SQLBaseParserROW, SQLBaseParserSCHEMA, SQLBaseParserCOMMENT, SQLBaseParserVIEW, SQLBaseParserREPLACE, SQLBaseParserGRANT, SQLBaseParserREVOKE, SQLBaseParserPRIVILEGES, SQLBaseParserPUBLIC, SQLBaseParserOPTION, SQLBaseParserEXPLAIN, SQLBaseParserANALYZE, SQLBaseParserFORMAT, SQLBaseParserTYPE, SQLBaseParserTEXT, SQLBaseParserGRAPHVIZ, SQLBaseParserLOGICAL, SQLBaseParserDISTRIBUTED, SQLBaseParserVALIDATE, SQLBaseParserSHOW, SQLBaseParserTABLES, SQLBaseParserSCHEMAS, SQLBaseParserCATALOGS, SQLBaseParserCOLUMNS, SQLBaseParserCOLUMN, SQLBaseParserUSE, SQLBaseParserPARTITIONS, SQLBaseParserFUNCTIONS, SQLBaseParserTO, SQLBaseParserSYSTEM, SQLBaseParserBERNOULLI, SQLBaseParserPOISSONIZED, SQLBaseParserTABLESAMPLE, SQLBaseParserARRAY, SQLBaseParserMAP, SQLBaseParserSET, SQLBaseParserRESET, SQLBaseParserSESSION, SQLBaseParserDATA, SQLBaseParserSTART, SQLBaseParserTRANSACTION, SQLBaseParserCOMMIT, SQLBaseParserROLLBACK, SQLBaseParserWORK, SQLBaseParserISOLATION, SQLBaseParserLEVEL, SQLBaseParserSERIALIZABLE, SQLBaseParserREPEATABLE, SQLBaseParserCOMMITTED, SQLBaseParserUNCOMMITTED, SQLBaseParserREAD, SQLBaseParserWRITE, SQLBaseParserONLY, SQLBaseParserCALL, SQLBaseParserINPUT, SQLBaseParserOUTPUT, SQLBaseParserCASCADE, SQLBaseParserRESTRICT, SQLBaseParserINCLUDING, SQLBaseParserEXCLUDING, SQLBaseParserPROPERTIES, SQLBaseParserNFD, SQLBaseParserNFC, SQLBaseParserNFKD, SQLBaseParserNFKC, SQLBaseParserIF, SQLBaseParserNULLIF, SQLBaseParserCOALESCE, SQLBaseParserSYMMETRIC:
 		localctx = NewNonReservedIdentifierContext(p, localctx)
 		p.EnterOuterAlt(localctx, 5)
 		{
@@ -18961,7 +18979,7 @@ func (p *SQLBaseParser) NonReserved() (localctx INonReservedContext) {
 	p.SetState(1307)
 	_la = p.GetTokenStream().LA(1)
 
-	if !((((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLBaseParserADD)|(1<<SQLBaseParserALL)|(1<<SQLBaseParserSOME)|(1<<SQLBaseParserANY)|(1<<SQLBaseParserAT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SQLBaseParserNO-33))|(1<<(SQLBaseParserSUBSTRING-33))|(1<<(SQLBaseParserPOSITION-33))|(1<<(SQLBaseParserTINYINT-33))|(1<<(SQLBaseParserSMALLINT-33))|(1<<(SQLBaseParserINTEGER-33))|(1<<(SQLBaseParserDATE-33))|(1<<(SQLBaseParserTIME-33))|(1<<(SQLBaseParserTIMESTAMP-33))|(1<<(SQLBaseParserINTERVAL-33))|(1<<(SQLBaseParserYEAR-33))|(1<<(SQLBaseParserMONTH-33))|(1<<(SQLBaseParserDAY-33))|(1<<(SQLBaseParserHOUR-33))|(1<<(SQLBaseParserMINUTE-33))|(1<<(SQLBaseParserSECOND-33))|(1<<(SQLBaseParserZONE-33)))) != 0) || (((_la-85)&-(0x1f+1)) == 0 && ((1<<uint((_la-85)))&((1<<(SQLBaseParserFILTER-85))|(1<<(SQLBaseParserOVER-85))|(1<<(SQLBaseParserPARTITION-85))|(1<<(SQLBaseParserRANGE-85))|(1<<(SQLBaseParserROWS-85))|(1<<(SQLBaseParserPRECEDING-85))|(1<<(SQLBaseParserFOLLOWING-85))|(1<<(SQLBaseParserCURRENT-85))|(1<<(SQLBaseParserROW-85))|(1<<(SQLBaseParserSCHEMA-85))|(1<<(SQLBaseParserCOMMENT-85))|(1<<(SQLBaseParserVIEW-85))|(1<<(SQLBaseParserREPLACE-85))|(1<<(SQLBaseParserGRANT-85))|(1<<(SQLBaseParserREVOKE-85))|(1<<(SQLBaseParserPRIVILEGES-85))|(1<<(SQLBaseParserPUBLIC-85))|(1<<(SQLBaseParserOPTION-85))|(1<<(SQLBaseParserEXPLAIN-85))|(1<<(SQLBaseParserANALYZE-85))|(1<<(SQLBaseParserFORMAT-85)))) != 0) || (((_la-117)&-(0x1f+1)) == 0 && ((1<<uint((_la-117)))&((1<<(SQLBaseParserTYPE-117))|(1<<(SQLBaseParserTEXT-117))|(1<<(SQLBaseParserGRAPHVIZ-117))|(1<<(SQLBaseParserLOGICAL-117))|(1<<(SQLBaseParserDISTRIBUTED-117))|(1<<(SQLBaseParserVALIDATE-117))|(1<<(SQLBaseParserSHOW-117))|(1<<(SQLBaseParserTABLES-117))|(1<<(SQLBaseParserSCHEMAS-117))|(1<<(SQLBaseParserCATALOGS-117))|(1<<(SQLBaseParserCOLUMNS-117))|(1<<(SQLBaseParserCOLUMN-117))|(1<<(SQLBaseParserUSE-117))|(1<<(SQLBaseParserPARTITIONS-117))|(1<<(SQLBaseParserFUNCTIONS-117))|(1<<(SQLBaseParserTO-117))|(1<<(SQLBaseParserSYSTEM-117))|(1<<(SQLBaseParserBERNOULLI-117))|(1<<(SQLBaseParserPOISSONIZED-117))|(1<<(SQLBaseParserTABLESAMPLE-117))|(1<<(SQLBaseParserARRAY-117))|(1<<(SQLBaseParserMAP-117)))) != 0) || (((_la-149)&-(0x1f+1)) == 0 && ((1<<uint((_la-149)))&((1<<(SQLBaseParserSET-149))|(1<<(SQLBaseParserRESET-149))|(1<<(SQLBaseParserSESSION-149))|(1<<(SQLBaseParserDATA-149))|(1<<(SQLBaseParserSTART-149))|(1<<(SQLBaseParserTRANSACTION-149))|(1<<(SQLBaseParserCOMMIT-149))|(1<<(SQLBaseParserROLLBACK-149))|(1<<(SQLBaseParserWORK-149))|(1<<(SQLBaseParserISOLATION-149))|(1<<(SQLBaseParserLEVEL-149))|(1<<(SQLBaseParserSERIALIZABLE-149))|(1<<(SQLBaseParserREPEATABLE-149))|(1<<(SQLBaseParserCOMMITTED-149))|(1<<(SQLBaseParserUNCOMMITTED-149))|(1<<(SQLBaseParserREAD-149))|(1<<(SQLBaseParserWRITE-149))|(1<<(SQLBaseParserONLY-149))|(1<<(SQLBaseParserCALL-149))|(1<<(SQLBaseParserINPUT-149))|(1<<(SQLBaseParserOUTPUT-149))|(1<<(SQLBaseParserCASCADE-149))|(1<<(SQLBaseParserRESTRICT-149))|(1<<(SQLBaseParserINCLUDING-149))|(1<<(SQLBaseParserEXCLUDING-149))|(1<<(SQLBaseParserPROPERTIES-149))|(1<<(SQLBaseParserNFD-149))|(1<<(SQLBaseParserNFC-149)))) != 0) || (((_la-181)&-(0x1f+1)) == 0 && ((1<<uint((_la-181)))&((1<<(SQLBaseParserNFKD-181))|(1<<(SQLBaseParserNFKC-181))|(1<<(SQLBaseParserIF-181))|(1<<(SQLBaseParserNULLIF-181))|(1<<(SQLBaseParserCOALESCE-181)))) != 0)) {
+	if !((((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLBaseParserADD)|(1<<SQLBaseParserALL)|(1<<SQLBaseParserSOME)|(1<<SQLBaseParserANY)|(1<<SQLBaseParserAT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SQLBaseParserNO-33))|(1<<(SQLBaseParserSUBSTRING-33))|(1<<(SQLBaseParserPOSITION-33))|(1<<(SQLBaseParserTINYINT-33))|(1<<(SQLBaseParserSMALLINT-33))|(1<<(SQLBaseParserINTEGER-33))|(1<<(SQLBaseParserDATE-33))|(1<<(SQLBaseParserTIME-33))|(1<<(SQLBaseParserTIMESTAMP-33))|(1<<(SQLBaseParserINTERVAL-33))|(1<<(SQLBaseParserYEAR-33))|(1<<(SQLBaseParserMONTH-33))|(1<<(SQLBaseParserDAY-33))|(1<<(SQLBaseParserHOUR-33))|(1<<(SQLBaseParserMINUTE-33))|(1<<(SQLBaseParserSECOND-33))|(1<<(SQLBaseParserZONE-33)))) != 0) || (((_la-85)&-(0x1f+1)) == 0 && ((1<<uint((_la-85)))&((1<<(SQLBaseParserFILTER-85))|(1<<(SQLBaseParserOVER-85))|(1<<(SQLBaseParserPARTITION-85))|(1<<(SQLBaseParserRANGE-85))|(1<<(SQLBaseParserROWS-85))|(1<<(SQLBaseParserPRECEDING-85))|(1<<(SQLBaseParserFOLLOWING-85))|(1<<(SQLBaseParserCURRENT-85))|(1<<(SQLBaseParserROW-85))|(1<<(SQLBaseParserSCHEMA-85))|(1<<(SQLBaseParserCOMMENT-85))|(1<<(SQLBaseParserVIEW-85))|(1<<(SQLBaseParserREPLACE-85))|(1<<(SQLBaseParserGRANT-85))|(1<<(SQLBaseParserREVOKE-85))|(1<<(SQLBaseParserPRIVILEGES-85))|(1<<(SQLBaseParserPUBLIC-85))|(1<<(SQLBaseParserOPTION-85))|(1<<(SQLBaseParserEXPLAIN-85))|(1<<(SQLBaseParserANALYZE-85))|(1<<(SQLBaseParserFORMAT-85)))) != 0) || (((_la-117)&-(0x1f+1)) == 0 && ((1<<uint((_la-117)))&((1<<(SQLBaseParserTYPE-117))|(1<<(SQLBaseParserTEXT-117))|(1<<(SQLBaseParserGRAPHVIZ-117))|(1<<(SQLBaseParserLOGICAL-117))|(1<<(SQLBaseParserDISTRIBUTED-117))|(1<<(SQLBaseParserVALIDATE-117))|(1<<(SQLBaseParserSHOW-117))|(1<<(SQLBaseParserTABLES-117))|(1<<(SQLBaseParserSCHEMAS-117))|(1<<(SQLBaseParserCATALOGS-117))|(1<<(SQLBaseParserCOLUMNS-117))|(1<<(SQLBaseParserCOLUMN-117))|(1<<(SQLBaseParserUSE-117))|(1<<(SQLBaseParserPARTITIONS-117))|(1<<(SQLBaseParserFUNCTIONS-117))|(1<<(SQLBaseParserTO-117))|(1<<(SQLBaseParserSYSTEM-117))|(1<<(SQLBaseParserBERNOULLI-117))|(1<<(SQLBaseParserPOISSONIZED-117))|(1<<(SQLBaseParserTABLESAMPLE-117))|(1<<(SQLBaseParserARRAY-117))|(1<<(SQLBaseParserMAP-117)))) != 0) || (((_la-149)&-(0x1f+1)) == 0 && ((1<<uint((_la-149)))&((1<<(SQLBaseParserSET-149))|(1<<(SQLBaseParserRESET-149))|(1<<(SQLBaseParserSESSION-149))|(1<<(SQLBaseParserDATA-149))|(1<<(SQLBaseParserSTART-149))|(1<<(SQLBaseParserTRANSACTION-149))|(1<<(SQLBaseParserCOMMIT-149))|(1<<(SQLBaseParserROLLBACK-149))|(1<<(SQLBaseParserWORK-149))|(1<<(SQLBaseParserISOLATION-149))|(1<<(SQLBaseParserLEVEL-149))|(1<<(SQLBaseParserSERIALIZABLE-149))|(1<<(SQLBaseParserREPEATABLE-149))|(1<<(SQLBaseParserCOMMITTED-149))|(1<<(SQLBaseParserUNCOMMITTED-149))|(1<<(SQLBaseParserREAD-149))|(1<<(SQLBaseParserWRITE-149))|(1<<(SQLBaseParserONLY-149))|(1<<(SQLBaseParserCALL-149))|(1<<(SQLBaseParserINPUT-149))|(1<<(SQLBaseParserOUTPUT-149))|(1<<(SQLBaseParserCASCADE-149))|(1<<(SQLBaseParserRESTRICT-149))|(1<<(SQLBaseParserINCLUDING-149))|(1<<(SQLBaseParserEXCLUDING-149))|(1<<(SQLBaseParserPROPERTIES-149))|(1<<(SQLBaseParserNFD-149))|(1<<(SQLBaseParserNFC-149)))) != 0) || (((_la-181)&-(0x1f+1)) == 0 && ((1<<uint((_la-181)))&((1<<(SQLBaseParserNFKD-181))|(1<<(SQLBaseParserNFKC-181))|(1<<(SQLBaseParserIF-181))|(1<<(SQLBaseParserNULLIF-181))|(1<<(SQLBaseParserCOALESCE-181)))) != 0) || _la == SQLBaseParserSYMMETRIC) {
 		p.GetErrorHandler().RecoverInline(p)
 	} else {
 		p.GetErrorHandler().ReportMatch(p)
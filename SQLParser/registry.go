@@ -8,6 +8,7 @@ import (
 	"github.com/alpacahq/marketstore/uda/count"
 	"github.com/alpacahq/marketstore/uda/max"
 	"github.com/alpacahq/marketstore/uda/min"
+	"github.com/alpacahq/marketstore/uda/zscore"
 )
 
 var AggRegistry = map[string]uda.AggInterface{
@@ -23,4 +24,6 @@ var AggRegistry = map[string]uda.AggInterface{
 	"max":           &max.Max{},
 	"Avg":           &avg.Avg{},
 	"avg":           &avg.Avg{},
+	"ZScore":        &zscore.ZScore{},
+	"zscore":        &zscore.ZScore{},
 }
@@ -1143,8 +1143,8 @@ func (sp *QuantifiedComparisonParse) String(level int) (out []string) {
 
 type BetweenParse struct {
 	MSTree
-	IsNot        bool
-	lower, upper IMSTree
+	IsNot, IsSymmetric bool
+	lower, upper       IMSTree
 }
 
 func NewBetweenParse(node antlr.Tree) (term *BetweenParse) {
@@ -1155,6 +1155,9 @@ func NewBetweenParse(node antlr.Tree) (term *BetweenParse) {
 	if ctx.NOT() != nil {
 		term.IsNot = true
 	}
+	if ctx.SYMMETRIC() != nil {
+		term.IsSymmetric = true
+	}
 	return term
 }
 func (sp *BetweenParse) String(level int) (out []string) {
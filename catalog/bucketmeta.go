@@ -0,0 +1,149 @@
+package catalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/alpacahq/marketstore/utils/io"
+)
+
+// BucketMetadataName is the file holding the creation and last write times
+// of a bucket and the storage tiers of its year files, kept in its directory
+// next to its year files
+const BucketMetadataName = "bucket.json"
+
+type bucketMetadata struct {
+	CreatedAt      time.Time `json:"created_at"`
+	LastModifiedAt time.Time `json:"last_modified_at"`
+	// Years of the files moved to the cold storage
+	ColdYears []int16 `json:"cold_years,omitempty"`
+}
+
+func (meta *bucketMetadata) storageTier(year int16) io.StorageTier {
+	for _, y := range meta.ColdYears {
+		if y == year {
+			return io.ColdTier
+		}
+	}
+	return io.HotTier
+}
+
+// Serializes the updates of the metadata files
+var bucketMetadataMu sync.Mutex
+
+func readBucketMetadata(dir string) (meta bucketMetadata, err error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, BucketMetadataName))
+	if err != nil {
+		return meta, err
+	}
+	err = json.Unmarshal(data, &meta)
+	return meta, err
+}
+
+// writeBucketMetadata replaces the metadata file of the bucket directory by
+// renaming, so that it is never seen partly written
+func writeBucketMetadata(dir string, meta bucketMetadata) error {
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	tmpPath := filepath.Join(dir, BucketMetadataName+".tmp")
+	if err = ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	if err = os.Rename(tmpPath, filepath.Join(dir, BucketMetadataName)); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
+
+// initBucketMetadata sets the creation time of the bucket directory if it
+// has none yet, i.e. when the bucket is first created
+func initBucketMetadata(dir string) error {
+	bucketMetadataMu.Lock()
+	defer bucketMetadataMu.Unlock()
+	if _, err := os.Stat(filepath.Join(dir, BucketMetadataName)); !os.IsNotExist(err) {
+		return err
+	}
+	now := time.Now().UTC()
+	return writeBucketMetadata(dir, bucketMetadata{CreatedAt: now, LastModifiedAt: now})
+}
+
+// setBucketMetadata sets the times and storage tiers of the metadata on the
+// year files of the directory
+func (d *Directory) setBucketMetadata(meta bucketMetadata) {
+	d.RLock()
+	defer d.RUnlock()
+	for _, tbi := range d.datafile {
+		tbi.SetBucketTimes(meta.CreatedAt, meta.LastModifiedAt)
+		tbi.SetStorageTier(meta.storageTier(tbi.Year))
+	}
+}
+
+// SetLastModified records t as the time of the last write to the bucket
+// holding the year file at fullFilePath, in its metadata file and on the
+// TimeBucketInfo of its year files. The creation time of a bucket created
+// before the metadata files were kept is left unknown.
+func (d *Directory) SetLastModified(fullFilePath string, t time.Time) error {
+	subDir, err := d.GetOwningSubDirectory(fullFilePath)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(fullFilePath)
+	bucketMetadataMu.Lock()
+	defer bucketMetadataMu.Unlock()
+	meta, err := readBucketMetadata(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	meta.LastModifiedAt = t.UTC()
+	if err = writeBucketMetadata(dir, meta); err != nil {
+		return err
+	}
+	subDir.setBucketMetadata(meta)
+	return nil
+}
+
+// SetStorageTier records tier as the storage of the year file at
+// fullFilePath, in the metadata file of its bucket and on its
+// TimeBucketInfo.
+func (d *Directory) SetStorageTier(fullFilePath string, tier io.StorageTier) error {
+	subDir, err := d.GetOwningSubDirectory(fullFilePath)
+	if err != nil {
+		return err
+	}
+	subDir.RLock()
+	tbi, ok := subDir.datafile[fullFilePath]
+	subDir.RUnlock()
+	if !ok {
+		return fmt.Errorf("Year file %s not found in catalog", fullFilePath)
+	}
+	dir := filepath.Dir(fullFilePath)
+	bucketMetadataMu.Lock()
+	defer bucketMetadataMu.Unlock()
+	meta, err := readBucketMetadata(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	coldYears := meta.ColdYears[:0:0]
+	for _, year := range meta.ColdYears {
+		if year != tbi.Year {
+			coldYears = append(coldYears, year)
+		}
+	}
+	if tier == io.ColdTier {
+		coldYears = append(coldYears, tbi.Year)
+	}
+	meta.ColdYears = coldYears
+	if err = writeBucketMetadata(dir, meta); err != nil {
+		return err
+	}
+	subDir.setBucketMetadata(meta)
+	return nil
+}
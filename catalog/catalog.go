@@ -33,6 +33,8 @@ type Directory struct {
 	/*
 		datafile[Key]: Key is the fully specified path to the datafile, including rootPath and filename
 	*/
+	treeMu sync.Mutex
+	trees  map[string]*TreeNode // Kept by the root for each top level item, see Tree
 }
 
 func NewDirectory(rootpath string) *Directory {
@@ -51,55 +53,13 @@ func (dRoot *Directory) AddTimeBucket(tbk *io.TimeBucketKey, f *io.TimeBucketInf
 	*/
 	dRoot.Lock()
 	defer dRoot.Unlock()
-	exists := func(path string) bool {
-		_, err := os.Stat(path)
-		if err == nil {
-			return true
-		}
-		if os.IsNotExist(err) {
-			return false
-		}
-		return true
-	}
-	writeCatName := func(catName, dirName string) error {
-		catNameFile := filepath.Join(dirName, "category_name")
-		if !exists(catNameFile) {
-			fp, err := os.OpenFile(catNameFile, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0770)
-			defer fp.Close()
-			if err != nil {
-				return fmt.Errorf(io.GetCallerFileContext(0) + err.Error())
-			}
-			if _, err = fp.WriteString(catName); err != nil {
-				return fmt.Errorf(io.GetCallerFileContext(0) + err.Error())
-			}
-		} else {
-			buffer, err := ioutil.ReadFile(catNameFile)
-			if err != nil {
-				return err
-			}
-			catNameFromFile := string(buffer)
-			if catNameFromFile != catName {
-				return fmt.Errorf("Category name does not match on-disk name")
-			}
-		}
-		return nil
-	}
 
 	catkeySplit := tbk.GetCategories()
 	datakeySplit := tbk.GetItems()
 
-	dirname := dRoot.GetPath()
-	for i, dataDirName := range datakeySplit {
-		subdirname := filepath.Join(dirname, dataDirName)
-		if !exists(subdirname) {
-			if err = os.Mkdir(subdirname, 0770); err != nil {
-				return fmt.Errorf(io.GetCallerFileContext(0) + err.Error())
-			}
-		}
-		if err = writeCatName(catkeySplit[i], dirname); err != nil {
-			return fmt.Errorf(io.GetCallerFileContext(0) + err.Error())
-		}
-		dirname = subdirname
+	dirname, err := dRoot.makeItemDirs(catkeySplit, datakeySplit)
+	if err != nil {
+		return err
 	}
 	// Write the last implied catName "Year"
 	if err = writeCatName("Year", dirname); err != nil {
@@ -110,6 +70,9 @@ func (dRoot *Directory) AddTimeBucket(tbk *io.TimeBucketKey, f *io.TimeBucketInf
 	if err = newTimeBucketInfoFromTemplate(f); err != nil {
 		return err
 	}
+	if err = initBucketMetadata(dirname); err != nil {
+		return err
+	}
 
 	/*
 		Check to see if this is an empty top level directory, if so - we need to set
@@ -126,9 +89,158 @@ func (dRoot *Directory) AddTimeBucket(tbk *io.TimeBucketKey, f *io.TimeBucketInf
 	childNodePath := filepath.Join(dRoot.GetPath(), childNodeName)
 	childDirectory := NewDirectory(childNodePath)
 	dRoot.addSubdir(childDirectory, childNodeName)
+	dRoot.resetTree(childNodePath)
 	return nil
 }
 
+func pathExists(path string) bool {
+	_, err := os.Stat(path)
+	if err == nil {
+		return true
+	}
+	if os.IsNotExist(err) {
+		return false
+	}
+	return true
+}
+
+func writeCatName(catName, dirName string) error {
+	catNameFile := filepath.Join(dirName, "category_name")
+	if !pathExists(catNameFile) {
+		fp, err := os.OpenFile(catNameFile, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0770)
+		defer fp.Close()
+		if err != nil {
+			return fmt.Errorf(io.GetCallerFileContext(0) + err.Error())
+		}
+		if _, err = fp.WriteString(catName); err != nil {
+			return fmt.Errorf(io.GetCallerFileContext(0) + err.Error())
+		}
+	} else {
+		buffer, err := ioutil.ReadFile(catNameFile)
+		if err != nil {
+			return err
+		}
+		catNameFromFile := string(buffer)
+		if catNameFromFile != catName {
+			return fmt.Errorf("Category name does not match on-disk name")
+		}
+	}
+	return nil
+}
+
+// makeItemDirs creates the directory of each item below the root, writing the
+// category name of the items in their parent. It returns the directory of the
+// last item.
+func (dRoot *Directory) makeItemDirs(catkeySplit, datakeySplit []string) (dirname string, err error) {
+	dirname = dRoot.GetPath()
+	for i, dataDirName := range datakeySplit {
+		subdirname := filepath.Join(dirname, dataDirName)
+		if !pathExists(subdirname) {
+			if err = os.Mkdir(subdirname, 0770); err != nil {
+				return "", fmt.Errorf(io.GetCallerFileContext(0) + err.Error())
+			}
+		}
+		if err = writeCatName(catkeySplit[i], dirname); err != nil {
+			return "", fmt.Errorf(io.GetCallerFileContext(0) + err.Error())
+		}
+		dirname = subdirname
+	}
+	return dirname, nil
+}
+
+// RenameTimeBucket moves the data files of the bucket at oldKey to newKey with
+// a single rename of its directory and reloads the affected parts of the
+// catalog. Directories left empty by the move are removed.
+func (dRoot *Directory) RenameTimeBucket(oldKey, newKey *io.TimeBucketKey) (err error) {
+	dRoot.Lock()
+	defer dRoot.Unlock()
+
+	if oldKey.GetCatKey() != newKey.GetCatKey() {
+		return fmt.Errorf("Category keys do not match: %s != %s", oldKey.GetCatKey(), newKey.GetCatKey())
+	}
+	oldPath := oldKey.GetPathToYearFiles(dRoot.GetPath())
+	newPath := newKey.GetPathToYearFiles(dRoot.GetPath())
+	if !pathExists(oldPath) {
+		return UnableToLocateSubDir(oldPath)
+	}
+	if pathExists(newPath) {
+		return FileAlreadyExists(newPath)
+	}
+
+	catkeySplit := newKey.GetCategories()
+	datakeySplit := newKey.GetItems()
+	last := len(datakeySplit) - 1
+	parent, err := dRoot.makeItemDirs(catkeySplit[:last], datakeySplit[:last])
+	if err != nil {
+		return err
+	}
+	if err = writeCatName(catkeySplit[last], parent); err != nil {
+		return err
+	}
+	if err = os.Rename(oldPath, newPath); err != nil {
+		return err
+	}
+
+	// Remove the parents of the old bucket that hold nothing but their category name
+	for dir := filepath.Dir(oldPath); dir != filepath.Clean(dRoot.GetPath()); dir = filepath.Dir(dir) {
+		entries, err := ioutil.ReadDir(dir)
+		if err != nil || len(entries) > 1 || (len(entries) == 1 && entries[0].Name() != "category_name") {
+			break
+		}
+		if err = os.RemoveAll(dir); err != nil {
+			break
+		}
+	}
+
+	dRoot.reloadSubDir(oldKey.GetItems()[0])
+	dRoot.reloadSubDir(datakeySplit[0])
+	return nil
+}
+
+// reloadSubDir replaces the tree of the top level item with what is on disk
+func (dRoot *Directory) reloadSubDir(itemName string) {
+	childNodePath := filepath.Join(dRoot.GetPath(), itemName)
+	for key := range dRoot.directMap {
+		if key == childNodePath || strings.HasPrefix(key, childNodePath+"/") {
+			delete(dRoot.directMap, key)
+		}
+	}
+	delete(dRoot.subDirs, itemName)
+	dRoot.catList = nil
+	dRoot.resetTree(childNodePath)
+	if pathExists(childNodePath) {
+		dRoot.addSubdir(NewDirectory(childNodePath), itemName)
+	} else if len(dRoot.subDirs) == 0 {
+		dRoot.subDirs = nil
+	}
+}
+
+// AddTimeBucketFiles creates the directory of the bucket at tbk if needed and
+// has addFiles place year files in it, then reloads the bucket's part of the
+// catalog so the new files are found by queries.
+func (dRoot *Directory) AddTimeBucketFiles(tbk *io.TimeBucketKey, addFiles func(dir string) error) (err error) {
+	dRoot.Lock()
+	defer dRoot.Unlock()
+
+	dirname, err := dRoot.makeItemDirs(tbk.GetCategories(), tbk.GetItems())
+	if err != nil {
+		return err
+	}
+	if err = writeCatName("Year", dirname); err != nil {
+		return err
+	}
+	if err = initBucketMetadata(dirname); err != nil {
+		return err
+	}
+	if len(dRoot.category) == 0 {
+		dRoot.category = tbk.GetCategories()[0]
+	}
+	// Files added before a failure are still picked up
+	err = addFiles(dirname)
+	dRoot.reloadSubDir(tbk.GetItems()[0])
+	return err
+}
+
 func (dRoot *Directory) RemoveTimeBucket(tbk *io.TimeBucketKey) (err error) {
 	/*
 		Deletes the item at the last level specified in the dataItemKey
@@ -139,6 +251,7 @@ func (dRoot *Directory) RemoveTimeBucket(tbk *io.TimeBucketKey) (err error) {
 	}
 
 	datakeySplit := tbk.GetItems()
+	defer dRoot.resetTree(filepath.Join(dRoot.GetPath(), datakeySplit[0]))
 
 	tree := make([]*Directory, len(datakeySplit))
 	current := dRoot
@@ -312,6 +425,7 @@ func (d *Directory) GetSubDirectoryAndAddFile(fullFilePath string, year int16) (
 	defer d.Unlock()
 	dirPath := path.Dir(fullFilePath)
 	if dir, ok := d.directMap[dirPath]; ok {
+		defer d.resetTree(dirPath)
 		return dir.AddFile(year)
 	}
 	return nil, fmt.Errorf("Directory path %s not found in catalog", fullFilePath)
@@ -601,6 +715,12 @@ func (d *Directory) load(rootPath string) error {
 				*/
 			}
 		}
+		// The times are unknown for buckets created before the metadata files
+		if d.datafile != nil {
+			if meta, err := readBucketMetadata(d.pathToItemName); err == nil {
+				d.setBucketMetadata(meta)
+			}
+		}
 		return nil
 	}
 	return loader(d, rootPath, rootPath)
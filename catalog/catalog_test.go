@@ -298,3 +298,26 @@ func exists(path string) bool {
 	}
 	return true
 }
+
+func (s *TestSuite) TestTree(c *C) {
+	d := NewDirectory(s.Rootdir)
+	tree := d.Tree()
+	c.Assert(tree.Files, Equals, len(d.gatherFilePaths()))
+	eurusd := tree.Children["EURUSD"]
+	c.Assert(eurusd, NotNil)
+	bucket := eurusd.Children["1Min"].Children["OHLC"]
+	c.Assert(bucket.Children["2001"].Files, Equals, 1)
+	c.Assert(bucket.Children["2001"].Bytes > 0, Equals, true)
+	c.Assert(tree.Bytes >= eurusd.Bytes, Equals, true)
+
+	// Adding a year only walks its symbol again
+	dir := filepath.Join(s.Rootdir, "EURUSD", "1Min", "OHLC")
+	_, err := d.GetSubDirectoryAndAddFile(filepath.Join(dir, "2001.bin"), 2030)
+	c.Assert(err, IsNil)
+	defer os.Remove(filepath.Join(dir, "2030.bin"))
+	updated := d.Tree()
+	c.Assert(updated.Files, Equals, tree.Files+1)
+	c.Assert(updated.Children["EURUSD"].Files, Equals, eurusd.Files+1)
+	c.Assert(updated.Children["EURUSD"].Children["1Min"].Children["OHLC"].Children["2030"], NotNil)
+	c.Assert(updated.Children["USDJPY"] == tree.Children["USDJPY"], Equals, true)
+}
@@ -0,0 +1,82 @@
+package catalog
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// TreeNode is a level of the bucket hierarchy, with the number and total size
+// in bytes of the year files below it. Buckets are the levels whose children
+// are years.
+type TreeNode struct {
+	Files    int                  `json:"files"`
+	Bytes    int64                `json:"bytes"`
+	Children map[string]*TreeNode `json:"children,omitempty"`
+}
+
+// Tree returns the hierarchy below the root directory dRoot. The tree of each
+// top level item, e.g. a symbol, is kept until a bucket or year file is added
+// or removed below it, so that only the items that changed are walked again.
+// The sizes are those of the files when their item was last walked.
+func (dRoot *Directory) Tree() *TreeNode {
+	dRoot.RLock()
+	defer dRoot.RUnlock()
+	dRoot.treeMu.Lock()
+	defer dRoot.treeMu.Unlock()
+
+	if dRoot.trees == nil {
+		dRoot.trees = make(map[string]*TreeNode)
+	}
+	root := &TreeNode{Children: make(map[string]*TreeNode, len(dRoot.subDirs))}
+	for name, subDir := range dRoot.subDirs {
+		child, ok := dRoot.trees[name]
+		if !ok {
+			child = subDir.walkTree()
+			dRoot.trees[name] = child
+		}
+		root.Children[name] = child
+		root.Files += child.Files
+		root.Bytes += child.Bytes
+	}
+	return root
+}
+
+func (d *Directory) walkTree() *TreeNode {
+	d.RLock()
+	defer d.RUnlock()
+	node := &TreeNode{}
+	if len(d.subDirs) > 0 {
+		node.Children = make(map[string]*TreeNode, len(d.subDirs))
+	}
+	for name, subDir := range d.subDirs {
+		child := subDir.walkTree()
+		node.Children[name] = child
+		node.Files += child.Files
+		node.Bytes += child.Bytes
+	}
+	for filePath, tbi := range d.datafile {
+		fi, err := os.Stat(filePath)
+		if err != nil {
+			continue
+		}
+		if node.Children == nil {
+			node.Children = make(map[string]*TreeNode, len(d.datafile))
+		}
+		node.Children[strings.TrimSuffix(filepath.Base(tbi.Path), ".bin")] = &TreeNode{Files: 1, Bytes: fi.Size()}
+		node.Files++
+		node.Bytes += fi.Size()
+	}
+	return node
+}
+
+// resetTree drops the tree kept for the top level item holding path
+func (dRoot *Directory) resetTree(path string) {
+	rel, err := filepath.Rel(dRoot.GetPath(), path)
+	if err != nil {
+		return
+	}
+	dRoot.treeMu.Lock()
+	delete(dRoot.trees, strings.Split(rel, string(filepath.Separator))[0])
+	dRoot.treeMu.Unlock()
+}
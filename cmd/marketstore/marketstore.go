@@ -5,13 +5,17 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"os"
 	"os/signal"
 	"runtime/pprof"
+	"strings"
 	"sync/atomic"
 	"syscall"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
 	"github.com/alpacahq/marketstore/executor"
 	"github.com/alpacahq/marketstore/frontend"
 	"github.com/alpacahq/marketstore/frontend/stream"
@@ -51,6 +55,22 @@ func init() {
 		Log(FATAL, "No configuration file provided.")
 	}
 
+	// "marketstore pause" and "marketstore resume" ask the running instance
+	// to halt and resume writes, "marketstore tier promote|demote <key> <year>"
+	// to move a year file to or back from the cold storage
+	switch cmd := flag.Arg(0); cmd {
+	case "":
+	case "pause", "resume":
+		os.Exit(requestWriteGate(cmd))
+	case "tier":
+		if flag.NArg() != 4 {
+			Log(FATAL, "Usage: marketstore tier promote|demote <key> <year>")
+		}
+		os.Exit(requestTier(flag.Arg(1), flag.Arg(2), flag.Arg(3)))
+	default:
+		Log(FATAL, "Unknown command: %s", cmd)
+	}
+
 	sigChannel := make(chan os.Signal)
 	go func() {
 		for sig := range sigChannel {
@@ -85,6 +105,16 @@ func main() {
 	stream.Initialize()
 	go http.HandleFunc("/ws", stream.Handler)
 
+	http.HandleFunc("/catalog/years/", frontend.YearsHandler)
+	http.HandleFunc("/catalog/metadata/", frontend.BucketMetadataHandler)
+	http.HandleFunc("/catalog/tree", frontend.TreeHandler)
+	http.HandleFunc("/config", frontend.ConfigHandler)
+	http.HandleFunc("/pause", frontend.PauseHandler)
+	http.HandleFunc("/resume", frontend.ResumeHandler)
+	http.HandleFunc("/admin/vacuum", frontend.VacuumHandler)
+	http.HandleFunc("/admin/tier/", frontend.TierHandler)
+	http.Handle("/metrics", promhttp.Handler())
+
 	InitializeTriggers()
 
 	RunBgWorkers()
@@ -104,7 +134,52 @@ func main() {
 	}
 }
 
+// requestWriteGate sends the pause or resume command to the instance
+// listening on the configured port and returns the exit code
+func requestWriteGate(cmd string) int {
+	resp, err := http.Post("http://localhost"+utils.InstanceConfig.ListenPort+"/"+cmd, "", nil)
+	if err != nil {
+		Log(ERROR, "Failed to %s writes - Error: %v", cmd, err)
+		return 1
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		Log(ERROR, "Failed to %s writes - Error: %s", cmd, strings.TrimSpace(string(body)))
+		return 1
+	}
+	fmt.Printf("Writes are %sd\n", cmd)
+	return 0
+}
+
+// requestTier asks the instance listening on the configured port to promote
+// or demote the year file of key for year and returns the exit code
+func requestTier(action, key, year string) int {
+	query := url.Values{"key": {key}, "year": {year}}
+	req, err := http.NewRequest(http.MethodPost,
+		"http://localhost"+utils.InstanceConfig.ListenPort+"/admin/tier/"+action+"?"+query.Encode(), nil)
+	if err != nil {
+		Log(ERROR, "Failed to %s %s %s - Error: %v", action, key, year, err)
+		return 1
+	}
+	req.Header.Set("Authorization", "Bearer "+utils.InstanceConfig.AdminToken)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		Log(ERROR, "Failed to %s %s %s - Error: %v", action, key, year, err)
+		return 1
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		Log(ERROR, "Failed to %s %s %s - Error: %s", action, key, year, strings.TrimSpace(string(body)))
+		return 1
+	}
+	fmt.Printf("%s %s is %sd\n", key, year, action)
+	return 0
+}
+
 func shutdown() {
+	executor.StopBackgroundTasks()
 	executor.ThisInstance.ShutdownPending = true
 	executor.ThisInstance.WALWg.Wait()
 	Log(INFO, "Exiting...")
@@ -339,3 +339,142 @@ func GetInt64ColumnFromCSVRows(csvRows [][]string, index int) (col []int64, err
 	}
 	return col, nil
 }
+
+// MaxReportedErrors is the number of errors kept in a ValidationReport, the
+// later ones are only counted
+const MaxReportedErrors = 10
+
+// RowError is a problem found in a row of the input file, Row counts the
+// data rows from 1
+type RowError struct {
+	Row    int
+	Column string
+	Err    string
+}
+
+func (re RowError) String() string {
+	if re.Column == "" {
+		return fmt.Sprintf("row %d: %s", re.Row, re.Err)
+	}
+	return fmt.Sprintf("row %d, column %s: %s", re.Row, re.Column, re.Err)
+}
+
+// ValidationReport is the result of validating the rows of an input file
+// without loading them
+type ValidationReport struct {
+	Rows       int
+	ErrorCount int
+	// The first MaxReportedErrors errors
+	Errors []RowError
+	// Adjustment of the time format found by the first parsed time
+	formatAdj  int
+	firstParse bool
+}
+
+func NewValidationReport() *ValidationReport {
+	return &ValidationReport{firstParse: true}
+}
+
+// AddError counts an error of the row, keeping it if it's among the first
+func (vr *ValidationReport) AddError(row int, column string, err error) {
+	vr.ErrorCount++
+	if len(vr.Errors) < MaxReportedErrors {
+		vr.Errors = append(vr.Errors, RowError{Row: row, Column: column, Err: err.Error()})
+	}
+}
+
+// Validate checks the rows of csvData the way a load would parse them: the
+// time index must parse to an epoch after 1970, and every mapped column must
+// hold a value of the type of the DB column. The columnIndex and dataShapes
+// are those of ReadCSVFileMetadata, with the Epoch-date and Epoch-time columns
+// first.
+func (vr *ValidationReport) Validate(csvData [][]string, columnIndex []int, dataShapes []DataShape,
+	conf *Configuration) error {
+
+	mustComposeEpoch := columnIndex[2] == -1
+	if mustComposeEpoch && (columnIndex[0] == -1 || columnIndex[1] == -1) {
+		return fmt.Errorf("Unable to build Epoch time from mapping - need both a date and time")
+	}
+	var tzLoc *time.Location
+	if len(conf.Timezone) != 0 {
+		var err error
+		if tzLoc, err = time.LoadLocation(conf.Timezone); err != nil {
+			return fmt.Errorf("Unable to parse timezone %s: %s", conf.Timezone, err.Error())
+		}
+	}
+
+	for _, row := range csvData {
+		vr.Rows++
+		vr.validateTime(row, columnIndex, conf, tzLoc, mustComposeEpoch)
+		for i := 3; i < len(dataShapes); i++ {
+			shape := dataShapes[i]
+			index := columnIndex[i]
+			if index >= len(row) {
+				vr.AddError(vr.Rows, shape.Name, fmt.Errorf("missing value"))
+				continue
+			}
+			value := strings.TrimSpace(row[index])
+			if value == "" {
+				vr.AddError(vr.Rows, shape.Name, fmt.Errorf("null value"))
+				continue
+			}
+			var err error
+			switch shape.Type {
+			case FLOAT32:
+				_, err = strconv.ParseFloat(value, 32)
+			case FLOAT64:
+				_, err = strconv.ParseFloat(value, 64)
+			case INT32:
+				_, err = strconv.ParseInt(value, 10, 32)
+			case INT64:
+				_, err = strconv.ParseInt(value, 10, 64)
+			}
+			if err != nil {
+				vr.AddError(vr.Rows, shape.Name, fmt.Errorf("not a valid %s: %q", shape.Type, value))
+			}
+		}
+	}
+	return nil
+}
+
+func (vr *ValidationReport) validateTime(row []string, columnIndex []int, conf *Configuration,
+	tzLoc *time.Location, mustComposeEpoch bool) {
+
+	var dateTime string
+	if mustComposeEpoch {
+		if columnIndex[0] >= len(row) || columnIndex[1] >= len(row) {
+			vr.AddError(vr.Rows, "Epoch", fmt.Errorf("missing value"))
+			return
+		}
+		dateTime = row[columnIndex[0]] + " " + row[columnIndex[1]]
+	} else {
+		if columnIndex[2] >= len(row) {
+			vr.AddError(vr.Rows, "Epoch", fmt.Errorf("missing value"))
+			return
+		}
+		dateTime = row[columnIndex[2]]
+	}
+	if strings.TrimSpace(dateTime) == "" {
+		vr.AddError(vr.Rows, "Epoch", fmt.Errorf("null value"))
+		return
+	}
+	if len(dateTime) < vr.formatAdj {
+		vr.AddError(vr.Rows, "Epoch", fmt.Errorf("%q does not match the time format", dateTime))
+		return
+	}
+	rowTime, err := parseTime(conf.TimeFormat, dateTime, tzLoc, vr.formatAdj)
+	if vr.firstParse && err != nil {
+		// Tune the time format like TimeColumnsFromCSV does
+		if vr.formatAdj = len(dateTime) - len(conf.TimeFormat); vr.formatAdj > 0 {
+			rowTime, err = parseTime(conf.TimeFormat, dateTime, tzLoc, vr.formatAdj)
+		} else {
+			vr.formatAdj = 0
+		}
+	}
+	vr.firstParse = false
+	if err != nil {
+		vr.AddError(vr.Rows, "Epoch", err)
+	} else if rowTime.Unix() <= 0 {
+		vr.AddError(vr.Rows, "Epoch", fmt.Errorf("time %v is not after 1970", rowTime.UTC()))
+	}
+}
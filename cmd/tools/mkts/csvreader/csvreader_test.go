@@ -0,0 +1,174 @@
+package csvreader
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/alpacahq/marketstore/utils/io"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type TestSuite struct{}
+
+var _ = Suite(&TestSuite{})
+
+// validationShapes are laid out like the shapes mkts passes to
+// ReadCSVFileMetadata, with the fake Epoch-date and Epoch-time columns first
+var validationShapes = []DataShape{
+	{Name: "Epoch-date", Type: INT64},
+	{Name: "Epoch-time", Type: INT64},
+	{Name: "Epoch", Type: INT64},
+	{Name: "Open", Type: FLOAT32},
+	{Name: "Volume", Type: INT32},
+}
+
+func (s *TestSuite) TestValidate(c *C) {
+	conf := &Configuration{TimeFormat: "2006-01-02 15:04:05", Timezone: "UTC"}
+	columnIndex := []int{-1, -1, 0, 1, 2}
+
+	tests := []struct {
+		name   string
+		rows   [][]string
+		errors []RowError
+	}{
+		{
+			name: "good file",
+			rows: [][]string{
+				{"2019-01-02 09:30:00", "101.5", "100"},
+				{"2019-01-02 09:31:00", "101.75", "200"},
+			},
+		},
+		{
+			name: "bad timestamps",
+			rows: [][]string{
+				{"2019-01-02 09:30:00", "101.5", "100"},
+				{"1960-01-01 00:00:00", "101.5", "100"},
+				{"yesterday", "101.5", "100"},
+				{" ", "101.5", "100"},
+			},
+			errors: []RowError{
+				{Row: 2, Column: "Epoch", Err: "time 1960-01-01 00:00:00 +0000 UTC is not after 1970"},
+				{Row: 3, Column: "Epoch"},
+				{Row: 4, Column: "Epoch", Err: "null value"},
+			},
+		},
+		{
+			name: "column count mismatch",
+			rows: [][]string{
+				{"2019-01-02 09:30:00", "101.5", "100"},
+				{"2019-01-02 09:31:00", "101.75"},
+				{"2019-01-02 09:32:00", "101.75", "300", "extra"},
+			},
+			errors: []RowError{
+				{Row: 2, Column: "Volume", Err: "missing value"},
+			},
+		},
+		{
+			name: "bad values",
+			rows: [][]string{
+				{"2019-01-02 09:30:00", "abc", "100"},
+				{"2019-01-02 09:31:00", "101.75", "1.5"},
+			},
+			errors: []RowError{
+				{Row: 1, Column: "Open", Err: `not a valid FLOAT32: "abc"`},
+				{Row: 2, Column: "Volume", Err: `not a valid INT32: "1.5"`},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		report := NewValidationReport()
+		err := report.Validate(test.rows, columnIndex, validationShapes, conf)
+		c.Assert(err, IsNil, Commentf(test.name))
+		c.Check(report.Rows, Equals, len(test.rows), Commentf(test.name))
+		c.Check(report.ErrorCount, Equals, len(test.errors), Commentf(test.name))
+		c.Assert(report.Errors, HasLen, len(test.errors), Commentf(test.name))
+		for i, want := range test.errors {
+			got := report.Errors[i]
+			c.Check(got.Row, Equals, want.Row, Commentf(test.name))
+			c.Check(got.Column, Equals, want.Column, Commentf(test.name))
+			if want.Err != "" {
+				c.Check(got.Err, Equals, want.Err, Commentf(test.name))
+			} else {
+				c.Check(got.Err, Not(Equals), "", Commentf(test.name))
+			}
+		}
+	}
+}
+
+func (s *TestSuite) TestValidateComposedEpoch(c *C) {
+	conf := &Configuration{TimeFormat: "2006-01-02 15:04:05", Timezone: "UTC"}
+
+	// Without both a date and a time column the Epoch can't be built
+	report := NewValidationReport()
+	err := report.Validate(nil, []int{0, -1, -1, 1, 2}, validationShapes, conf)
+	c.Assert(err, NotNil)
+
+	report = NewValidationReport()
+	rows := [][]string{
+		{"2019-01-02", "09:30:00", "101.5", "100"},
+		{"2019-01-02"},
+	}
+	err = report.Validate(rows, []int{0, 1, -1, 2, 3}, validationShapes, conf)
+	c.Assert(err, IsNil)
+	c.Assert(report.Rows, Equals, 2)
+	c.Assert(report.Errors, HasLen, 3)
+	c.Assert(report.Errors[0], Equals, RowError{Row: 2, Column: "Epoch", Err: "missing value"})
+	c.Assert(report.Errors[1].Column, Equals, "Open")
+	c.Assert(report.Errors[2].Column, Equals, "Volume")
+}
+
+func (s *TestSuite) TestValidateKeepsFirstErrors(c *C) {
+	conf := &Configuration{TimeFormat: "2006-01-02 15:04:05", Timezone: "UTC"}
+	rows := make([][]string, 2*MaxReportedErrors)
+	for i := range rows {
+		rows[i] = []string{"2019-01-02 09:30:00", "101.5", "x"}
+	}
+	report := NewValidationReport()
+	c.Assert(report.Validate(rows, []int{-1, -1, 0, 1, 2}, validationShapes, conf), IsNil)
+	c.Assert(report.ErrorCount, Equals, 2*MaxReportedErrors)
+	c.Assert(report.Errors, HasLen, MaxReportedErrors)
+	c.Assert(report.Errors[MaxReportedErrors-1].Row, Equals, MaxReportedErrors)
+}
+
+func (s *TestSuite) TestReadCSVFileMetadata(c *C) {
+	dir := c.MkDir()
+	dataPath := filepath.Join(dir, "data.csv")
+	controlPath := filepath.Join(dir, "data.yaml")
+	c.Assert(ioutil.WriteFile(dataPath, []byte(
+		"Epoch,Open,Volume\n"+
+			"2019-01-02 09:30:00,101.5,100\n"+
+			"2019-01-02 09:31:00,101.75,200\n"), 0644), IsNil)
+	c.Assert(ioutil.WriteFile(controlPath, []byte(
+		"firstRowHasColumnNames: true\n"+
+			"timeFormat: \"2006-01-02 15:04:05\"\n"), 0644), IsNil)
+	dataFD, err := os.Open(dataPath)
+	c.Assert(err, IsNil)
+	defer dataFD.Close()
+	controlFD, err := os.Open(controlPath)
+	c.Assert(err, IsNil)
+	defer controlFD.Close()
+
+	columnIndex, csvReader, conf, err := ReadCSVFileMetadata(dataFD, controlFD, validationShapes)
+	c.Assert(err, IsNil)
+	c.Assert(columnIndex, DeepEquals, []int{-1, -1, 0, 1, 2})
+
+	var rows [][]string
+	for {
+		row, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, IsNil)
+		rows = append(rows, row)
+	}
+	report := NewValidationReport()
+	c.Assert(report.Validate(rows, columnIndex, validationShapes, conf), IsNil)
+	c.Assert(report.Rows, Equals, 2)
+	c.Assert(report.ErrorCount, Equals, 0)
+}
@@ -29,6 +29,10 @@ func processHelp(line string) {
 
 			>> \show TSLA/1Min/OHLCV 2016-09-15 2016-09-16
 
+		- Example: print the estimated files, bytes and rows read, without reading (local mode):
+
+			>> \show TSLA/1Min/OHLCV 2016-09-15 2016-09-16 explain
+
 	trim: removes the data in the date range from the DB
 	show: displays data in the date range
 	gaps: finds gaps in data in the date range`)
@@ -39,12 +43,16 @@ func processHelp(line string) {
 
 		Syntax:
 
-			>> \load <Symbol/Timeframe/RecordFormat> <csv input file> [<loader control file>]
+			>> \load [--dry-run] <Symbol/Timeframe/RecordFormat> <csv input file> [<loader control file>]
 
 		- Example:
 
 			>> \load TSLA/1Min/RecordFormat test.csv test.yaml
 
+		With --dry-run every row is validated and nothing is written: the time index must
+		parse to an epoch after 1970 and each column must hold a non-empty value of the
+		type of its DB column. The number of errors and the first 10 of them are printed.
+
 		(optional) Loader control file format (YAML):
 		- Example:
 			firstRowHasColumnNames: false
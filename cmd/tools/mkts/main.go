@@ -33,6 +33,7 @@ var _ConnectURL = flag.String("serverURL", "", "network connect to server at \"h
 var _RootDir = flag.String("rootDir", "", "input directory when used in local mode")
 var _OutputDir = flag.String("outputDir", "", "output directory for csv files")
 var toCsv bool = false
+var explain bool = false
 var timingForSQL bool = true // default is: print timing after SQL queries
 var baseURL string           // built from the _ConnectURL
 var localMode bool
@@ -308,6 +309,11 @@ func processTrim(line string) {
 func processLoad(line string) {
 	args := strings.Split(line, " ")
 	args = args[1:]
+	var dryRun bool
+	if len(args) > 0 && args[0] == "--dry-run" {
+		dryRun = true
+		args = args[1:]
+	}
 	if len(args) == 0 {
 		fmt.Println("Not enough arguments to load - try help")
 		return
@@ -328,12 +334,15 @@ func processLoad(line string) {
 		return
 	}
 	/*
-		Obtain a writer
+		Obtain a writer, unless the file is only validated
 	*/
-	dbWriter, err := executor.NewWriter(tbi, executor.ThisInstance.TXNPipe, executor.ThisInstance.CatalogDir)
-	if err != nil {
-		fmt.Printf("Error return from query scanner: %v", err)
-		return
+	var dbWriter *executor.Writer
+	if !dryRun {
+		dbWriter, err = executor.NewWriter(tbi, executor.ThisInstance.TXNPipe, executor.ThisInstance.CatalogDir)
+		if err != nil {
+			fmt.Printf("Error return from query scanner: %v", err)
+			return
+		}
 	}
 
 	/*
@@ -370,6 +379,15 @@ func processLoad(line string) {
 		fmt.Println("Error: ", err.Error())
 		return
 	}
+	if dryRun {
+		report, err := validateCSV(csvReader, columnIndex, dataShapes, conf)
+		if err != nil {
+			fmt.Println("Error: ", err.Error())
+			return
+		}
+		printValidationReport(report)
+		return
+	}
 	/*
 		Now that the columns in the CSV file are mapped into the columnIndex, we can chop the fake column names off
 	*/
@@ -414,6 +432,57 @@ func processLoad(line string) {
 	}
 }
 
+// validateCSV reads every row of the csv file and checks that it could be
+// loaded, nothing is written
+func validateCSV(csvReader *csv.Reader, columnIndex []int, dataShapes []DataShape,
+	conf *csvreader.Configuration) (*csvreader.ValidationReport, error) {
+
+	report := csvreader.NewValidationReport()
+	for {
+		csvChunk := make([][]string, 0)
+		var done bool
+		for i := 0; i < 1000000; i++ {
+			row, err := csvReader.Read()
+			if err == io.EOF {
+				done = true
+				break
+			} else if _, ok := err.(*csv.ParseError); !ok && err != nil {
+				return nil, err
+			} else if err != nil {
+				// Rows that can't be read are reported as they come
+				if len(csvChunk) > 0 {
+					if err := report.Validate(csvChunk, columnIndex, dataShapes, conf); err != nil {
+						return nil, err
+					}
+					csvChunk = csvChunk[:0]
+				}
+				report.Rows++
+				report.AddError(report.Rows, "", err)
+				continue
+			}
+			csvChunk = append(csvChunk, row)
+		}
+		if len(csvChunk) > 0 {
+			if err := report.Validate(csvChunk, columnIndex, dataShapes, conf); err != nil {
+				return nil, err
+			}
+		}
+		if done {
+			return report, nil
+		}
+	}
+}
+
+func printValidationReport(report *csvreader.ValidationReport) {
+	fmt.Printf("Dry run, no data written: %d rows validated, %d errors\n", report.Rows, report.ErrorCount)
+	for _, rowErr := range report.Errors {
+		fmt.Printf("  %s\n", rowErr)
+	}
+	if report.ErrorCount > len(report.Errors) {
+		fmt.Printf("  ... and %d more errors\n", report.ErrorCount-len(report.Errors))
+	}
+}
+
 func writeCSVChunk(dbWriter *executor.Writer, dataShapes []DataShape, dbKey TimeBucketKey, columnIndex []int, csvDataChunk [][]string, conf *csvreader.Configuration) (start, end time.Time) {
 	epochCol, nanosCol := csvreader.TimeColumnsFromCSV(csvDataChunk, columnIndex, conf)
 	if epochCol == nil {
@@ -502,7 +571,7 @@ func parseLoadArgs(args []string) (mk *TimeBucketKey, inputFD, controlFD *os.Fil
 	return nil, nil, nil, nil
 }
 
-func processQueryLocal(tbk *TimeBucketKey, start, end *time.Time) (csm ColumnSeriesMap, err error) {
+func parseQueryLocal(tbk *TimeBucketKey, start, end *time.Time) (pr *planner.ParseResult, err error) {
 	query := planner.NewQuery(executor.ThisInstance.CatalogDir)
 	query.AddTargetKey(tbk)
 
@@ -517,10 +586,18 @@ func processQueryLocal(tbk *TimeBucketKey, start, end *time.Time) (csm ColumnSer
 
 	fmt.Printf("Query range: %v to %v\n", start, end)
 
-	pr, err := query.Parse()
+	pr, err = query.Parse()
 	if err != nil {
 		fmt.Println("No results")
 		Log(ERROR, "Parsing query: %v", err)
+		return nil, err
+	}
+	return pr, nil
+}
+
+func processQueryLocal(tbk *TimeBucketKey, start, end *time.Time) (csm ColumnSeriesMap, err error) {
+	pr, err := parseQueryLocal(tbk, start, end)
+	if pr == nil {
 		return
 	}
 
@@ -538,6 +615,22 @@ func processQueryLocal(tbk *TimeBucketKey, start, end *time.Time) (csm ColumnSer
 	return csm, nil
 }
 
+// explainQueryLocal prints the estimated work of the query without reading data
+func explainQueryLocal(tbk *TimeBucketKey, start, end *time.Time) {
+	pr, _ := parseQueryLocal(tbk, start, end)
+	if pr == nil {
+		return
+	}
+	scanner, err := executor.NewReader(pr)
+	if err != nil {
+		Log(ERROR, "Error return from query scanner: %v", err)
+		return
+	}
+	for key, ps := range scanner.Explain() {
+		fmt.Printf("%v: %d files, %d bytes, %d rows (estimated)\n", key.String(), ps.Files, ps.Bytes, ps.Rows)
+	}
+}
+
 func processQueryRemote(tbk *TimeBucketKey, start, end *time.Time) (csm ColumnSeriesMap, err error) {
 	if end == nil {
 		t := time.Unix(planner.MaxEpoch, 0)
@@ -576,11 +669,20 @@ func processQuery(line string) {
 		return
 	}
 	toCsv = false
+	explain = false
 	tbk, start, end := parseQueryArgs(args)
 	if tbk == nil {
 		fmt.Println("Could not parse arguments, see \"\\help show\" ")
 		return
 	}
+	if explain {
+		if !localMode {
+			fmt.Println("explain is only available in local mode")
+			return
+		}
+		explainQueryLocal(tbk, start, end)
+		return
+	}
 
 	timeStart := time.Now()
 	var csm ColumnSeriesMap
@@ -696,6 +798,8 @@ func parseQueryArgs(args []string) (tbk *TimeBucketKey, start, end *time.Time) {
 		case "and":
 		case "csv":
 			toCsv = true
+		case "explain":
+			explain = true
 		default:
 			if t, err := parseTime(arg); err != nil {
 				Log(ERROR, "Invalid Symbol/Timeframe/recordFormat string %v", arg)
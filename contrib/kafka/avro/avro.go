@@ -0,0 +1,128 @@
+// Package avro encodes the rows of a ColumnSeries as Avro records framed in
+// the Confluent wire format, with the record schemas registered in a schema
+// registry. Only the subset of Avro needed for the MarketStore column types
+// is implemented.
+package avro
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+
+	"github.com/alpacahq/marketstore/utils/io"
+)
+
+// Characters not allowed in Avro names
+var invalidName = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// avroTypes maps the column types to their Avro primitive type
+var avroTypes = map[io.EnumElementType]string{
+	io.BOOL:    "boolean",
+	io.BYTE:    "int",
+	io.INT16:   "int",
+	io.INT32:   "int",
+	io.UINT8:   "int",
+	io.UINT16:  "int",
+	io.INT64:   "long",
+	io.EPOCH:   "long",
+	io.UINT32:  "long",
+	io.UINT64:  "long",
+	io.FLOAT32: "float",
+	io.FLOAT64: "double",
+}
+
+type field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type recordSchema struct {
+	Type      string  `json:"type"`
+	Name      string  `json:"name"`
+	Namespace string  `json:"namespace"`
+	Fields    []field `json:"fields"`
+}
+
+// Schema returns the JSON schema of a record named after the bucket key with
+// one field per data shape, in order
+func Schema(tbk io.TimeBucketKey, dsv []io.DataShape) (string, error) {
+	schema := recordSchema{
+		Type:      "record",
+		Name:      avroName(tbk.GetItemKey()),
+		Namespace: "marketstore",
+	}
+	for _, ds := range dsv {
+		typ, ok := avroTypes[ds.Type]
+		if !ok {
+			return "", fmt.Errorf("avro: column %s of type %v is not supported", ds.Name, ds.Type)
+		}
+		schema.Fields = append(schema.Fields, field{Name: avroName(ds.Name), Type: typ})
+	}
+	data, err := json.Marshal(schema)
+	return string(data), err
+}
+
+// avroName replaces the characters not allowed in the name of an Avro record
+// or field with underscores
+func avroName(name string) string {
+	name = invalidName.ReplaceAllString(name, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	return name
+}
+
+// AppendMessage appends row i of cs, holding the columns of dsv, to buf as a
+// message of the Confluent wire format: a zero byte, the schema ID and the
+// Avro binary encoding of the record
+func AppendMessage(buf []byte, schemaID int32, cs *io.ColumnSeries, dsv []io.DataShape, i int) ([]byte, error) {
+	buf = append(buf, 0, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(buf[len(buf)-4:], uint32(schemaID))
+	for _, ds := range dsv {
+		col := cs.GetByName(ds.Name)
+		if col == nil {
+			return buf, fmt.Errorf("avro: %v: %s", io.ErrColumnNotFound, ds.Name)
+		}
+		value := reflect.ValueOf(col).Index(i)
+		switch avroTypes[ds.Type] {
+		case "boolean":
+			if value.Bool() {
+				buf = append(buf, 1)
+			} else {
+				buf = append(buf, 0)
+			}
+		case "int", "long":
+			var n int64
+			switch value.Kind() {
+			case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				n = value.Int()
+			default:
+				n = int64(value.Uint())
+			}
+			buf = appendLong(buf, n)
+		case "float":
+			buf = append(buf, 0, 0, 0, 0)
+			binary.LittleEndian.PutUint32(buf[len(buf)-4:], math.Float32bits(float32(value.Float())))
+		case "double":
+			buf = append(buf, 0, 0, 0, 0, 0, 0, 0, 0)
+			binary.LittleEndian.PutUint64(buf[len(buf)-8:], math.Float64bits(value.Float()))
+		default:
+			return buf, fmt.Errorf("avro: column %s of type %v is not supported", ds.Name, ds.Type)
+		}
+	}
+	return buf, nil
+}
+
+// appendLong appends the zig-zag variable length encoding of n, used for both
+// int and long
+func appendLong(buf []byte, n int64) []byte {
+	u := uint64(n<<1) ^ uint64(n>>63)
+	for u >= 0x80 {
+		buf = append(buf, byte(u)|0x80)
+		u >>= 7
+	}
+	return append(buf, byte(u))
+}
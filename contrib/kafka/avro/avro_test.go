@@ -0,0 +1,76 @@
+package avro
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alpacahq/marketstore/utils/io"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+var _ = Suite(&TestSuite{})
+
+type TestSuite struct{}
+
+func (s *TestSuite) TestSchema(c *C) {
+	schema, err := Schema(*io.NewTimeBucketKey("BTC-USD/1Min/OHLCV"), []io.DataShape{
+		{Name: "Epoch", Type: io.INT64},
+		{Name: "Close", Type: io.FLOAT32},
+	})
+	c.Assert(err, IsNil)
+	c.Assert(schema, Equals, `{"type":"record","name":"BTC_USD_1Min_OHLCV","namespace":"marketstore",`+
+		`"fields":[{"name":"Epoch","type":"long"},{"name":"Close","type":"float"}]}`)
+
+	_, err = Schema(*io.NewTimeBucketKey("AAPL/1Min/TICK"), []io.DataShape{{Name: "Exchange", Type: io.STRING}})
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestAppendMessage(c *C) {
+	dsv := []io.DataShape{
+		{Name: "Epoch", Type: io.INT64},
+		{Name: "Close", Type: io.FLOAT32},
+		{Name: "Volume", Type: io.INT32},
+		{Name: "Halted", Type: io.BOOL},
+	}
+	cs := io.NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{1, 64})
+	cs.AddColumn("Close", []float32{1.5, 2})
+	cs.AddColumn("Volume", []int32{-1, 300})
+	cs.AddColumn("Halted", []bool{false, true})
+
+	buf, err := AppendMessage(nil, 7, cs, dsv, 1)
+	c.Assert(err, IsNil)
+	// Zig-zag 64 is 128, two varint bytes, and 300 is 600
+	c.Assert(hex.EncodeToString(buf), Equals, "0000000007"+"8001"+"00000040"+"d804"+"01")
+
+	buf, err = AppendMessage(nil, 7, cs, dsv, 0)
+	c.Assert(err, IsNil)
+	c.Assert(hex.EncodeToString(buf), Equals, "0000000007"+"02"+"0000c03f"+"01"+"00")
+}
+
+func (s *TestSuite) TestRegistry(c *C) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests++
+		c.Check(r.URL.Path, Equals, "/subjects/marketstore.AAPL.1Min-value/versions")
+		var body map[string]string
+		c.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		c.Check(body["schema"], Equals, `"long"`)
+		rw.Write([]byte(`{"id":42}`))
+	}))
+	defer server.Close()
+
+	registry := NewRegistry(server.URL + "/")
+	for i := 0; i < 2; i++ {
+		id, err := registry.Register("marketstore.AAPL.1Min-value", `"long"`)
+		c.Assert(err, IsNil)
+		c.Assert(id, Equals, int32(42))
+	}
+	// The ID is kept after the first registration
+	c.Assert(requests, Equals, 1)
+}
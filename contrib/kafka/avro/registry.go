@@ -0,0 +1,69 @@
+package avro
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+const registryContentType = "application/vnd.schemaregistry.v1+json"
+
+// Registry registers schemas in a Confluent schema registry and keeps the
+// IDs it assigned them
+type Registry struct {
+	URL    string
+	Client *http.Client
+
+	mu  sync.Mutex
+	ids map[string]int32 // by subject and schema
+}
+
+// NewRegistry returns a client of the schema registry at url
+func NewRegistry(url string) *Registry {
+	return &Registry{
+		URL:    strings.TrimRight(url, "/"),
+		Client: http.DefaultClient,
+		ids:    map[string]int32{},
+	}
+}
+
+// Register returns the ID of schema under subject, registering it the first
+// time. Registering a schema the registry already has returns its ID.
+func (r *Registry) Register(subject, schema string) (int32, error) {
+	cacheKey := subject + "\x00" + schema
+	r.mu.Lock()
+	id, ok := r.ids[cacheKey]
+	r.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	body, err := json.Marshal(map[string]string{"schema": schema})
+	if err != nil {
+		return 0, err
+	}
+	resp, err := r.Client.Post(r.URL+"/subjects/"+subject+"/versions", registryContentType, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := ioutil.ReadAll(resp.Body)
+		return 0, fmt.Errorf("avro: registering %s: %s: %s", subject, resp.Status, bytes.TrimSpace(msg))
+	}
+	var registered struct {
+		ID int32 `json:"id"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&registered); err != nil {
+		return 0, err
+	}
+
+	r.mu.Lock()
+	r.ids[cacheKey] = registered.ID
+	r.mu.Unlock()
+	return registered.ID, nil
+}
@@ -0,0 +1,16 @@
+// This is a shim package for buiding a plugin module wrapping
+// the importable kafkatrigger package.  For more details, see kafkatrigger.
+package main
+
+import (
+	"github.com/alpacahq/marketstore/contrib/kafka/kafkatrigger"
+	"github.com/alpacahq/marketstore/plugins/trigger"
+)
+
+// NewTrigger returns a new kafka trigger based on the configuration.
+func NewTrigger(conf map[string]interface{}) (trigger.Trigger, error) {
+	return kafkatrigger.NewTrigger(conf)
+}
+
+func main() {
+}
@@ -0,0 +1,125 @@
+// Package kafkatrigger produces the records written to the matched buckets
+// to Kafka, one Avro-encoded message per row, on the topic
+// marketstore.{symbol}.{timeframe}. The brokers and schema registry are
+// taken from the kafka_brokers and kafka_schema_registry settings of the
+// instance configuration.
+package kafkatrigger
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/golang/glog"
+
+	"github.com/alpacahq/marketstore/contrib/kafka/avro"
+	"github.com/alpacahq/marketstore/executor"
+	"github.com/alpacahq/marketstore/plugins/trigger"
+	"github.com/alpacahq/marketstore/utils"
+	"github.com/alpacahq/marketstore/utils/io"
+)
+
+var (
+	errDisabled   = errors.New("kafka_brokers is empty, the kafka trigger is disabled")
+	errNoRegistry = errors.New("kafka_schema_registry is required by the kafka trigger")
+)
+
+// KafkaWritePlugin is the trigger producing the written rows to Kafka
+type KafkaWritePlugin struct {
+	producer *kafka.Producer
+	registry *avro.Registry
+}
+
+var _ trigger.Trigger = &KafkaWritePlugin{}
+
+// NewTrigger returns a new kafka trigger. The trigger config is not used.
+func NewTrigger(conf map[string]interface{}) (trigger.Trigger, error) {
+	brokers := utils.InstanceConfig.KafkaBrokers
+	if len(brokers) == 0 {
+		return nil, errDisabled
+	}
+	if utils.InstanceConfig.KafkaSchemaRegistry == "" {
+		return nil, errNoRegistry
+	}
+	producer, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers": strings.Join(brokers, ","),
+	})
+	if err != nil {
+		return nil, err
+	}
+	go logDeliveryErrors(producer)
+
+	return &KafkaWritePlugin{
+		producer: producer,
+		registry: avro.NewRegistry(utils.InstanceConfig.KafkaSchemaRegistry),
+	}, nil
+}
+
+// logDeliveryErrors reports the messages the brokers failed to take
+func logDeliveryErrors(producer *kafka.Producer) {
+	for e := range producer.Events() {
+		switch ev := e.(type) {
+		case *kafka.Message:
+			if ev.TopicPartition.Error != nil {
+				glog.Errorf("kafka delivery to %s failed: %v", *ev.TopicPartition.Topic, ev.TopicPartition.Error)
+			}
+		case kafka.Error:
+			glog.Errorf("kafka: %v", ev)
+		}
+	}
+}
+
+// Topic returns the name of the topic the rows of tbk are produced to
+func Topic(tbk io.TimeBucketKey) string {
+	return "marketstore." + tbk.GetItemInCategory("Symbol") + "." + tbk.GetItemInCategory("Timeframe")
+}
+
+// Fire implements trigger interface.
+func (k *KafkaWritePlugin) Fire(keyPath string, records []trigger.Record) {
+	elements := strings.Split(keyPath, "/")
+	tf := utils.NewTimeframe(elements[1])
+	fileName := elements[len(elements)-1]
+	year, _ := strconv.Atoi(strings.Replace(fileName, ".bin", "", 1))
+	tbk := io.NewTimeBucketKey(strings.Join(elements[:len(elements)-1], "/"))
+
+	tbi, err := executor.ThisInstance.CatalogDir.GetLatestTimeBucketInfoFromKey(tbk)
+	if err != nil {
+		glog.Errorf("kafka trigger: %s: %v", tbk.String(), err)
+		return
+	}
+	if tbi.GetRecordType() != io.FIXED {
+		glog.Errorf("kafka trigger: %s: only fixed records are supported", tbk.String())
+		return
+	}
+	dsv := tbi.GetDataShapesWithEpoch()
+	cs := trigger.RecordsToColumnSeries(*tbk, dsv, nil, tf.Duration, int16(year), records)
+
+	topic := Topic(*tbk)
+	schema, err := avro.Schema(*tbk, dsv)
+	if err != nil {
+		glog.Errorf("kafka trigger: %s: %v", tbk.String(), err)
+		return
+	}
+	schemaID, err := k.registry.Register(topic+"-value", schema)
+	if err != nil {
+		glog.Errorf("kafka trigger: %s: %v", tbk.String(), err)
+		return
+	}
+
+	for i := 0; i < cs.Len(); i++ {
+		value, err := avro.AppendMessage(nil, schemaID, cs, dsv, i)
+		if err != nil {
+			glog.Errorf("kafka trigger: %s: %v", tbk.String(), err)
+			return
+		}
+		err = k.producer.Produce(&kafka.Message{
+			TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
+			Value:          value,
+		}, nil)
+		if err != nil {
+			glog.Errorf("kafka trigger: producing to %s: %v", topic, err)
+			return
+		}
+	}
+}
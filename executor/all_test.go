@@ -2,19 +2,29 @@ package executor
 
 import (
 	"bytes"
+	"encoding/binary"
+	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"reflect"
+	"runtime"
 	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	. "gopkg.in/check.v1"
 
 	. "github.com/alpacahq/marketstore/catalog"
+	"github.com/alpacahq/marketstore/executor/readhint"
 	. "github.com/alpacahq/marketstore/planner"
 	"github.com/alpacahq/marketstore/utils"
 	. "github.com/alpacahq/marketstore/utils/io"
@@ -27,6 +37,7 @@ func Test(t *testing.T) { TestingT(t) }
 var _ = Suite(&TestSuite{nil, "", nil, nil})
 var _ = Suite(&DestructiveWALTests{nil, "", nil, nil})
 var _ = Suite(&DestructiveWALTest2{nil, "", nil, nil})
+var _ = Suite(&ColdStorageTests{})
 
 type TestSuite struct {
 	DataDirectory *Directory
@@ -44,6 +55,11 @@ type DestructiveWALTests struct {
 	WALFile      *WALFileType
 }
 
+type ColdStorageTests struct {
+	Rootdir string
+	ColdDir string
+}
+
 type DestructiveWALTest2 struct {
 	DataDirectory *Directory
 	Rootdir       string
@@ -250,7 +266,7 @@ func (s *TestSuite) TestFileRead(c *C) {
 	for _, iop := range scanner.IOPMap {
 		c.Assert(len(iop.FilePlan), Equals, 1)
 		for _, fp := range iop.FilePlan {
-			year := int16(time.Unix(fp.BaseTime, 0).UTC().Year())
+			year := int16(time.Unix(0, fp.BaseTime).UTC().Year())
 			if minYear == 0 {
 				minYear = year
 			} else if year < minYear {
@@ -1047,3 +1063,3022 @@ func nearestSecond(seconds int64, nanos int32) int64 {
 	}
 	return seconds
 }
+
+func (s *TestSuite) TestBatchReader(c *C) {
+	newParsed := func(start, end time.Time) *ParseResult {
+		q := NewQuery(s.DataDirectory)
+		q.AddRestriction("Symbol", "NZDUSD")
+		q.AddRestriction("AttributeGroup", "OHLC")
+		q.AddRestriction("Timeframe", "1Min")
+		q.SetRange(start.Unix(), end.Unix())
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		return parsed
+	}
+	count := func(pr *ParseResult) int {
+		reader, err := NewReader(pr)
+		c.Assert(err, IsNil)
+		csm, _, err := reader.Read()
+		c.Assert(err, IsNil)
+		return len(csm[*NewTimeBucketKey("NZDUSD/1Min/OHLC")].GetEpoch())
+	}
+
+	// Two overlapping legs on the same key plus a disjoint one in another year
+	leg1 := newParsed(time.Date(2001, time.March, 1, 0, 0, 0, 0, time.UTC), time.Date(2001, time.June, 1, 0, 0, 0, 0, time.UTC))
+	leg2 := newParsed(time.Date(2001, time.May, 1, 0, 0, 0, 0, time.UTC), time.Date(2001, time.August, 1, 0, 0, 0, 0, time.UTC))
+	leg3 := newParsed(time.Date(2002, time.March, 1, 0, 0, 0, 0, time.UTC), time.Date(2002, time.April, 1, 0, 0, 0, 0, time.UTC))
+	union := newParsed(time.Date(2001, time.March, 1, 0, 0, 0, 0, time.UTC), time.Date(2001, time.August, 1, 0, 0, 0, 0, time.UTC))
+
+	reader, err := NewBatchReader([]*ParseResult{leg1, leg2, leg3})
+	c.Assert(err, IsNil)
+	c.Assert(len(reader.IOPMap), Equals, 1)
+	for _, iop := range reader.IOPMap {
+		c.Assert(len(iop.FilePlan), Equals, 2)
+	}
+	csm, _, err := reader.Read()
+	c.Assert(err, IsNil)
+	epoch := csm[*NewTimeBucketKey("NZDUSD/1Min/OHLC")].GetEpoch()
+	c.Assert(len(epoch) > 0, Equals, true)
+	c.Assert(len(epoch), Equals, count(union)+count(leg3))
+	for i := 1; i < len(epoch); i++ {
+		c.Assert(epoch[i] > epoch[i-1], Equals, true)
+	}
+
+	_, err = NewBatchReader(nil)
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestGetRow(c *C) {
+	q := NewQuery(s.DataDirectory)
+	q.AddRestriction("Symbol", "USDJPY")
+	q.AddRestriction("AttributeGroup", "OHLC")
+	q.AddRestriction("Timeframe", "1Min")
+	q.SetRange(
+		time.Date(2001, time.March, 1, 0, 0, 0, 0, time.UTC).Unix(),
+		time.Date(2001, time.March, 2, 0, 0, 0, 0, time.UTC).Unix(),
+	)
+	q.SetRowLimit(FIRST, 3)
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+	reader, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+	csm, _, err := reader.Read()
+	c.Assert(err, IsNil)
+	tbk := *NewTimeBucketKey("USDJPY/1Min/OHLC")
+	cs := csm[tbk]
+	c.Assert(cs.Len(), Equals, 3)
+
+	rs, err := GetRow(tbk, cs.GetEpoch()[1])
+	c.Assert(err, IsNil)
+	c.Assert(rs.GetNumRows(), Equals, 1)
+	_, row := rs.ToColumnSeries()
+	for _, name := range cs.GetColumnNames() {
+		c.Assert(reflect.ValueOf(row.GetByName(name)).Index(0).Interface(), Equals,
+			reflect.ValueOf(cs.GetByName(name)).Index(1).Interface())
+	}
+
+	// Missing year file
+	_, err = GetRow(tbk, time.Date(1990, time.March, 1, 0, 0, 0, 0, time.UTC).Unix())
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestTruncateAfter(c *C) {
+	tbk := NewTimeBucketKey("TRUNC/1Min/OHLCV")
+	tf := utils.TimeframeFromString("1Min")
+	dsv := NewDataShapeVector(
+		[]string{"Open", "High", "Low", "Close", "Volume"},
+		[]EnumElementType{FLOAT32, FLOAT32, FLOAT32, FLOAT32, INT32})
+	tbinfo := NewTimeBucketInfo(*tf, tbk.GetPathToYearFiles(s.Rootdir), "Test", int16(2016), dsv, FIXED)
+	c.Assert(ThisInstance.CatalogDir.AddTimeBucket(tbk, tbinfo), IsNil)
+
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{})
+	cs.AddColumn("Open", []float32{})
+	cs.AddColumn("High", []float32{})
+	cs.AddColumn("Low", []float32{})
+	cs.AddColumn("Close", []float32{})
+	cs.AddColumn("Volume", []int32{})
+	t0 := time.Date(2016, time.December, 31, 23, 50, 0, 0, time.UTC)
+	for i := 0; i < 20; i++ {
+		c.Assert(cs.AppendRow(t0.Add(time.Duration(i)*time.Minute).Unix(), map[string]interface{}{
+			"Open": float32(1), "High": float32(2), "Low": float32(0.5), "Close": float32(1.5), "Volume": int32(i),
+		}), IsNil)
+	}
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(*tbk, cs)
+	c.Assert(WriteCSM(csm, false), IsNil)
+
+	read := func() []int64 {
+		q := NewQuery(s.DataDirectory)
+		q.AddTargetKey(tbk)
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		reader, err := NewReader(parsed)
+		c.Assert(err, IsNil)
+		csm, _, err := reader.Read()
+		c.Assert(err, IsNil)
+		return csm[*tbk].GetEpoch()
+	}
+	c.Assert(len(read()), Equals, 20)
+
+	// Truncate across the year boundary
+	count, err := TruncateAfter(*tbk, t0.Add(4*time.Minute).Unix())
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, int64(15))
+	epochs := read()
+	c.Assert(len(epochs), Equals, 5)
+	c.Assert(epochs[4], Equals, t0.Add(4*time.Minute).Unix())
+
+	count, err = TruncateAfter(*tbk, t0.Add(10*time.Minute).Unix())
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, int64(0))
+}
+
+func (s *TestSuite) TestResize(c *C) {
+	tbk := NewTimeBucketKey("RSZ/1Min/OHLCV")
+	tf := utils.TimeframeFromString("1Min")
+	dsv := NewDataShapeVector(
+		[]string{"Open", "High", "Low", "Close", "Volume"},
+		[]EnumElementType{FLOAT32, FLOAT32, FLOAT32, FLOAT32, INT32})
+	tbinfo := NewTimeBucketInfo(*tf, tbk.GetPathToYearFiles(s.Rootdir), "Test", int16(2016), dsv, FIXED)
+	c.Assert(ThisInstance.CatalogDir.AddTimeBucket(tbk, tbinfo), IsNil)
+
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{})
+	cs.AddColumn("Open", []float32{})
+	cs.AddColumn("High", []float32{})
+	cs.AddColumn("Low", []float32{})
+	cs.AddColumn("Close", []float32{})
+	cs.AddColumn("Volume", []int32{})
+	t0 := time.Date(2016, time.December, 31, 23, 50, 0, 0, time.UTC)
+	for i := 0; i < 12; i++ {
+		c.Assert(cs.AppendRow(t0.Add(time.Duration(i)*time.Minute).Unix(), map[string]interface{}{
+			"Open": float32(i), "High": float32(i + 10), "Low": float32(i - 10), "Close": float32(i + 1), "Volume": int32(i),
+		}), IsNil)
+	}
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(*tbk, cs)
+	c.Assert(WriteCSM(csm, false), IsNil)
+
+	c.Assert(Resize(*tbk, *utils.TimeframeFromString("30Sec")), Equals, ErrIncompatibleTimeframe)
+	c.Assert(Resize(*tbk, *utils.TimeframeFromString("90Sec")), Equals, ErrIncompatibleTimeframe)
+	c.Assert(Resize(*tbk, *utils.TimeframeFromString("5Min")), IsNil)
+
+	_, err := ThisInstance.CatalogDir.GetLatestTimeBucketInfoFromKey(tbk)
+	c.Assert(err, NotNil)
+
+	newTbk := NewTimeBucketKey("RSZ/5Min/OHLCV")
+	q := NewQuery(ThisInstance.CatalogDir)
+	q.AddTargetKey(newTbk)
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+	reader, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+	csm, _, err = reader.Read()
+	c.Assert(err, IsNil)
+	cs = csm[*newTbk]
+	// 23:50, 23:55 and 00:00 of the next year, which holds two records
+	c.Assert(cs.GetEpoch(), DeepEquals, []int64{
+		t0.Unix(), t0.Add(5 * time.Minute).Unix(), t0.Add(10 * time.Minute).Unix()})
+	c.Assert(cs.GetByName("Open").([]float32), DeepEquals, []float32{0, 5, 10})
+	c.Assert(cs.GetByName("High").([]float32), DeepEquals, []float32{14, 19, 21})
+	c.Assert(cs.GetByName("Low").([]float32), DeepEquals, []float32{-10, -5, 0})
+	c.Assert(cs.GetByName("Close").([]float32), DeepEquals, []float32{5, 10, 12})
+	c.Assert(cs.GetByName("Volume").([]int32), DeepEquals, []int32{10, 35, 21})
+}
+
+func (s *TestSuite) TestReadOlderSchema(c *C) {
+	tbk := NewTimeBucketKey("SCHEMA/1Min/OHLCV")
+	tf := utils.TimeframeFromString("1Min")
+	oldDsv := NewDataShapeVector([]string{"Open", "Close"}, []EnumElementType{FLOAT32, FLOAT32})
+	tbinfo := NewTimeBucketInfo(*tf, tbk.GetPathToYearFiles(s.Rootdir), "Test", int16(2016), oldDsv, FIXED)
+	c.Assert(ThisInstance.CatalogDir.AddTimeBucket(tbk, tbinfo), IsNil)
+
+	t0 := time.Date(2016, time.December, 31, 23, 58, 0, 0, time.UTC)
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{t0.Unix(), t0.Add(time.Minute).Unix()})
+	cs.AddColumn("Open", []float32{1, 2})
+	cs.AddColumn("Close", []float32{3, 4})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(*tbk, cs)
+	c.Assert(WriteCSM(csm, false), IsNil)
+
+	// The 2017 file is written after a Volume column was added to the bucket
+	newDsv := NewDataShapeVector([]string{"Open", "Close", "Volume"}, []EnumElementType{FLOAT32, FLOAT32, INT64})
+	tbinfo = NewTimeBucketInfo(*tf, tbk.GetPathToYearFiles(s.Rootdir), "Test", int16(2017), newDsv, FIXED)
+	c.Assert(ThisInstance.CatalogDir.AddTimeBucket(tbk, tbinfo), IsNil)
+	c.Assert(tbinfo.GetRecordLength(), Not(Equals), int32(16))
+
+	cs = NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{t0.Add(2 * time.Minute).Unix()})
+	cs.AddColumn("Open", []float32{5})
+	cs.AddColumn("Close", []float32{6})
+	cs.AddColumn("Volume", []int64{7})
+	csm = NewColumnSeriesMap()
+	csm.AddColumnSeries(*tbk, cs)
+	c.Assert(WriteCSM(csm, false), IsNil)
+
+	read := func(direction DirectionEnum, limit int) *ColumnSeries {
+		q := NewQuery(ThisInstance.CatalogDir)
+		q.AddTargetKey(tbk)
+		q.SetRowLimit(direction, limit)
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		reader, err := NewReader(parsed)
+		c.Assert(err, IsNil)
+		csm, _, err := reader.Read()
+		c.Assert(err, IsNil)
+		return csm[*tbk]
+	}
+	cs = read(FIRST, 10)
+	c.Assert(cs.GetEpoch(), DeepEquals, []int64{
+		t0.Unix(), t0.Add(time.Minute).Unix(), t0.Add(2 * time.Minute).Unix()})
+	c.Assert(cs.GetByName("Open").([]float32), DeepEquals, []float32{1, 2, 5})
+	c.Assert(cs.GetByName("Close").([]float32), DeepEquals, []float32{3, 4, 6})
+	c.Assert(cs.GetByName("Volume").([]int64), DeepEquals, []int64{0, 0, 7})
+
+	cs = read(LAST, 2)
+	c.Assert(cs.GetEpoch(), DeepEquals, []int64{t0.Add(time.Minute).Unix(), t0.Add(2 * time.Minute).Unix()})
+	c.Assert(cs.GetByName("Open").([]float32), DeepEquals, []float32{2, 5})
+	c.Assert(cs.GetByName("Volume").([]int64), DeepEquals, []int64{0, 7})
+}
+
+func (s *TestSuite) TestWriteBatch(c *C) {
+	dsv := NewDataShapeVector(
+		[]string{"Epoch", "Open", "Close"},
+		[]EnumElementType{INT64, FLOAT32, FLOAT32})
+	t0 := time.Date(2016, time.December, 31, 23, 59, 0, 0, time.UTC)
+	newRows := func(key *TimeBucketKey, cols ...string) *RowSeries {
+		cs := NewColumnSeries()
+		cs.AddColumn("Epoch", []int64{t0.Unix(), t0.Add(time.Minute).Unix()})
+		for _, col := range cols {
+			cs.AddColumn(col, []float32{1, 2})
+		}
+		return cs.ToRowSeries(*key)
+	}
+	good := NewTimeBucketKey("BATCH/1Min/OHLCV")
+	other := NewTimeBucketKey("BATCH2/1Min/OHLCV")
+	bad := NewTimeBucketKey("BATCH3/1Min/OHLCV")
+	tf := utils.TimeframeFromString("1Min")
+	tbinfo := NewTimeBucketInfo(*tf, bad.GetPathToYearFiles(s.Rootdir), "Test", int16(2016), dsv[1:], FIXED)
+	c.Assert(ThisInstance.CatalogDir.AddTimeBucket(bad, tbinfo), IsNil)
+
+	err := WriteBatch(map[TimeBucketKey]*RowSeries{
+		*good:  newRows(good, "Open", "Close"),
+		*other: newRows(other, "Open", "Close"),
+		*bad:   newRows(bad, "Open"),
+	})
+	perr, ok := err.(*PartialWriteError)
+	c.Assert(ok, Equals, true)
+	c.Assert(perr.Succeeded, DeepEquals, []TimeBucketKey{*good, *other})
+	c.Assert(perr.Failed, HasLen, 1)
+	c.Assert(perr.Failed[*bad], NotNil)
+
+	for _, key := range []*TimeBucketKey{good, other} {
+		q := NewQuery(ThisInstance.CatalogDir)
+		q.AddTargetKey(key)
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		reader, err := NewReader(parsed)
+		c.Assert(err, IsNil)
+		csm, _, err := reader.Read()
+		c.Assert(err, IsNil)
+		cs := csm[*key]
+		c.Assert(cs.GetEpoch(), DeepEquals, []int64{t0.Unix(), t0.Add(time.Minute).Unix()})
+		c.Assert(cs.GetByName("Close").([]float32), DeepEquals, []float32{1, 2})
+	}
+}
+
+func (s *TestSuite) TestNextPageIOP(c *C) {
+	key := *NewTimeBucketKey("NZDUSD/1Min/OHLC")
+	newReader := func(limit int) *reader {
+		q := NewQuery(s.DataDirectory)
+		q.AddTargetKey(&key)
+		q.SetRange(
+			time.Date(2001, time.December, 31, 23, 0, 0, 0, time.UTC).Unix(),
+			time.Date(2002, time.January, 3, 0, 0, 0, 0, time.UTC).Unix())
+		q.SetRowLimit(FIRST, limit)
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		reader, err := NewReader(parsed)
+		c.Assert(err, IsNil)
+		return reader
+	}
+	read := func(r *reader) ([]int64, int64) {
+		csm, tPrevMap, err := r.Read()
+		c.Assert(err, IsNil)
+		return csm[key].GetEpoch(), tPrevMap[key]
+	}
+
+	all, _ := read(newReader(300))
+	c.Assert(len(all), Equals, 300)
+
+	// Pages of 100 records, the second one crosses into the 2002 file
+	r := newReader(100)
+	var pages []int64
+	for i := 0; i < 3; i++ {
+		epochs, tPrev := read(r)
+		c.Assert(len(epochs), Equals, 100)
+		if i > 0 {
+			c.Assert(tPrev, Equals, pages[len(pages)-1])
+		}
+		pages = append(pages, epochs...)
+		iop, err := r.IOPMap[key].NextPageIOP(epochs[len(epochs)-1])
+		c.Assert(err, IsNil)
+		r.IOPMap[key] = iop
+	}
+	c.Assert(pages, DeepEquals, all)
+	c.Assert(len(r.IOPMap[key].FilePlan), Equals, 1)
+	c.Assert(r.IOPMap[key].FilePlan[0].GetFileYear(), Equals, int16(2002))
+
+	_, err := r.IOPMap[key].NextPageIOP(all[0])
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestPagedReader(c *C) {
+	key := *NewTimeBucketKey("NZDUSD/1Min/OHLC")
+	parse := func(direction DirectionEnum) *ParseResult {
+		q := NewQuery(s.DataDirectory)
+		q.AddTargetKey(&key)
+		q.SetRange(
+			time.Date(2001, time.December, 31, 23, 0, 0, 0, time.UTC).Unix(),
+			time.Date(2001, time.December, 31, 23, 59, 0, 0, time.UTC).Unix()+250*60)
+		if direction != 0 {
+			q.SetRowLimit(direction, 10)
+		}
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		return parsed
+	}
+	r, err := NewReader(parse(0))
+	c.Assert(err, IsNil)
+	csm, _, err := r.Read()
+	c.Assert(err, IsNil)
+	all := csm[key].GetEpoch()
+	c.Assert(len(all) > 250, Equals, true)
+
+	// The pages cross into the 2002 file, the last one is partial
+	pr, err := NewPagedReader(parse(FIRST), 100)
+	c.Assert(err, IsNil)
+	var epochs []int64
+	var cursor *Cursor
+	for pages := 1; ; pages++ {
+		csm, cursor, err = pr.Read(cursor)
+		c.Assert(err, IsNil)
+		page := csm[key].GetEpoch()
+		c.Assert(len(page) <= 100, Equals, true)
+		epochs = append(epochs, page...)
+		if cursor == nil {
+			c.Assert(pages, Equals, (len(all)+99)/100)
+			break
+		}
+		c.Assert(cursor.LastEpochs[key], Equals, page[len(page)-1])
+	}
+	c.Assert(epochs, DeepEquals, all)
+
+	// A cursor can be read again
+	_, first, err := pr.Read(nil)
+	c.Assert(err, IsNil)
+	csm, _, err = pr.Read(first)
+	c.Assert(err, IsNil)
+	c.Assert(csm[key].GetEpoch(), DeepEquals, all[100:200])
+
+	_, err = NewPagedReader(parse(LAST), 100)
+	c.Assert(err, NotNil)
+	_, err = NewPagedReader(parse(FIRST), 0)
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestFileReplaced(c *C) {
+	key := *NewTimeBucketKey("INODE/1Min/OHLC")
+	base := time.Date(2003, time.March, 3, 10, 0, 0, 0, time.UTC).Unix()
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{base, base + 60})
+	cs.AddColumn("Open", []float32{1, 2})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(key, cs)
+	c.Assert(WriteCSM(csm, false), IsNil)
+
+	q := NewQuery(ThisInstance.CatalogDir)
+	q.AddTargetKey(&key)
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+	r, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+	iop := r.IOPMap[key]
+	fp := iop.FilePlan[0]
+	c.Assert(fp.Inode, Not(Equals), uint64(0))
+
+	// The file is copied over itself like a vacuum does
+	data, err := ioutil.ReadFile(fp.FullPath)
+	c.Assert(err, IsNil)
+	c.Assert(ioutil.WriteFile(fp.FullPath+".copy", data, 0600), IsNil)
+	c.Assert(os.Rename(fp.FullPath+".copy", fp.FullPath), IsNil)
+
+	_, _, err = newIoExec(iop).readForward(nil, fp, iop.RecordLen, math.MaxInt32, make([]byte, 64*iop.RecordLen))
+	c.Assert(err, Equals, ErrFileReplaced)
+
+	// The read goes on with the new file
+	csm, _, err = r.Read()
+	c.Assert(err, IsNil)
+	c.Assert(csm[key].GetEpoch(), DeepEquals, []int64{base, base + 60})
+	c.Assert(fp.Inode, Equals, fileInode(fp.FullPath))
+}
+
+func (s *TestSuite) TestForwardReadBuffer(c *C) {
+	r := &reader{readBuffer: make([]byte, 32)}
+	fp := &ioFilePlan{SuggestedReadSize: 128 << 10}
+	c.Assert(len(r.forwardReadBuffer(fp, 32)), Equals, 128<<10)
+	fp.SuggestedReadSize = 0
+	c.Assert(len(r.forwardReadBuffer(fp, 64)), Equals, 64)
+}
+
+func (s *TestSuite) TestSortPrevFilePlan(c *C) {
+	// Base times out of year order, as for years not starting on January 1
+	fps := []*ioFilePlan{{BaseTime: 300}, {BaseTime: 100}, {BaseTime: 200}}
+	sortPrevFilePlan(fps)
+	for i, baseTime := range []int64{300, 200, 100} {
+		c.Assert(fps[i].BaseTime, Equals, baseTime)
+	}
+}
+
+func (s *TestSuite) TestSortFilePlan(c *C) {
+	fps := []*ioFilePlan{{BaseTime: 300}, {BaseTime: 100}, {BaseTime: 200}}
+	sortFilePlan(fps)
+	for i, baseTime := range []int64{100, 200, 300} {
+		c.Assert(fps[i].BaseTime, Equals, baseTime)
+	}
+}
+
+func (s *TestSuite) TestNoPreviousDataWarning(c *C) {
+	key := *NewTimeBucketKey("NZDUSD/1Min/OHLC")
+	newReader := func(start time.Time, direction DirectionEnum) *reader {
+		q := NewQuery(s.DataDirectory)
+		q.AddTargetKey(&key)
+		q.SetRange(start.Unix(), start.AddDate(0, 1, 0).Unix())
+		q.SetRowLimit(direction, 10)
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		reader, err := NewReader(parsed)
+		c.Assert(err, IsNil)
+		return reader
+	}
+	// The range starts with the first year file
+	r := newReader(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), FIRST)
+	c.Assert(r.Warnings()[key], DeepEquals, []IOPlanWarning{NoPreviousData})
+
+	// Backward scans take tPrev from the records read
+	r = newReader(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), LAST)
+	c.Assert(r.Warnings(), HasLen, 0)
+
+	r = newReader(time.Date(2001, time.March, 1, 0, 0, 0, 0, time.UTC), FIRST)
+	c.Assert(r.Warnings(), HasLen, 0)
+}
+
+func (s *TestSuite) TestRenameSymbol(c *C) {
+	oldKey := NewTimeBucketKey("OLDTICK/1Min/OHLCV")
+	newKey := NewTimeBucketKey("NEWTICK/1Min/OHLCV")
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{time.Date(2017, time.March, 1, 0, 0, 0, 0, time.UTC).Unix()})
+	cs.AddColumn("Open", []float32{1})
+	cs.AddColumn("Close", []float32{2})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(*oldKey, cs)
+	c.Assert(WriteCSM(csm, false), IsNil)
+
+	c.Assert(RenameSymbol(*oldKey, *newKey), IsNil)
+	_, err := ThisInstance.CatalogDir.GetLatestTimeBucketInfoFromKey(oldKey)
+	c.Assert(err, NotNil)
+	_, err = os.Stat(filepath.Join(s.Rootdir, "OLDTICK"))
+	c.Assert(os.IsNotExist(err), Equals, true)
+
+	q := NewQuery(ThisInstance.CatalogDir)
+	q.AddTargetKey(newKey)
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+	reader, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+	csm, _, err = reader.Read()
+	c.Assert(err, IsNil)
+	c.Assert(csm[*newKey].GetByName("Close").([]float32), DeepEquals, []float32{2})
+
+	// Renaming onto an existing bucket
+	csm = NewColumnSeriesMap()
+	csm.AddColumnSeries(*oldKey, cs)
+	c.Assert(WriteCSM(csm, false), IsNil)
+	c.Assert(RenameSymbol(*oldKey, *newKey), Equals, ErrDestinationExists)
+}
+
+func (s *TestSuite) TestMaxVariableRecordLen(c *C) {
+	tbk := NewTimeBucketKey("VARMAX/1Min/TICKS")
+	newCSM := func(rows int) ColumnSeriesMap {
+		t0 := time.Date(2017, time.March, 1, 0, 0, 0, 0, time.UTC)
+		epoch := make([]int64, rows)
+		for i := range epoch {
+			epoch[i] = t0.Unix()
+		}
+		cs := NewColumnSeries()
+		cs.AddColumn("Epoch", epoch)
+		cs.AddColumn("Bid", make([]float32, rows))
+		cs.AddColumn("Ask", make([]float32, rows))
+		csm := NewColumnSeriesMap()
+		csm.AddColumnSeries(*tbk, cs)
+		return csm
+	}
+	defer func(maxLen int) { utils.InstanceConfig.MaxVariableRecordLen = maxLen }(utils.InstanceConfig.MaxVariableRecordLen)
+	utils.InstanceConfig.MaxVariableRecordLen = 120
+
+	// Each row takes 12 bytes on disk, 8 for the data and 4 for the interval ticks
+	c.Assert(WriteCSM(newCSM(10), true), IsNil)
+	c.Assert(WriteCSM(newCSM(11), true), Equals, ErrVariableRecordTooLarge)
+}
+
+func (s *TestSuite) TestReadMissingYearFile(c *C) {
+	tbk := NewTimeBucketKey("NOFILE/1Min/OHLCV")
+	tf := utils.TimeframeFromString("1Min")
+	dsv := NewDataShapeVector([]string{"Open", "Close"}, []EnumElementType{FLOAT32, FLOAT32})
+	tbinfo := NewTimeBucketInfo(*tf, tbk.GetPathToYearFiles(s.Rootdir), "Test", int16(2016), dsv, FIXED)
+	c.Assert(ThisInstance.CatalogDir.AddTimeBucket(tbk, tbinfo), IsNil)
+
+	q := NewQuery(ThisInstance.CatalogDir)
+	q.AddTargetKey(tbk)
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+	// The catalog knows the file, but it is not on disk yet
+	c.Assert(os.Remove(tbinfo.Path), IsNil)
+	reader, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+	// Planning the read doesn't create it, only reading it does
+	_, err = os.Stat(tbinfo.Path)
+	c.Assert(os.IsNotExist(err), Equals, true)
+	csm, _, err := reader.Read()
+	c.Assert(err, IsNil)
+	c.Assert(csm[*tbk].Len(), Equals, 0)
+
+	fi, err := os.Stat(tbinfo.Path)
+	c.Assert(err, IsNil)
+	c.Assert(fi.Size(), Equals, FileSize(tbinfo.GetTimeframe(), 2016, int(tbinfo.GetRecordLength())))
+}
+
+func (s *TestSuite) TestConcurrentFirstWrite(c *C) {
+	tbk := NewTimeBucketKey("FIRSTW/1Min/OHLCV")
+	newCSM := func(year int) ColumnSeriesMap {
+		cs := NewColumnSeries()
+		cs.AddColumn("Epoch", []int64{time.Date(year, time.March, 1, 0, 0, 0, 0, time.UTC).Unix()})
+		cs.AddColumn("Open", []float32{float32(year)})
+		cs.AddColumn("Close", []float32{float32(year)})
+		csm := NewColumnSeriesMap()
+		csm.AddColumnSeries(*tbk, cs)
+		return csm
+	}
+	c.Assert(WriteCSM(newCSM(2010), false), IsNil)
+
+	// Every write adds a year file while the bucket is being read
+	done := make(chan error)
+	go func() {
+		for year := 2011; year < 2020; year++ {
+			if err := WriteCSM(newCSM(year), false); err != nil {
+				done <- err
+				return
+			}
+		}
+		done <- nil
+	}()
+	for finished := false; !finished; {
+		select {
+		case err := <-done:
+			c.Assert(err, IsNil)
+			finished = true
+		default:
+		}
+		q := NewQuery(ThisInstance.CatalogDir)
+		q.AddTargetKey(tbk)
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		reader, err := NewReader(parsed)
+		c.Assert(err, IsNil)
+		_, _, err = reader.Read()
+		c.Assert(err, IsNil)
+	}
+}
+
+func (s *TestSuite) TestListYearsWithData(c *C) {
+	key := *NewTimeBucketKey("USDJPY/1D/OHLC")
+	dir := key.GetPathToYearFiles(s.Rootdir)
+	// A file holding only its header has no data
+	c.Assert(ioutil.WriteFile(filepath.Join(dir, "1999.bin"), make([]byte, Headersize), 0644), IsNil)
+
+	years, err := ListYearsWithData(key)
+	c.Assert(err, IsNil)
+	c.Assert(years, DeepEquals, []int16{2000, 2001, 2002})
+
+	// The listing is cached
+	c.Assert(ioutil.WriteFile(filepath.Join(dir, "2003.bin"), make([]byte, Headersize+8), 0644), IsNil)
+	years, err = ListYearsWithData(key)
+	c.Assert(err, IsNil)
+	c.Assert(years, DeepEquals, []int16{2000, 2001, 2002})
+	c.Assert(os.Remove(filepath.Join(dir, "2003.bin")), IsNil)
+	c.Assert(os.Remove(filepath.Join(dir, "1999.bin")), IsNil)
+
+	_, err = ListYearsWithData(*NewTimeBucketKey("NOSUCH/1D/OHLC"))
+	c.Assert(os.IsNotExist(err), Equals, true)
+
+	// Year files are allocated in full, those without records are left out
+	key = *NewTimeBucketKey("YEARS/1Min/OHLC")
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{time.Date(2003, time.March, 3, 10, 0, 0, 0, time.UTC).Unix()})
+	cs.AddColumn("Open", []float32{1})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(key, cs)
+	c.Assert(WriteCSM(csm, false), IsNil)
+	fileStatsRebuilds.Wait()
+	tbi, err := ThisInstance.CatalogDir.GetLatestTimeBucketInfoFromKey(&key)
+	c.Assert(err, IsNil)
+	empty := tbi.GetDeepCopy()
+	empty.Year = 2004
+	empty.Path = filepath.Join(filepath.Dir(tbi.Path), "2004.bin")
+	c.Assert(createEmptyYearFile(empty), IsNil)
+	fi, err := os.Stat(empty.Path)
+	c.Assert(err, IsNil)
+	c.Assert(fi.Size() > Headersize, Equals, true)
+	// With and without stats
+	noStats := filepath.Join(filepath.Dir(tbi.Path), "2005.bin")
+	c.Assert(os.Link(empty.Path, noStats), IsNil)
+	c.Assert(writeFileStats(empty.Path, &FileStats{}), IsNil)
+	years, err = ListYearsWithData(key)
+	c.Assert(err, IsNil)
+	c.Assert(years, DeepEquals, []int16{2003})
+	for _, path := range []string{empty.Path, statsPath(empty.Path), noStats} {
+		c.Assert(os.Remove(path), IsNil)
+	}
+}
+
+func (s *TestSuite) TestReadAllYears(c *C) {
+	key := *NewTimeBucketKey("READALL/1Min/OHLC")
+	write := func(epochs ...int64) {
+		cs := NewColumnSeries()
+		cs.AddColumn("Epoch", epochs)
+		cs.AddColumn("Open", make([]float32, len(epochs)))
+		csm := NewColumnSeriesMap()
+		csm.AddColumnSeries(key, cs)
+		c.Assert(WriteCSM(csm, false), IsNil)
+	}
+	first := time.Date(2003, time.March, 3, 10, 0, 0, 0, time.UTC).Unix()
+	last := time.Date(2005, time.March, 3, 10, 0, 0, 0, time.UTC).Unix()
+	write(first)
+	write(last)
+
+	cs, err := ReadAllYears(key)
+	c.Assert(err, IsNil)
+	c.Assert(cs.GetEpoch(), DeepEquals, []int64{first, last})
+
+	// The result is cached
+	write(last + 60)
+	cs, err = ReadAllYears(key)
+	c.Assert(err, IsNil)
+	c.Assert(cs.Len(), Equals, 2)
+	readAllMu.Lock()
+	delete(readAllCache, key)
+	readAllMu.Unlock()
+	cs, err = ReadAllYears(key)
+	c.Assert(err, IsNil)
+	c.Assert(cs.GetEpoch(), DeepEquals, []int64{first, last, last + 60})
+
+	// Expired entries are dropped, and failed reads are not cached
+	readAllMu.Lock()
+	readAllCache[key].expires = time.Now()
+	readAllMu.Unlock()
+	noSuch := *NewTimeBucketKey("NOSUCH/1Min/OHLC")
+	_, err = ReadAllYears(noSuch)
+	c.Assert(err, NotNil)
+	readAllMu.Lock()
+	_, cached := readAllCache[key]
+	c.Assert(cached, Equals, false)
+	_, cached = readAllCache[noSuch]
+	c.Assert(cached, Equals, false)
+	readAllMu.Unlock()
+}
+
+func (s *TestSuite) TestBucketTimes(c *C) {
+	key := *NewTimeBucketKey("BUCKETTIMES/1Min/OHLC")
+	write := func(epoch int64) {
+		cs := NewColumnSeries()
+		cs.AddColumn("Epoch", []int64{epoch})
+		cs.AddColumn("Open", []float32{1})
+		csm := NewColumnSeriesMap()
+		csm.AddColumnSeries(key, cs)
+		c.Assert(WriteCSM(csm, false), IsNil)
+	}
+	before := time.Now()
+	write(time.Date(2003, time.March, 3, 10, 0, 0, 0, time.UTC).Unix())
+	tbi, err := ThisInstance.CatalogDir.GetLatestTimeBucketInfoFromKey(&key)
+	c.Assert(err, IsNil)
+	createdAt, modifiedAt := tbi.GetCreatedAt(), tbi.GetLastModifiedAt()
+	c.Assert(createdAt.Before(before), Equals, false)
+	c.Assert(modifiedAt.Before(createdAt), Equals, false)
+
+	// A new year file shares the times of the bucket
+	time.Sleep(10 * time.Millisecond)
+	write(time.Date(2004, time.March, 3, 10, 0, 0, 0, time.UTC).Unix())
+	tbi, err = ThisInstance.CatalogDir.GetLatestTimeBucketInfoFromKey(&key)
+	c.Assert(err, IsNil)
+	c.Assert(tbi.Year, Equals, int16(2004))
+	c.Assert(tbi.GetCreatedAt().Equal(createdAt), Equals, true)
+	c.Assert(tbi.GetLastModifiedAt().After(modifiedAt), Equals, true)
+
+	// The times are loaded back with the catalog
+	reloaded, err := NewDirectory(ThisInstance.RootDir).GetLatestTimeBucketInfoFromKey(&key)
+	c.Assert(err, IsNil)
+	c.Assert(reloaded.GetCreatedAt().Equal(createdAt), Equals, true)
+	c.Assert(reloaded.GetLastModifiedAt().Equal(tbi.GetLastModifiedAt()), Equals, true)
+
+	// Unknown for the buckets created without them
+	tbi, err = ThisInstance.CatalogDir.GetLatestTimeBucketInfoFromKey(NewTimeBucketKey("EURUSD/1Min/OHLC"))
+	c.Assert(err, IsNil)
+	c.Assert(tbi.GetCreatedAt().IsZero(), Equals, true)
+}
+
+func (s *TestSuite) TestCompileTimeQuals(c *C) {
+	var quals []TimeQualFunc
+	for n := 0; n < 6; n++ {
+		// Epoch must be a multiple of every divisor up to n+1
+		ex := newIoExec(&ioplan{TimeQuals: quals})
+		c.Assert(ex.checkTimeQuals(720), Equals, true)
+		c.Assert(ex.checkTimeQuals(7), Equals, n == 0)
+		divisor := int64(n + 2)
+		quals = append(quals, func(epoch int64) bool { return epoch%divisor == 0 })
+	}
+	ex := newIoExec(&ioplan{TimeQuals: quals})
+	c.Assert(ex.checkTimeQuals(420), Equals, true)
+	c.Assert(ex.checkTimeQuals(210), Equals, false)
+
+	// The copy filters with its own quals, the exec it was made from is unchanged
+	odd := ex.WithTimeQuals([]TimeQualFunc{func(epoch int64) bool { return epoch%2 == 1 }})
+	c.Assert(odd.checkTimeQuals(7), Equals, true)
+	c.Assert(odd.checkTimeQuals(420), Equals, false)
+	c.Assert(odd.plan.TimeQuals, HasLen, 1)
+	c.Assert(ex.plan.TimeQuals, HasLen, len(quals))
+	c.Assert(ex.checkTimeQuals(420), Equals, true)
+	c.Assert(ex.WithTimeQuals(nil).checkTimeQuals(7), Equals, true)
+}
+
+func (s *TestSuite) TestExplain(c *C) {
+	key := *NewTimeBucketKey("EURUSD/1Min/OHLC")
+	q := NewQuery(s.DataDirectory)
+	q.AddTargetKey(&key)
+	start := time.Date(2001, time.December, 31, 0, 0, 0, 0, time.UTC)
+	q.SetRange(start.Unix(), start.AddDate(0, 0, 2).Add(-time.Minute).Unix())
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+	reader, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+
+	ps := reader.Explain()[key]
+	c.Assert(ps.Files, Equals, 2)
+	c.Assert(ps.Rows, Equals, int64(2*24*60))
+	c.Assert(ps.Bytes, Equals, ps.Rows*int64(reader.IOPMap[key].RecordLen))
+}
+
+func (s *TestSuite) TestPackingReaderSharedFile(c *C) {
+	key := *NewTimeBucketKey("EURUSD/1Min/OHLC")
+	q := NewQuery(s.DataDirectory)
+	q.AddTargetKey(&key)
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+	reader, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+	iop := reader.IOPMap[key]
+	fp := iop.FilePlan[0]
+	ex := newIoExec(iop)
+
+	f, err := os.Open(fp.FullPath)
+	c.Assert(err, IsNil)
+	defer f.Close()
+
+	// Reads of different ranges on one descriptor from several goroutines
+	recordLen := int64(iop.RecordLen)
+	length := 1000 * recordLen
+	read := func(offset int64) []byte {
+		var packed []byte
+		_, err := ex.packingReader(&packed, f, offset, make([]byte, 64*recordLen), length, 0, fp)
+		c.Check(err, IsNil)
+		return packed
+	}
+	offsets := []int64{fp.Offset, fp.Offset + length, fp.Offset + 2*length, fp.Offset + 3*length}
+	expected := make([][]byte, len(offsets))
+	for i, offset := range offsets {
+		expected[i] = read(offset)
+		c.Assert(len(expected[i]) > 0, Equals, true)
+	}
+	results := make([][]byte, len(offsets))
+	done := make(chan struct{})
+	for i := range offsets {
+		go func(i int) {
+			results[i] = read(offsets[i])
+			done <- struct{}{}
+		}(i)
+	}
+	for range offsets {
+		<-done
+	}
+	c.Assert(results, DeepEquals, expected)
+	pos, err := f.Seek(0, os.SEEK_CUR)
+	c.Assert(err, IsNil)
+	c.Assert(pos, Equals, int64(0))
+}
+
+// partialReaderAt returns every other read short, with io.ErrUnexpectedEOF,
+// or every read empty
+type partialReaderAt struct {
+	io.ReaderAt
+	reads int
+	empty bool
+}
+
+func (r *partialReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	r.reads++
+	if r.empty {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if r.reads%2 == 0 {
+		return r.ReaderAt.ReadAt(p, off)
+	}
+	// Stop in the middle of a record
+	n, err := r.ReaderAt.ReadAt(p[:len(p)/2+3], off)
+	if err == nil {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (s *TestSuite) TestPackingReaderUnexpectedEOF(c *C) {
+	key := *NewTimeBucketKey("EURUSD/1Min/OHLC")
+	q := NewQuery(s.DataDirectory)
+	q.AddTargetKey(&key)
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+	reader, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+	iop := reader.IOPMap[key]
+	fp := iop.FilePlan[0]
+	ex := newIoExec(iop)
+
+	f, err := os.Open(fp.FullPath)
+	c.Assert(err, IsNil)
+	defer f.Close()
+
+	recordLen := int64(iop.RecordLen)
+	length := 1000 * recordLen
+	var expected, packed []byte
+	_, err = ex.packingReader(&expected, f, fp.Offset, make([]byte, 64*recordLen), length, 0, fp)
+	c.Assert(err, IsNil)
+	pr := &partialReaderAt{ReaderAt: f}
+	_, err = ex.packingReader(&packed, pr, fp.Offset, make([]byte, 64*recordLen), length, 0, fp)
+	c.Assert(err, IsNil)
+	c.Assert(pr.reads > 2, Equals, true)
+	c.Assert(packed, DeepEquals, expected)
+
+	// An unexpected EOF without any data is still an error
+	packed = nil
+	_, err = ex.packingReader(&packed, &partialReaderAt{ReaderAt: f, empty: true}, fp.Offset, make([]byte, 64*recordLen), length, 0, fp)
+	c.Assert(err, NotNil)
+	c.Assert(packed, HasLen, 0)
+}
+
+func (s *TestSuite) TestPackingReaderMaxRecords(c *C) {
+	key := *NewTimeBucketKey("EURUSD/1Min/OHLC")
+	q := NewQuery(s.DataDirectory)
+	q.AddTargetKey(&key)
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+	reader, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+	iop := reader.IOPMap[key]
+	fp := iop.FilePlan[0]
+	ex := newIoExec(iop)
+
+	f, err := os.Open(fp.FullPath)
+	c.Assert(err, IsNil)
+	defer f.Close()
+
+	recordLen := int64(iop.RecordLen)
+	length := 1000 * recordLen
+	var all, packed []byte
+	_, err = ex.packingReader(&all, f, fp.Offset, make([]byte, 64*recordLen), length, 0, fp)
+	c.Assert(err, IsNil)
+	c.Assert(int64(len(all)) > 5*recordLen, Equals, true)
+
+	res, err := ex.packingReader(&packed, f, fp.Offset, make([]byte, 64*recordLen), length, 5, fp)
+	c.Assert(err, IsNil)
+	c.Assert(res.Records, Equals, int64(5))
+	c.Assert(res.BytesRead, Equals, res.LastValidOffset-fp.Offset+recordLen)
+	c.Assert(packed, DeepEquals, all[:5*recordLen])
+
+	// The bytes to read may be hit first
+	packed = nil
+	res, err = ex.packingReader(&packed, f, fp.Offset, make([]byte, 64*recordLen), res.BytesRead-recordLen, 5, fp)
+	c.Assert(err, IsNil)
+	c.Assert(res.Records, Equals, int64(4))
+
+	// The forward scans stop once MaxRecords are read
+	ex.MaxRecords = 3
+	result, finished, err := ex.readForward(nil, fp, iop.RecordLen, math.MaxInt32, make([]byte, 64*recordLen))
+	c.Assert(err, IsNil)
+	c.Assert(finished, Equals, true)
+	c.Assert(result, DeepEquals, all[:3*recordLen])
+	result, finished, err = ex.readForward(result, fp, iop.RecordLen, math.MaxInt32, make([]byte, 64*recordLen))
+	c.Assert(err, IsNil)
+	c.Assert(finished, Equals, true)
+	c.Assert(result, HasLen, int(3*recordLen))
+}
+
+func (s *TestSuite) TestCopyBucket(c *C) {
+	src := *NewTimeBucketKey("NZDUSD/1H/OHLC")
+	dst := *NewTimeBucketKey("NZDCOLD/1H/OHLC")
+
+	c.Assert(CopyBucket(src, dst, []int16{2000, 1999}), Equals, ErrYearNotFound)
+	c.Assert(CopyBucket(src, dst, []int16{2000, 2001}), IsNil)
+
+	read := func(key TimeBucketKey) *ColumnSeries {
+		q := NewQuery(ThisInstance.CatalogDir)
+		q.AddTargetKey(&key)
+		q.SetRange(
+			time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC).Unix(),
+			time.Date(2001, time.December, 31, 23, 0, 0, 0, time.UTC).Unix())
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		reader, err := NewReader(parsed)
+		c.Assert(err, IsNil)
+		csm, _, err := reader.Read()
+		c.Assert(err, IsNil)
+		return csm[key]
+	}
+	srcCS, dstCS := read(src), read(dst)
+	c.Assert(dstCS.Len() > 0, Equals, true)
+	c.Assert(dstCS.GetEpoch(), DeepEquals, srcCS.GetEpoch())
+	c.Assert(dstCS.GetByName("Close"), DeepEquals, srcCS.GetByName("Close"))
+
+	// Copying more years into the bucket keeps the ones already there
+	c.Assert(CopyBucket(src, dst, []int16{2001}), Equals, ErrDestinationExists)
+	c.Assert(CopyBucket(src, dst, []int16{2002}), IsNil)
+	tbis, err := getTimeBucketInfos(&dst)
+	c.Assert(err, IsNil)
+	c.Assert(len(tbis), Equals, 3)
+
+	c.Assert(CopyBucket(*NewTimeBucketKey("NZDUSD/1D/OHLC"), dst, []int16{2000}), NotNil)
+}
+
+func (s *TestSuite) TestRowLimitPercent(c *C) {
+	key := *NewTimeBucketKey("EURUSD/1Min/OHLC")
+	newReader := func(percent int) (*reader, error) {
+		q := NewQuery(s.DataDirectory)
+		q.AddTargetKey(&key)
+		start := time.Date(2001, time.March, 1, 0, 0, 0, 0, time.UTC)
+		q.SetRange(start.Unix(), start.AddDate(0, 0, 1).Add(-time.Minute).Unix())
+		q.SetRowLimitPercent(FIRST, percent)
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		return NewReader(parsed)
+	}
+	reader, err := newReader(10)
+	c.Assert(err, IsNil)
+	c.Assert(reader.IOPMap[key].Limit.Number, Equals, int32(144))
+	csm, _, err := reader.Read()
+	c.Assert(err, IsNil)
+	c.Assert(csm[key].Len(), Equals, 144)
+
+	_, err = newReader(101)
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestBucketWriteMetrics(c *C) {
+	tbk := *NewTimeBucketKey("METRICS/1Min/OHLCV")
+	cs := NewColumnSeries()
+	epochs := []int64{
+		time.Date(2016, time.June, 1, 0, 2, 0, 0, time.UTC).Unix(),
+		time.Date(2016, time.June, 1, 0, 1, 0, 0, time.UTC).Unix(),
+	}
+	cs.AddColumn("Epoch", epochs)
+	cs.AddColumn("Open", []float32{1, 2})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(tbk, cs)
+
+	c.Assert(WriteCSM(csm, false), IsNil)
+	c.Assert(WriteCSM(csm, false), IsNil)
+	c.Assert(testutil.ToFloat64(bucketWritesTotal.WithLabelValues("METRICS/1Min/OHLCV")), Equals, float64(2))
+	c.Assert(testutil.ToFloat64(bucketLastWriteEpoch.WithLabelValues("METRICS/1Min/OHLCV")), Equals, float64(epochs[0]))
+}
+
+func (s *TestSuite) TestNearest(c *C) {
+	tbk := *NewTimeBucketKey("NEAR/1Min/OHLCV")
+	at := func(year int, month time.Month, day, hour, min int) int64 {
+		return time.Date(year, month, day, hour, min, 0, 0, time.UTC).Unix()
+	}
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{at(2015, time.December, 31, 23, 50), at(2016, time.June, 1, 10, 0), at(2016, time.June, 1, 10, 10)})
+	cs.AddColumn("Open", []float32{1, 2, 3})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(tbk, cs)
+	c.Assert(WriteCSM(csm, false), IsNil)
+
+	nearest := func(target int64) (*ColumnSeries, error) {
+		q := NewQuery(ThisInstance.CatalogDir)
+		q.AddTargetKey(&tbk)
+		q.SetRange(target, at(2016, time.December, 31, 23, 59))
+		q.SetRowLimit(NEAREST, 1)
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		reader, err := NewReader(parsed)
+		c.Assert(err, IsNil)
+		csm, _, err := reader.Read()
+		return csm[tbk], err
+	}
+	for target, expected := range map[int64]int64{
+		at(2016, time.June, 1, 10, 3):   at(2016, time.June, 1, 10, 0),
+		at(2016, time.June, 1, 10, 7):   at(2016, time.June, 1, 10, 10),
+		at(2016, time.June, 1, 10, 5):   at(2016, time.June, 1, 10, 0),
+		at(2016, time.June, 1, 10, 10):  at(2016, time.June, 1, 10, 10),
+		at(2016, time.January, 1, 0, 5): at(2015, time.December, 31, 23, 50),
+		at(2016, time.July, 1, 0, 0):    at(2016, time.June, 1, 10, 10),
+	} {
+		result, err := nearest(target)
+		c.Assert(err, IsNil)
+		c.Assert(result.GetEpoch(), DeepEquals, []int64{expected})
+	}
+
+	// A query without a range has no target
+	q := NewQuery(ThisInstance.CatalogDir)
+	q.AddTargetKey(&tbk)
+	q.SetRowLimit(NEAREST, 1)
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+	parsed.Range = nil
+	reader, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+	_, _, err = reader.Read()
+	c.Assert(err, Equals, ErrNoNearestTarget)
+
+	// The forward scan stops at the first record after the target
+	q = NewQuery(ThisInstance.CatalogDir)
+	q.AddTargetKey(&tbk)
+	q.SetRange(at(2016, time.June, 1, 9, 0), at(2016, time.December, 31, 23, 59))
+	q.SetRowLimit(NEAREST, 1)
+	parsed, err = q.Parse()
+	c.Assert(err, IsNil)
+	reader, err = NewReader(parsed)
+	c.Assert(err, IsNil)
+	ex := newIoExec(reader.IOPMap[tbk])
+	buffer, err := reader.readNearest(ex, RecordsPerRead*ex.plan.RecordLen)
+	c.Assert(err, IsNil)
+	c.Assert(int64(binary.LittleEndian.Uint64(buffer)), Equals, at(2016, time.June, 1, 10, 0))
+	c.Assert(ex.records, Equals, int64(1))
+
+	_, err = TruncateAfter(tbk, at(2015, time.January, 1, 0, 0))
+	c.Assert(err, IsNil)
+	_, err = nearest(at(2016, time.June, 1, 10, 0))
+	c.Assert(err, Equals, ErrNoDataFound)
+}
+
+func (s *TestSuite) TestReadInvalidFileFormat(c *C) {
+	tbk := *NewTimeBucketKey("NOTMKTS/1Min/OHLCV")
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{time.Date(2016, time.June, 1, 0, 0, 0, 0, time.UTC).Unix()})
+	cs.AddColumn("Open", []float32{1})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(tbk, cs)
+	c.Assert(WriteCSM(csm, false), IsNil)
+
+	q := NewQuery(ThisInstance.CatalogDir)
+	q.AddTargetKey(&tbk)
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+
+	// Some other kind of file where the year file is expected
+	f, err := os.OpenFile(parsed.QualifiedFiles[0].File.Path, os.O_WRONLY, 0)
+	c.Assert(err, IsNil)
+	_, err = f.WriteAt([]byte("PK\x03\x04"), 0)
+	c.Assert(err, IsNil)
+	f.Close()
+
+	reader, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+	_, _, err = reader.Read()
+	c.Assert(err, Equals, ErrInvalidFileFormat)
+}
+
+func (s *TestSuite) TestReadOnly(c *C) {
+	utils.InstanceConfig.ReadOnly = true
+	defer func() { utils.InstanceConfig.ReadOnly = false }()
+
+	tbk := *NewTimeBucketKey("EURUSD/1Min/OHLC")
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{time.Date(2001, time.June, 1, 0, 0, 0, 0, time.UTC).Unix()})
+	cs.AddColumn("Open", []float32{1})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(tbk, cs)
+
+	c.Assert(WriteCSM(csm, false), Equals, ErrReadOnly)
+	c.Assert(WriteBatch(map[TimeBucketKey]*RowSeries{tbk: cs.ToRowSeries(tbk)}), Equals, ErrReadOnly)
+	_, err := TruncateAfter(tbk, 0)
+	c.Assert(err, Equals, ErrReadOnly)
+	c.Assert(RenameSymbol(tbk, *NewTimeBucketKey("EURUSD2/1Min/OHLC")), Equals, ErrReadOnly)
+	c.Assert(CopyBucket(tbk, *NewTimeBucketKey("EURUSD2/1Min/OHLC"), []int16{2001}), Equals, ErrReadOnly)
+	c.Assert(Resize(tbk, *utils.TimeframeFromString("5Min")), Equals, ErrReadOnly)
+	c.Assert(PromoteYearFile(tbk, 2001), Equals, ErrReadOnly)
+
+	q := NewQuery(s.DataDirectory)
+	q.AddTargetKey(&tbk)
+	q.SetRowLimit(LAST, 5)
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+	reader, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+	csm, _, err = reader.Read()
+	c.Assert(err, IsNil)
+	c.Assert(csm[tbk].Len(), Equals, 5)
+}
+
+func (s *TestSuite) TestIOPlanClone(c *C) {
+	key := *NewTimeBucketKey("EURUSD/1Min/OHLC")
+	q := NewQuery(s.DataDirectory)
+	q.AddTargetKey(&key)
+	q.SetRowLimit(LAST, 5)
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+	reader, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+	iop := reader.IOPMap[key]
+	clone := iop.Clone()
+	c.Assert(clone, DeepEquals, iop)
+
+	// Updates to the file plans and limit of the clone do not reach the plan
+	for i := range clone.FilePlan {
+		c.Assert(clone.FilePlan[i] != iop.FilePlan[i], Equals, true)
+	}
+	clone.FilePlan[0].seekingLast = !iop.FilePlan[0].seekingLast
+	clone.Limit.Number = 1
+	c.Assert(iop.FilePlan[0].seekingLast, Not(Equals), clone.FilePlan[0].seekingLast)
+	c.Assert(iop.Limit.Number, Equals, int32(5))
+}
+
+func (s *TestSuite) TestWatch(c *C) {
+	tbk := *NewTimeBucketKey("WATCH/1Min/OHLCV")
+	epoch := time.Date(2016, time.June, 1, 0, 1, 0, 0, time.UTC).Unix()
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{epoch - 60, epoch})
+	cs.AddColumn("Open", []float32{1, 2})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(tbk, cs)
+	c.Assert(WriteCSM(csm, false), IsNil)
+
+	_, _, err := Watch(*NewTimeBucketKey("NOTHERE/1Min/OHLCV"))
+	c.Assert(err, NotNil)
+
+	events, cancel, err := Watch(tbk)
+	c.Assert(err, IsNil)
+	defer cancel()
+	other, cancelOther, err := Watch(tbk)
+	c.Assert(err, IsNil)
+	defer cancelOther()
+	dir := tbk.GetPathToYearFiles(s.Rootdir)
+	watchMu.Lock()
+	c.Assert(len(watches[dir].subs), Equals, 2)
+	watchMu.Unlock()
+
+	// The first write may still be dispatched after the watches begin
+	next := func(ch <-chan WatchEvent, eventType EventType) WatchEvent {
+		for {
+			select {
+			case ev := <-ch:
+				if ev.EventType == eventType {
+					return ev
+				}
+			case <-time.After(5 * time.Second):
+				c.Fatal("timed out waiting for a watch event")
+			}
+		}
+	}
+
+	c.Assert(WriteCSM(csm, false), IsNil)
+	for _, ch := range []<-chan WatchEvent{events, other} {
+		ev := next(ch, Write)
+		c.Assert(ev.Key.String(), Equals, tbk.String())
+		c.Assert(ev.Epoch, Equals, epoch)
+	}
+
+	cancelOther()
+	cancelOther()
+	for range other {
+	}
+
+	c.Assert(os.Remove(filepath.Join(dir, "2016.bin")), IsNil)
+	next(events, Delete)
+
+	cancel()
+	watchMu.Lock()
+	_, ok := watches[dir]
+	watchMu.Unlock()
+	c.Assert(ok, Equals, false)
+}
+
+func (s *TestSuite) TestLastKnownMaxAge(c *C) {
+	utils.InstanceConfig.EnableLastKnown = true
+	defer func() { utils.InstanceConfig.EnableLastKnown = false }()
+
+	path := filepath.Join(s.Rootdir, "HINT/1Min/OHLCV/2016.bin")
+	readhint.SetLastKnownWithTime(path, 1024, time.Now().Add(-time.Hour))
+	offset, ok := readhint.GetLastKnown(path, 0)
+	c.Assert(ok, Equals, true)
+	c.Assert(offset, Equals, int64(1024))
+	_, ok = readhint.GetLastKnown(path, time.Minute)
+	c.Assert(ok, Equals, false)
+
+	// Seeing the same offset again refreshes the hint
+	readhint.SetLastKnown(path, 1024)
+	offset, ok = readhint.GetLastKnown(path, time.Minute)
+	c.Assert(ok, Equals, true)
+	c.Assert(offset, Equals, int64(1024))
+
+	// Smaller offsets are ignored
+	readhint.SetLastKnown(path, 512)
+	offset, _ = readhint.GetLastKnown(path, 0)
+	c.Assert(offset, Equals, int64(1024))
+}
+
+func (s *TestSuite) TestLockFreeHints(c *C) {
+	utils.InstanceConfig.EnableLastKnown = true
+	utils.InstanceConfig.LockFreeHints = true
+	defer func() {
+		utils.InstanceConfig.EnableLastKnown = false
+		utils.InstanceConfig.LockFreeHints = false
+	}()
+
+	path := filepath.Join(s.Rootdir, "LOCKFREE/1Min/OHLCV/2016.bin")
+	_, ok := readhint.GetLastKnown(path, 0)
+	c.Assert(ok, Equals, false)
+	readhint.SetLastKnownWithTime(path, 1024, time.Now().Add(-time.Hour))
+	offset, ok := readhint.GetLastKnown(path, 0)
+	c.Assert(ok, Equals, true)
+	c.Assert(offset, Equals, int64(1024))
+	_, ok = readhint.GetLastKnown(path, time.Minute)
+	c.Assert(ok, Equals, false)
+
+	// Seeing the same offset again refreshes the hint
+	readhint.SetLastKnown(path, 1024)
+	_, ok = readhint.GetLastKnown(path, time.Minute)
+	c.Assert(ok, Equals, true)
+
+	// Smaller offsets are ignored, larger ones set concurrently keep the largest
+	readhint.SetLastKnown(path, 512)
+	var wg sync.WaitGroup
+	for i := 1; i <= 8; i++ {
+		wg.Add(1)
+		go func(i int64) {
+			defer wg.Done()
+			readhint.SetLastKnown(path, 1024+i*64)
+		}(int64(i))
+	}
+	wg.Wait()
+	offset, _ = readhint.GetLastKnown(path, 0)
+	c.Assert(offset, Equals, int64(1024+8*64))
+	c.Assert(readhint.Snapshot(), HasLen, 0)
+
+	readhint.MoveLastKnown(filepath.Join(s.Rootdir, "LOCKFREE"), filepath.Join(s.Rootdir, "MOVED"))
+	_, ok = readhint.GetLastKnown(path, 0)
+	c.Assert(ok, Equals, false)
+}
+
+func (s *TestSuite) TestWarmReadHints(c *C) {
+	utils.InstanceConfig.EnableLastKnown = true
+	defer func() { utils.InstanceConfig.EnableLastKnown = false }()
+
+	tbk := *NewTimeBucketKey("WARM/1Min/OHLCV")
+	last := time.Date(2017, time.March, 1, 10, 0, 0, 0, time.UTC).Unix()
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{time.Date(2016, time.June, 1, 0, 0, 0, 0, time.UTC).Unix(), last - 60, last})
+	cs.AddColumn("Open", []float32{1, 2, 3})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(tbk, cs)
+	c.Assert(WriteCSM(csm, false), IsNil)
+
+	warmReadHints(ThisInstance.CatalogDir, 1)
+
+	tbi, err := getTimeBucketInfoForYear(&tbk, 2017)
+	c.Assert(err, IsNil)
+	offset, ok := readhint.GetLastKnown(tbi.Path, 0)
+	c.Assert(ok, Equals, true)
+	c.Assert(offset, Equals, EpochToOffset(last, tbi.GetTimeframe(), tbi.GetRecordLength()))
+
+	// Only the latest year of each bucket is looked at
+	tbi, err = getTimeBucketInfoForYear(&tbk, 2016)
+	c.Assert(err, IsNil)
+	_, ok = readhint.GetLastKnown(tbi.Path, 0)
+	c.Assert(ok, Equals, false)
+
+	// The payload of VARIABLE records is not mistaken for the index
+	tick := *NewTimeBucketKey("WARM/1Min/TICK")
+	shapes := []DataShape{{Name: "Epoch", Type: INT64}, {Name: "Bid", Type: FLOAT32}}
+	c.Assert(CreateBucket(tick, shapes, *utils.TimeframeFromString("1Min"), VARIABLE), IsNil)
+	cs = NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{last, last + 1, last + 2})
+	cs.AddColumn("Bid", []float32{1, 2, 3})
+	csm = NewColumnSeriesMap()
+	csm.AddColumnSeries(tick, cs)
+	c.Assert(WriteCSM(csm, true), IsNil)
+	ThisInstance.WALFile.RequestFlush()
+	tbi, err = getTimeBucketInfoForYear(&tick, 2017)
+	c.Assert(err, IsNil)
+	offset, err = lastRecordOffset(tbi)
+	c.Assert(err, IsNil)
+	c.Assert(offset, Equals, EpochToOffset(last, tbi.GetTimeframe(), tbi.GetRecordLength()))
+}
+
+func (s *TestSuite) TestReadHintCheckpoint(c *C) {
+	utils.InstanceConfig.EnableLastKnown = true
+	defer func() { utils.InstanceConfig.EnableLastKnown = false }()
+
+	tbk := *NewTimeBucketKey("HINTCKPT/1Min/OHLCV")
+	last := time.Date(2017, time.March, 1, 10, 0, 0, 0, time.UTC).Unix()
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{last})
+	cs.AddColumn("Open", []float32{1})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(tbk, cs)
+	c.Assert(WriteCSM(csm, false), IsNil)
+	tbi, err := getTimeBucketInfoForYear(&tbk, 2017)
+	c.Assert(err, IsNil)
+	offset := EpochToOffset(last, tbi.GetTimeframe(), tbi.GetRecordLength())
+	readhint.SetLastKnown(tbi.Path, offset)
+
+	c.Assert(checkpointReadHints(s.Rootdir), IsNil)
+	path := filepath.Join(s.Rootdir, ReadHintCheckpointName)
+	saved, err := readhint.Load(path)
+	c.Assert(err, IsNil)
+	rel, err := filepath.Rel(s.Rootdir, tbi.Path)
+	c.Assert(err, IsNil)
+	c.Assert(saved[rel].Offset, Equals, offset)
+
+	// The checkpoint is not taken for a year file when the catalog is reopened
+	reopened := NewDirectory(s.Rootdir)
+	c.Assert(len(reopened.GatherTimeBucketInfo()), Equals, len(ThisInstance.CatalogDir.GatherTimeBucketInfo()))
+	c.Assert(reopened.DirHasDataFiles(), Equals, false)
+
+	// Only the hints at a record of a file unchanged since are loaded
+	now := time.Now()
+	hints := map[string]readhint.Hint{
+		rel:                                  {Offset: offset, SetAt: now},
+		filepath.Join("..", "x", "2017.bin"): {Offset: offset, SetAt: now},
+	}
+	c.Assert(readhint.Persist(path, hints), IsNil)
+	loaded, err := loadReadHints(s.Rootdir)
+	c.Assert(err, IsNil)
+	c.Assert(loaded, Equals, 1)
+	for _, hint := range []readhint.Hint{{Offset: offset + 1, SetAt: now}, {Offset: offset, SetAt: now.Add(-time.Hour)}} {
+		c.Assert(readhint.Persist(path, map[string]readhint.Hint{rel: hint}), IsNil)
+		loaded, err = loadReadHints(s.Rootdir)
+		c.Assert(err, IsNil)
+		c.Assert(loaded, Equals, 0)
+	}
+
+	// A file cut short is not loaded
+	data, err := ioutil.ReadFile(path)
+	c.Assert(err, IsNil)
+	c.Assert(ioutil.WriteFile(path, data[:len(data)-4], 0600), IsNil)
+	_, err = loadReadHints(s.Rootdir)
+	c.Assert(err, Equals, readhint.ErrInvalidHintFile)
+	c.Assert(os.Remove(path), IsNil)
+}
+
+func (s *TestSuite) TestWriteAtomicMulti(c *C) {
+	t0 := time.Date(2016, time.December, 31, 23, 59, 0, 0, time.UTC)
+	newRows := func(key *TimeBucketKey, cols ...string) *RowSeries {
+		cs := NewColumnSeries()
+		cs.AddColumn("Epoch", []int64{t0.Unix(), t0.Add(time.Minute).Unix()})
+		for _, col := range cols {
+			cs.AddColumn(col, []float32{1, 2})
+		}
+		return cs.ToRowSeries(*key)
+	}
+	read := func(key *TimeBucketKey) *ColumnSeries {
+		q := NewQuery(ThisInstance.CatalogDir)
+		q.AddTargetKey(key)
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		reader, err := NewReader(parsed)
+		c.Assert(err, IsNil)
+		csm, _, err := reader.Read()
+		c.Assert(err, IsNil)
+		return csm[*key]
+	}
+	legA := NewTimeBucketKey("LEGA/1Min/OHLCV")
+	legB := NewTimeBucketKey("LEGB/1Min/OHLCV")
+
+	c.Assert(WriteAtomicMulti(map[TimeBucketKey]*RowSeries{
+		*legA: newRows(legA, "Open", "Close"),
+		*legB: newRows(legB, "Open", "Close"),
+	}), IsNil)
+	for _, key := range []*TimeBucketKey{legA, legB} {
+		cs := read(key)
+		c.Assert(cs.GetEpoch(), DeepEquals, []int64{t0.Unix(), t0.Add(time.Minute).Unix()})
+		c.Assert(cs.GetByName("Close").([]float32), DeepEquals, []float32{1, 2})
+		// No temporary or replaced files are left behind
+		names, err := filepath.Glob(filepath.Join(key.GetPathToYearFiles(s.Rootdir), ".atomic*"))
+		c.Assert(err, IsNil)
+		c.Assert(names, HasLen, 0)
+	}
+
+	// A batch that can not be written leaves the others unwritten too
+	t0 = t0.Add(time.Hour)
+	c.Assert(WriteAtomicMulti(map[TimeBucketKey]*RowSeries{
+		*legA: newRows(legA, "Open", "Close"),
+		*legB: newRows(legB, "Open"),
+	}), NotNil)
+	c.Assert(read(legA).GetEpoch(), HasLen, 2)
+}
+
+func (s *TestSuite) TestBytesSkippedByHint(c *C) {
+	utils.InstanceConfig.EnableLastKnown = true
+	defer func() { utils.InstanceConfig.EnableLastKnown = false }()
+
+	tbk := *NewTimeBucketKey("SKIP/1Min/OHLCV")
+	last := time.Date(2016, time.March, 1, 10, 0, 0, 0, time.UTC).Unix()
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{last - 60, last})
+	cs.AddColumn("Open", []float32{1, 2})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(tbk, cs)
+	c.Assert(WriteCSM(csm, false), IsNil)
+
+	tbi, err := getTimeBucketInfoForYear(&tbk, 2016)
+	c.Assert(err, IsNil)
+	lastOffset := EpochToOffset(last, tbi.GetTimeframe(), tbi.GetRecordLength())
+	readhint.SetLastKnown(tbi.Path, lastOffset)
+
+	for _, direction := range []DirectionEnum{FIRST, LAST} {
+		q := NewQuery(ThisInstance.CatalogDir)
+		q.AddTargetKey(&tbk)
+		q.SetRange(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC).Unix(),
+			time.Date(2016, time.December, 31, 23, 59, 0, 0, time.UTC).Unix())
+		q.SetRowLimit(direction, 10)
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		reader, err := NewReader(parsed)
+		c.Assert(err, IsNil)
+		csm, _, err := reader.Read()
+		c.Assert(err, IsNil)
+		epochs := csm[tbk].GetEpoch()
+		c.Assert(epochs[len(epochs)-1], Equals, last)
+		fileEnd := FileSize(tbi.GetTimeframe(), 2016, int(tbi.GetRecordLength()))
+		c.Assert(reader.Stats().BytesSkippedByHint, Equals, fileEnd-lastOffset-int64(tbi.GetRecordLength()))
+	}
+}
+
+func (s *TestSuite) TestRequireNonEmpty(c *C) {
+	tbk := *NewTimeBucketKey("NONEMPTY/1Min/OHLCV")
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{time.Date(2016, time.June, 1, 0, 0, 0, 0, time.UTC).Unix()})
+	cs.AddColumn("Open", []float32{1})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(tbk, cs)
+	c.Assert(WriteCSM(csm, false), IsNil)
+
+	read := func(requireNonEmpty bool) (ColumnSeriesMap, error) {
+		q := NewQuery(ThisInstance.CatalogDir)
+		q.AddTargetKey(&tbk)
+		q.SetRange(time.Date(2016, time.July, 1, 0, 0, 0, 0, time.UTC).Unix(),
+			time.Date(2016, time.July, 2, 0, 0, 0, 0, time.UTC).Unix())
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		parsed.RequireNonEmpty = requireNonEmpty
+		reader, err := NewReader(parsed)
+		c.Assert(err, IsNil)
+		csm, _, err := reader.Read()
+		return csm, err
+	}
+
+	csm, err := read(false)
+	c.Assert(err, IsNil)
+	c.Assert(csm[tbk].Len(), Equals, 0)
+
+	_, err = read(true)
+	emptyErr, ok := err.(ErrEmptyResultSet)
+	c.Assert(ok, Equals, true)
+	c.Assert(emptyErr.Key.String(), Equals, tbk.String())
+	c.Assert(emptyErr.Error(), Equals,
+		"no rows found for NONEMPTY/1Min/OHLCV:Symbol/Timeframe/AttributeGroup between 2016-07-01 00:00:00 +0000 UTC and 2016-07-02 00:00:00 +0000 UTC")
+}
+
+func (s *TestSuite) TestSortedFileListBinary(c *C) {
+	q := NewQuery(s.DataDirectory)
+	q.AddRestriction("Symbol", "EURUSD")
+	q.AddRestriction("AttributeGroup", "OHLC")
+	q.AddRestriction("Timeframe", "1Min")
+	q.SetRange(time.Date(2001, time.June, 1, 0, 0, 0, 0, time.UTC).Unix(),
+		time.Date(2001, time.June, 1, 1, 0, 0, 0, time.UTC).Unix())
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+	fl := SortedFileList(parsed.QualifiedFiles)
+	sort.Sort(fl)
+
+	data, err := fl.MarshalBinary()
+	c.Assert(err, IsNil)
+	var decoded SortedFileList
+	c.Assert(decoded.UnmarshalBinary(data), IsNil)
+	c.Assert(decoded, HasLen, len(fl))
+	for i, qf := range decoded {
+		c.Assert(qf.Key.String(), Equals, fl[i].Key.String())
+		c.Assert(qf.File.Path, Equals, fl[i].File.Path)
+		c.Assert(qf.File.Year, Equals, fl[i].File.Year)
+	}
+
+	// A plan built from the decoded list reads the headers from the files
+	iop, err := NewIOPlan(decoded, parsed, false)
+	c.Assert(err, IsNil)
+	c.Assert(iop.RecordLen, Equals, fl[0].File.GetRecordLength())
+
+	for _, corrupt := range [][]byte{nil, data[:len(data)-1], append([]byte{0}, data[1:]...)} {
+		c.Assert(decoded.UnmarshalBinary(corrupt), NotNil)
+	}
+}
+
+type countingPlanStore struct {
+	*memPlanStore
+	hits, deletes int
+}
+
+func (s *countingPlanStore) Get(name string) ([]byte, bool) {
+	data, ok := s.memPlanStore.Get(name)
+	if ok {
+		s.hits++
+	}
+	return data, ok
+}
+
+func (s *countingPlanStore) Delete(name string) {
+	s.deletes++
+	s.memPlanStore.Delete(name)
+}
+
+func (s *TestSuite) TestPlanCache(c *C) {
+	store := &countingPlanStore{memPlanStore: newMemPlanStore()}
+	SetPlanStore(store)
+	defer SetPlanStore(newMemPlanStore())
+
+	tbk := NewTimeBucketKey("PLANS/1Min/OHLC")
+	epoch := func(min int) int64 {
+		return time.Date(2003, time.March, 1, 0, min, 0, 0, time.UTC).Unix()
+	}
+	write := func(min int) {
+		cs := NewColumnSeries()
+		cs.AddColumn("Epoch", []int64{epoch(min)})
+		cs.AddColumn("Open", []float32{float32(min)})
+		csm := NewColumnSeriesMap()
+		csm.AddColumnSeries(*tbk, cs)
+		c.Assert(WriteCSM(csm, false), IsNil)
+	}
+	planned := func(start, end int64) int {
+		q := NewQuery(ThisInstance.CatalogDir)
+		q.AddTargetKey(tbk)
+		q.SetRange(start, end)
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		iop, err := NewIOPlan(parsed.QualifiedFiles, parsed, false)
+		c.Assert(err, IsNil)
+		return len(iop.FilePlan)
+	}
+	write(10)
+	fileStatsRebuilds.Wait()
+
+	// The second plan of a range is taken from the cache, without the stats
+	c.Assert(planned(epoch(20), epoch(30)), Equals, 0)
+	c.Assert(store.hits, Equals, 0)
+	path := filepath.Join(tbk.GetPathToYearFiles(ThisInstance.RootDir), "2003.bin")
+	statsData, err := ioutil.ReadFile(statsPath(path))
+	c.Assert(err, IsNil)
+	c.Assert(os.Remove(statsPath(path)), IsNil)
+	c.Assert(planned(epoch(20), epoch(30)), Equals, 0)
+	c.Assert(store.hits, Equals, 1)
+	c.Assert(ioutil.WriteFile(statsPath(path), statsData, 0644), IsNil)
+
+	// Other ranges are planned on their own
+	c.Assert(planned(epoch(0), epoch(30)), Equals, 1)
+	c.Assert(store.hits, Equals, 1)
+
+	// A write drops the plans of the key
+	write(25)
+	c.Assert(store.deletes, Equals, 2)
+	c.Assert(planned(epoch(20), epoch(30)), Equals, 1)
+	c.Assert(store.hits, Equals, 1)
+
+	// Plans made while the stats are missing are not cached
+	fileStatsRebuilds.Wait()
+	c.Assert(os.Remove(statsPath(path)), IsNil)
+	c.Assert(planned(epoch(40), epoch(50)), Equals, 1)
+	c.Assert(rebuildFileStats(path), IsNil)
+	c.Assert(planned(epoch(40), epoch(50)), Equals, 0)
+	c.Assert(store.hits, Equals, 1)
+}
+
+func (s *TestSuite) TestEstimateQueryTime(c *C) {
+	scanRateMu.Lock()
+	saved := scanRates
+	scanRates = map[uint64]float64{}
+	scanRateMu.Unlock()
+	defer func() {
+		scanRateMu.Lock()
+		scanRates = saved
+		scanRateMu.Unlock()
+	}()
+
+	key := *NewTimeBucketKey("EURUSD/1Min/OHLC")
+	q := NewQuery(s.DataDirectory)
+	q.AddTargetKey(&key)
+	q.SetRange(time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC).Unix(),
+		time.Date(2001, time.February, 1, 0, 0, 0, 0, time.UTC).Unix())
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+
+	_, err = EstimateQueryTime(key, parsed)
+	c.Assert(err, Equals, ErrNoScanHistory)
+	_, err = EstimateQueryTime(*NewTimeBucketKey("USDJPY/1Min/OHLC"), parsed)
+	c.Assert(err, Equals, ErrNoDataFound)
+
+	reader, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+	_, _, err = reader.Read()
+	c.Assert(err, IsNil)
+	estimate, err := EstimateQueryTime(key, parsed)
+	c.Assert(err, IsNil)
+	c.Assert(estimate > 0, Equals, true)
+
+	// The estimate is the planned bytes over the rate of the device
+	scanRateMu.Lock()
+	c.Assert(scanRates, HasLen, 1)
+	for dev := range scanRates {
+		scanRates[dev] = 1 << 20
+	}
+	scanRateMu.Unlock()
+	estimate, err = EstimateQueryTime(key, parsed)
+	c.Assert(err, IsNil)
+	bytes := reader.Explain()[key].Bytes
+	c.Assert(estimate, Equals, time.Duration(float64(bytes)/(1<<20)*float64(time.Second)))
+	// The reader estimates from its own plans
+	planned, err := reader.EstimateQueryTime()
+	c.Assert(err, IsNil)
+	c.Assert(planned, Equals, estimate)
+
+	// The parsed query is left as it is
+	parsed.Range = nil
+	_, err = EstimateQueryTime(key, parsed)
+	c.Assert(err, IsNil)
+	c.Assert(parsed.Range, IsNil)
+}
+
+func (s *TestSuite) TestIOPlanText(c *C) {
+	key := *NewTimeBucketKey("EURUSD/1Min/OHLC")
+	q := NewQuery(s.DataDirectory)
+	q.AddTargetKey(&key)
+	q.SetRange(time.Date(2001, time.December, 1, 0, 0, 0, 0, time.UTC).Unix(),
+		time.Date(2002, time.February, 1, 0, 0, 0, 0, time.UTC).Unix())
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+	reader, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+	iop := reader.IOPMap[key]
+	c.Assert(iop.FilePlan, HasLen, 2)
+
+	text, err := iop.MarshalText()
+	c.Assert(err, IsNil)
+	lines := strings.Split(strings.TrimSuffix(string(text), "\n"), "\n")
+	c.Assert(lines, HasLen, 2)
+	fp := iop.FilePlan[0]
+	c.Assert(lines[0], Equals, fmt.Sprintf("%s\t%d\t%d\t%d\t%d",
+		fp.FullPath, fp.Offset, fp.Length, fp.BaseTime, iop.RecordLen))
+
+	decoded := new(ioplan)
+	c.Assert(decoded.UnmarshalText(text), IsNil)
+	c.Assert(decoded.RecordLen, Equals, iop.RecordLen)
+	c.Assert(decoded.FilePlan, HasLen, 2)
+	for i, fp := range decoded.FilePlan {
+		c.Assert(fp.FullPath, Equals, iop.FilePlan[i].FullPath)
+		c.Assert(fp.Offset, Equals, iop.FilePlan[i].Offset)
+		c.Assert(fp.Length, Equals, iop.FilePlan[i].Length)
+		c.Assert(fp.BaseTime, Equals, iop.FilePlan[i].BaseTime)
+		c.Assert(fp.GetFileYear(), Equals, iop.FilePlan[i].GetFileYear())
+	}
+	c.Assert(decoded.PlanSummary(), Equals, iop.PlanSummary())
+	again, err := decoded.MarshalText()
+	c.Assert(err, IsNil)
+	c.Assert(string(again), Equals, string(text))
+
+	c.Assert(decoded.UnmarshalText([]byte("path\t1\t2\t3\n")), NotNil)
+	c.Assert(decoded.UnmarshalText([]byte("path\t1\tx\t3\t4\n")), NotNil)
+	c.Assert(decoded.UnmarshalText([]byte("path\t1\t2\t3\t0\n")), NotNil)
+}
+
+func (s *TestSuite) TestReIndex(c *C) {
+	key := *NewTimeBucketKey("NZDUSD/1H/OHLC")
+	tbi, err := getTimeBucketInfoForYear(&key, 2001)
+	c.Assert(err, IsNil)
+	original, err := ioutil.ReadFile(tbi.Path)
+	c.Assert(err, IsNil)
+
+	n, err := ReIndex(key, 2001)
+	c.Assert(err, IsNil)
+	c.Assert(n, Equals, int64(0))
+
+	defer ioutil.WriteFile(tbi.Path, original, 0666)
+
+	// Corrupt the index of the first three records, and null out the fourth
+	// record, as a gap in the data, but with a junk index
+	recordLen := int64(tbi.GetRecordLength())
+	expected := append([]byte(nil), original...)
+	nullOffset := Headersize + 3*recordLen
+	copy(expected[nullOffset:nullOffset+recordLen], make([]byte, recordLen))
+	corrupted := append([]byte(nil), expected...)
+	for i, index := range []uint64{0xdeadbeef, 0xdeadbeef, 0, 0xdeadbeef} {
+		binary.LittleEndian.PutUint64(corrupted[Headersize+int64(i)*recordLen:], index)
+	}
+	c.Assert(ioutil.WriteFile(tbi.Path, corrupted, 0666), IsNil)
+
+	n, err = ReIndex(key, 2001)
+	c.Assert(err, IsNil)
+	c.Assert(n, Equals, int64(4))
+	repaired, err := ioutil.ReadFile(tbi.Path)
+	c.Assert(err, IsNil)
+	c.Assert(bytes.Equal(repaired, expected), Equals, true)
+
+	_, err = ReIndex(key, 1990)
+	c.Assert(err, NotNil)
+
+	// The payload of VARIABLE records follows the index area and is left alone
+	tick := *NewTimeBucketKey("REINDEX/1Min/TICK")
+	shapes := []DataShape{{Name: "Epoch", Type: INT64}, {Name: "Bid", Type: FLOAT32}}
+	c.Assert(CreateBucket(tick, shapes, *utils.TimeframeFromString("1Min"), VARIABLE), IsNil)
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{time.Date(2001, time.May, 2, 10, 0, 0, 0, time.UTC).Unix()})
+	cs.AddColumn("Bid", []float32{1})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(tick, cs)
+	c.Assert(WriteCSM(csm, true), IsNil)
+	ThisInstance.WALFile.RequestFlush()
+	tickInfo, err := getTimeBucketInfoForYear(&tick, 2001)
+	c.Assert(err, IsNil)
+	before, err := ioutil.ReadFile(tickInfo.Path)
+	c.Assert(err, IsNil)
+	_, err = ReIndex(tick, 2001)
+	c.Assert(err, NotNil)
+	after, err := ioutil.ReadFile(tickInfo.Path)
+	c.Assert(err, IsNil)
+	c.Assert(bytes.Equal(before, after), Equals, true)
+}
+
+func (s *TestSuite) TestAllowPartialResults(c *C) {
+	good := *NewTimeBucketKey("EURUSD/1Min/OHLC")
+	bad := *NewTimeBucketKey("USDJPY/1Min/OHLC")
+	notYearFile := filepath.Join(c.MkDir(), "2001.bin")
+	c.Assert(ioutil.WriteFile(notYearFile, make([]byte, Headersize+1024), 0666), IsNil)
+
+	newReader := func(allowPartial bool) *reader {
+		q := NewQuery(s.DataDirectory)
+		q.AddTargetKey(&good)
+		q.AddTargetKey(&bad)
+		q.SetRange(time.Date(2001, time.June, 1, 0, 0, 0, 0, time.UTC).Unix(),
+			time.Date(2001, time.June, 1, 1, 0, 0, 0, time.UTC).Unix())
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		parsed.AllowPartialResults = allowPartial
+		reader, err := NewReader(parsed)
+		c.Assert(err, IsNil)
+		for _, fp := range reader.IOPMap[bad].FilePlan {
+			fp.FullPath = notYearFile
+		}
+		return reader
+	}
+
+	csm, _, err := newReader(false).Read()
+	c.Assert(err, Equals, ErrInvalidFileFormat)
+	c.Assert(csm, IsNil)
+
+	csm, tPrevMap, err := newReader(true).Read()
+	partial, ok := err.(*PartialReadError)
+	c.Assert(ok, Equals, true)
+	c.Assert(partial.Failed, DeepEquals, map[TimeBucketKey]error{bad: ErrInvalidFileFormat})
+	c.Assert(csm, HasLen, 1)
+	c.Assert(csm[good].Len() > 0, Equals, true)
+	_, ok = tPrevMap[bad]
+	c.Assert(ok, Equals, false)
+}
+
+func (s *TestSuite) TestNewIOPlanRecordTypeMismatch(c *C) {
+	tbk := NewTimeBucketKey("MIXED/1Min/TICK")
+	tf := utils.NewTimeframe("1Min")
+	// Both records are 24 bytes long, as is the pointer of variable records
+	dsv := []DataShape{{Name: "Bid", Type: FLOAT64}, {Name: "Ask", Type: FLOAT64}}
+	dir := tbk.GetPathToYearFiles(s.Rootdir)
+	fl := SortedFileList{
+		{Key: *tbk, File: NewTimeBucketInfo(*tf, dir, "Test", 2016, dsv, FIXED)},
+		{Key: *tbk, File: NewTimeBucketInfo(*tf, dir, "Test", 2017, dsv, VARIABLE)},
+	}
+	c.Assert(fl[0].File.GetRecordLength(), Equals, fl[1].File.GetRecordLength())
+	pr := &ParseResult{Range: NewDateRange(), Limit: NewRowLimit()}
+	_, err := NewIOPlan(fl, pr, false)
+	c.Assert(err, Equals, ErrRecordTypeMismatch)
+
+	fl[1].File = NewTimeBucketInfo(*tf, dir, "Test", 2017, dsv, FIXED)
+	_, err = NewIOPlan(fl, pr, false)
+	c.Assert(err, IsNil)
+
+	_, err = NewIOPlan(nil, pr, false)
+	c.Assert(err, Equals, ErrNoDataFound)
+}
+
+func (s *TestSuite) TestWrongReplica(c *C) {
+	defer func(id string) { utils.InstanceConfig.ReplicaID = id }(utils.InstanceConfig.ReplicaID)
+	utils.InstanceConfig.ReplicaID = "replica-1"
+
+	q := NewQuery(ThisInstance.CatalogDir)
+	q.AddTargetKey(NewTimeBucketKey("EURUSD/1Min/OHLC"))
+	q.SetRange(time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC).Unix(),
+		time.Date(2001, time.January, 2, 0, 0, 0, 0, time.UTC).Unix())
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+
+	parsed.ReplicaID = "replica-2"
+	_, err = NewReader(parsed)
+	c.Assert(err, Equals, ErrWrongReplica)
+	_, err = NewBatchReader([]*ParseResult{parsed})
+	c.Assert(err, Equals, ErrWrongReplica)
+
+	for _, id := range []string{"replica-1", ""} {
+		parsed.ReplicaID = id
+		_, err = NewReader(parsed)
+		c.Assert(err, IsNil)
+	}
+}
+
+func (s *TestSuite) TestSampleRows(c *C) {
+	key := *NewTimeBucketKey("NZDUSD/1H/OHLC")
+	all, err := sampleRead(key, func(int64) bool { return true })
+	c.Assert(err, IsNil)
+	epochs := map[int64]bool{}
+	for _, epoch := range all.GetEpoch() {
+		epochs[epoch] = true
+	}
+
+	sample, err := SampleRows(key, 10, 1)
+	c.Assert(err, IsNil)
+	c.Assert(sample.Len(), Equals, 10)
+	sampled := sample.GetEpoch()
+	for i, epoch := range sampled {
+		c.Assert(epochs[epoch], Equals, true)
+		if i > 0 {
+			c.Assert(epoch > sampled[i-1], Equals, true)
+		}
+	}
+	again, err := SampleRows(key, 10, 1)
+	c.Assert(err, IsNil)
+	c.Assert(again.GetEpoch(), DeepEquals, sampled)
+	other, err := SampleRows(key, 10, 2)
+	c.Assert(err, IsNil)
+	c.Assert(other.GetEpoch(), Not(DeepEquals), sampled)
+
+	// Asking for more rows than there are returns all of them
+	everything, err := SampleRows(key, all.Len()+1, 1)
+	c.Assert(err, IsNil)
+	c.Assert(everything.GetEpoch(), DeepEquals, all.GetEpoch())
+
+	_, err = SampleRows(key, 0, 1)
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestWriteZeroEpoch(c *C) {
+	tbk := *NewTimeBucketKey("ZEROEPOCH/1Min/OHLC")
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{time.Date(2001, time.June, 1, 0, 0, 0, 0, time.UTC).Unix(), 0})
+	cs.AddColumn("Open", []float32{1, 2})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(tbk, cs)
+
+	c.Assert(WriteCSM(csm, false), Equals, ErrZeroEpoch)
+	err := WriteBatch(map[TimeBucketKey]*RowSeries{tbk: cs.ToRowSeries(tbk)})
+	c.Assert(err, NotNil)
+	c.Assert(err.(*PartialWriteError).Failed[tbk], Equals, ErrZeroEpoch)
+
+	// The bucket is not created
+	_, err = os.Stat(filepath.Join(s.Rootdir, "ZEROEPOCH"))
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
+
+func (s *TestSuite) TestCreateBucket(c *C) {
+	tbk := *NewTimeBucketKey("PREDECLARED/1Min/OHLCV")
+	shapes := []DataShape{
+		{Name: "Epoch", Type: INT64},
+		{Name: "Close", Type: FLOAT32},
+		{Name: "Volume", Type: INT64},
+	}
+	c.Assert(CreateBucket(tbk, shapes, *utils.TimeframeFromString("1Min"), FIXED), IsNil)
+	c.Assert(CreateBucket(tbk, shapes, *utils.TimeframeFromString("1Min"), FIXED), Equals, ErrBucketAlreadyExists)
+
+	tbi, err := ThisInstance.CatalogDir.GetLatestTimeBucketInfoFromKey(&tbk)
+	c.Assert(err, IsNil)
+	c.Assert(tbi.GetDataShapesWithEpoch(), DeepEquals, shapes)
+	c.Assert(tbi.GetRecordType(), Equals, FIXED)
+	c.Assert(tbi.GetTimeframe(), Equals, time.Minute)
+
+	// Writes land in the declared bucket
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{time.Date(int(tbi.Year), time.March, 1, 0, 0, 0, 0, time.UTC).Unix()})
+	cs.AddColumn("Close", []float32{1})
+	cs.AddColumn("Volume", []int64{100})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(tbk, cs)
+	c.Assert(WriteCSM(csm, false), IsNil)
+}
+
+func (s *TestSuite) TestRecordIndex(c *C) {
+	buffer := make([]byte, 3*16)
+	for i, index := range []uint64{1, 0, 1<<40 + 7} {
+		binary.LittleEndian.PutUint64(buffer[i*16:], index)
+		c.Assert(recordIndex(buffer, int64(i*16)), Equals, int64(index))
+	}
+}
+
+var benchIndexSum int64
+
+func benchmarkRecordBuffer() []byte {
+	buffer := make([]byte, 4096*24)
+	for i := 0; i < 4096; i++ {
+		binary.LittleEndian.PutUint64(buffer[i*24:], uint64(i))
+	}
+	return buffer
+}
+
+func BenchmarkRecordIndex(b *testing.B) {
+	buffer := benchmarkRecordBuffer()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for curpos := int64(0); curpos < int64(len(buffer)); curpos += 24 {
+			benchIndexSum += recordIndex(buffer, curpos)
+		}
+	}
+}
+
+func BenchmarkRecordIndexBinary(b *testing.B) {
+	buffer := benchmarkRecordBuffer()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for curpos := int64(0); curpos < int64(len(buffer)); curpos += 24 {
+			benchIndexSum += int64(binary.LittleEndian.Uint64(buffer[curpos:]))
+		}
+	}
+}
+
+// benchmarkYearFileWrites writes batches of records to a file the way the
+// WAL flush does, with the file opened with O_SYNC if dsync is true
+func benchmarkYearFileWrites(b *testing.B, dsync bool) {
+	const records, recordLen = 100, 32
+	dir, err := ioutil.TempDir("", "dsync")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "2000.bin")
+	if err = ioutil.WriteFile(path, make([]byte, records*recordLen), 0600); err != nil {
+		b.Fatal(err)
+	}
+	writes := make([]offsetIndexBuffer, records)
+	for i := range writes {
+		buffer := make([]byte, 8+recordLen)
+		binary.LittleEndian.PutUint64(buffer, uint64(i*recordLen))
+		binary.LittleEndian.PutUint64(buffer[8:], uint64(i+1))
+		writes[i] = buffer
+	}
+	defer func(dsync bool) { utils.InstanceConfig.DSyncWrites = dsync }(utils.InstanceConfig.DSyncWrites)
+	utils.InstanceConfig.DSyncWrites = dsync
+	b.SetBytes(records * recordLen)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		fp, err := os.OpenFile(path, yearFileWriteFlag(), 0700)
+		if err != nil {
+			b.Fatal(err)
+		}
+		for _, buffer := range writes {
+			if err = WriteBufferToFile(fp, buffer); err != nil {
+				b.Fatal(err)
+			}
+		}
+		if err = fdatasync(fp); err != nil {
+			b.Fatal(err)
+		}
+		fp.Close()
+	}
+}
+
+func BenchmarkYearFileWrites(b *testing.B) {
+	benchmarkYearFileWrites(b, false)
+}
+
+func BenchmarkYearFileWritesDSync(b *testing.B) {
+	benchmarkYearFileWrites(b, true)
+}
+
+// benchmarkReadForward scans a whole year of a bucket forward, from a
+// mapping of the file if useMmap is true
+func benchmarkReadForward(b *testing.B, useMmap bool) {
+	dir, err := ioutil.TempDir("", "mmap")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	MakeDummyCurrencyDir(dir, true, false)
+	key := *NewTimeBucketKey("EURUSD/1Min/OHLC")
+	q := NewQuery(NewDirectory(dir))
+	q.AddTargetKey(&key)
+	q.SetRange(
+		time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC).Unix(),
+		time.Date(2001, time.December, 31, 0, 0, 0, 0, time.UTC).Unix())
+	parsed, err := q.Parse()
+	if err != nil {
+		b.Fatal(err)
+	}
+	reader, err := NewReader(parsed)
+	if err != nil {
+		b.Fatal(err)
+	}
+	iop := reader.IOPMap[key]
+	fp := iop.FilePlan[0]
+	readBuffer := make([]byte, RecordsPerRead*iop.RecordLen)
+	defer func(useMmap bool) { utils.InstanceConfig.UseMmap = useMmap }(utils.InstanceConfig.UseMmap)
+	utils.InstanceConfig.UseMmap = useMmap
+	b.SetBytes(fp.Length)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, _, err = newIoExec(iop).readForward(nil, fp, iop.RecordLen, math.MaxInt32, readBuffer); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkReadForward(b *testing.B) {
+	benchmarkReadForward(b, false)
+}
+
+func BenchmarkReadForwardMmap(b *testing.B) {
+	benchmarkReadForward(b, true)
+}
+
+func (s *TestSuite) TestReadForwardMmap(c *C) {
+	read := func(useMmap bool) ColumnSeriesMap {
+		defer func() { utils.InstanceConfig.UseMmap = false }()
+		utils.InstanceConfig.UseMmap = useMmap
+		q := NewQuery(s.DataDirectory)
+		for _, symbol := range []string{"EURUSD", "USDJPY", "NZDUSD"} {
+			q.AddTargetKey(NewTimeBucketKey(symbol + "/1Min/OHLC"))
+		}
+		q.SetRange(
+			time.Date(2001, time.December, 1, 0, 0, 0, 0, time.UTC).Unix(),
+			time.Date(2002, time.February, 1, 0, 0, 0, 0, time.UTC).Unix())
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		reader, err := NewReader(parsed)
+		c.Assert(err, IsNil)
+		csm, _, err := reader.Read()
+		c.Assert(err, IsNil)
+		return csm
+	}
+	expected, mapped := read(false), read(true)
+	c.Assert(len(mapped), Equals, len(expected))
+	for key, cs := range expected {
+		c.Assert(cs.Len() > 0, Equals, true)
+		c.Assert(mapped[key].GetEpoch(), DeepEquals, cs.GetEpoch())
+		c.Assert(mapped[key].GetByName("Close"), DeepEquals, cs.GetByName("Close"))
+	}
+}
+
+func (s *TestSuite) TestReverseBufferMeta(c *C) {
+	for _, n := range []int{3, 4, 5} {
+		bufMeta := make([]bufferMeta, n)
+		for i := range bufMeta {
+			bufMeta[i].FullPath = strconv.Itoa(i)
+		}
+		reverseBufferMeta(bufMeta)
+		for i, md := range bufMeta {
+			c.Assert(md.FullPath, Equals, strconv.Itoa(n-1-i))
+		}
+	}
+}
+
+func (s *TestSuite) TestPauseResume(c *C) {
+	saved := utils.InstanceConfig.PausedWriteTimeout
+	defer func() { utils.InstanceConfig.PausedWriteTimeout = saved }()
+
+	tbk := *NewTimeBucketKey("PAUSED/1Min/OHLC")
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{time.Date(2001, time.June, 1, 0, 0, 0, 0, time.UTC).Unix()})
+	cs.AddColumn("Open", []float32{1})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(tbk, cs)
+
+	c.Assert(Resume(), Equals, ErrNotPaused)
+	c.Assert(Pause(), IsNil)
+	c.Assert(Pause(), Equals, ErrAlreadyPaused)
+
+	utils.InstanceConfig.PausedWriteTimeout = 10 * time.Millisecond
+	c.Assert(WriteCSM(csm, false), Equals, ErrWritesPaused)
+
+	// A blocked write proceeds once resumed
+	utils.InstanceConfig.PausedWriteTimeout = time.Minute
+	done := make(chan error)
+	go func() { done <- WriteCSM(csm, false) }()
+	select {
+	case <-done:
+		c.Fatal("write was not paused")
+	case <-time.After(50 * time.Millisecond):
+	}
+	c.Assert(Resume(), IsNil)
+	c.Assert(<-done, IsNil)
+
+	// Reads are not paused
+	c.Assert(Pause(), IsNil)
+	defer Resume()
+	q := NewQuery(s.DataDirectory)
+	q.AddTargetKey(&tbk)
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+	reader, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+	csm, _, err = reader.Read()
+	c.Assert(err, IsNil)
+	c.Assert(csm[tbk].Len(), Equals, 1)
+}
+
+func (s *TestSuite) TestCatalogLock(c *C) {
+	defer func(poll time.Duration) { catalogLockPoll = poll }(catalogLockPoll)
+	catalogLockPoll = time.Millisecond
+	rootDir := c.MkDir()
+
+	c.Assert(waitCatalogLock(rootDir), IsNil)
+	c.Assert(lockCatalog(rootDir, "compaction", time.Hour), IsNil)
+	c.Assert(lockCatalog(rootDir, "fsck", time.Hour), Equals, ErrCatalogLocked)
+	lock, err := readCatalogLock(rootDir)
+	c.Assert(err, IsNil)
+	c.Assert(lock.Operation, Equals, "compaction")
+	c.Assert(lock.PID, Equals, os.Getpid())
+	c.Assert(lock.ExpectedDuration, Equals, time.Hour)
+
+	// A lock of this process on startup was left by an earlier run with its PID
+	c.Assert(waitCatalogLock(rootDir), IsNil)
+	_, err = os.Stat(filepath.Join(rootDir, CatalogLockName))
+	c.Assert(os.IsNotExist(err), Equals, true)
+
+	writeLock := func(pid int, expected time.Duration) {
+		data, err := json.Marshal(CatalogLock{
+			Operation:        "compaction",
+			PID:              pid,
+			StartTime:        time.Now().UTC(),
+			ExpectedDuration: expected,
+		})
+		c.Assert(err, IsNil)
+		c.Assert(ioutil.WriteFile(filepath.Join(rootDir, CatalogLockName), data, 0644), IsNil)
+	}
+
+	// Starting instances wait for the lock of another process to be released
+	writeLock(os.Getppid(), time.Hour)
+	done := make(chan error)
+	go func() { done <- waitCatalogLock(rootDir) }()
+	select {
+	case <-done:
+		c.Fatal("the lock was not waited for")
+	case <-time.After(20 * time.Millisecond):
+	}
+	c.Assert(unlockCatalog(rootDir), IsNil)
+	c.Assert(<-done, IsNil)
+
+	// and fail once the operation should be over
+	writeLock(os.Getppid(), 10*time.Millisecond)
+	c.Assert(waitCatalogLock(rootDir), Equals, ErrCatalogLocked)
+	c.Assert(unlockCatalog(rootDir), IsNil)
+
+	// Stale locks are removed, whether their process is gone or they are unreadable
+	exited := exec.Command("true")
+	c.Assert(exited.Run(), IsNil)
+	data, err := json.Marshal(CatalogLock{
+		Operation:        "compaction",
+		PID:              exited.Process.Pid,
+		StartTime:        time.Now().UTC(),
+		ExpectedDuration: time.Hour,
+	})
+	c.Assert(err, IsNil)
+	for _, content := range [][]byte{data, []byte("{\"operation\": ")} {
+		c.Assert(ioutil.WriteFile(filepath.Join(rootDir, CatalogLockName), content, 0644), IsNil)
+		c.Assert(waitCatalogLock(rootDir), IsNil)
+		_, err = os.Stat(filepath.Join(rootDir, CatalogLockName))
+		c.Assert(os.IsNotExist(err), Equals, true)
+	}
+
+	// Pause holds the lock until Resume
+	c.Assert(Pause(), IsNil)
+	lock, err = readCatalogLock(ThisInstance.RootDir)
+	c.Assert(err, IsNil)
+	c.Assert(lock.Operation, Equals, "pause")
+	c.Assert(Resume(), IsNil)
+	lock, err = readCatalogLock(ThisInstance.RootDir)
+	c.Assert(err, IsNil)
+	c.Assert(lock, IsNil)
+}
+
+func (s *TestSuite) TestFileStats(c *C) {
+	tbk := NewTimeBucketKey("STATS/1Min/OHLC")
+	epoch := func(min int) int64 {
+		return time.Date(2003, time.March, 1, 0, min, 0, 0, time.UTC).Unix()
+	}
+	write := func(mins ...int) {
+		cs := NewColumnSeries()
+		epochs := make([]int64, len(mins))
+		opens := make([]float32, len(mins))
+		for i, min := range mins {
+			epochs[i] = epoch(min)
+			opens[i] = float32(min)
+		}
+		cs.AddColumn("Epoch", epochs)
+		cs.AddColumn("Open", opens)
+		csm := NewColumnSeriesMap()
+		csm.AddColumnSeries(*tbk, cs)
+		c.Assert(WriteCSM(csm, false), IsNil)
+	}
+	write(10, 20, 30)
+	// The stats of a new file are built in the background
+	fileStatsRebuilds.Wait()
+	path := filepath.Join(tbk.GetPathToYearFiles(ThisInstance.RootDir), "2003.bin")
+	st := ReadFileStats(path)
+	c.Assert(st, NotNil)
+	c.Assert(st.MinEpoch, Equals, epoch(10))
+	c.Assert(st.MaxEpoch, Equals, epoch(30))
+	c.Assert(st.RowCount, Equals, int64(3))
+	c.Assert(st.NullCount, Equals, int64(365*24*60-3))
+
+	// Rewrites are not counted twice
+	write(5, 20, 25, 40, 40)
+	st = ReadFileStats(path)
+	c.Assert(st, NotNil)
+	c.Assert(st.MinEpoch, Equals, epoch(5))
+	c.Assert(st.MaxEpoch, Equals, epoch(40))
+	c.Assert(st.RowCount, Equals, int64(6))
+	tbi, err := NewTimeBucketInfoFromFile(path)
+	c.Assert(err, IsNil)
+	built, err := buildFileStats(tbi)
+	c.Assert(err, IsNil)
+	c.Assert(built.RowCount, Equals, st.RowCount)
+	c.Assert(built.NullCount, Equals, st.NullCount)
+
+	// Files out of the range are not planned
+	planned := func(start, end int64) int {
+		q := NewQuery(ThisInstance.CatalogDir)
+		q.AddTargetKey(tbk)
+		q.SetRange(start, end)
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		iop, err := NewIOPlan(parsed.QualifiedFiles, parsed, false)
+		c.Assert(err, IsNil)
+		return len(iop.FilePlan)
+	}
+	c.Assert(planned(epoch(0), epoch(60)), Equals, 1)
+	c.Assert(planned(epoch(41), epoch(60)), Equals, 0)
+
+	// Stats are ignored while the file is written
+	u := newFileStatsUpdate(path, nil)
+	c.Assert(u.begin(), IsNil)
+	c.Assert(ReadFileStats(path), IsNil)
+	c.Assert(planned(epoch(41), epoch(60)), Equals, 1)
+	u.save()
+	c.Assert(planned(epoch(41), epoch(60)), Equals, 0)
+
+	// Stats rebuilt while the file is written are dropped
+	rewrite := newFileRewrite(path)
+	c.Assert(rewrite.begin(), IsNil)
+	c.Assert(rebuildFileStats(path), IsNil)
+	c.Assert(ReadFileStats(path), IsNil)
+	rewrite.save()
+	fileStatsRebuilds.Wait()
+	c.Assert(planned(epoch(41), epoch(60)), Equals, 0)
+
+	// VARIABLE records count the rows of each interval
+	tickKey := NewTimeBucketKey("STATS/1Min/TICK")
+	for _, secs := range [][]int64{{1, 2}, {3}, {61}} {
+		cs := NewColumnSeries()
+		epochs := make([]int64, len(secs))
+		for i, sec := range secs {
+			epochs[i] = epoch(0) + sec
+		}
+		cs.AddColumn("Epoch", epochs)
+		cs.AddColumn("Bid", make([]float32, len(secs)))
+		csm := NewColumnSeriesMap()
+		csm.AddColumnSeries(*tickKey, cs)
+		c.Assert(WriteCSM(csm, true), IsNil)
+	}
+	fileStatsRebuilds.Wait()
+	path = filepath.Join(tickKey.GetPathToYearFiles(ThisInstance.RootDir), "2003.bin")
+	st = ReadFileStats(path)
+	c.Assert(st, NotNil)
+	c.Assert(st.RowCount, Equals, int64(4))
+	c.Assert(st.MinEpoch, Equals, epoch(0))
+	c.Assert(st.MaxEpoch, Equals, epoch(2)-1)
+	tbi, err = NewTimeBucketInfoFromFile(path)
+	c.Assert(err, IsNil)
+	built, err = buildFileStats(tbi)
+	c.Assert(err, IsNil)
+	c.Assert(built.RowCount, Equals, st.RowCount)
+	c.Assert(built.NullCount, Equals, st.NullCount)
+}
+
+func (s *TestSuite) TestSamplePercent(c *C) {
+	key := NewTimeBucketKey("NZDUSD/1H/OHLC")
+	read := func(percent float64, seed int64) (*ColumnSeries, error) {
+		q := NewQuery(ThisInstance.CatalogDir)
+		q.AddTargetKey(key)
+		q.SetSamplePercent(percent, seed)
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		reader, err := NewReader(parsed)
+		if err != nil {
+			return nil, err
+		}
+		csm, _, err := reader.Read()
+		c.Assert(err, IsNil)
+		return csm[*key], nil
+	}
+	all, err := read(100, 1)
+	c.Assert(err, IsNil)
+	sample, err := read(10, 1)
+	c.Assert(err, IsNil)
+	// 10% of the rows, within a margin of the binomial deviation
+	expected := float64(all.Len()) / 10
+	c.Assert(math.Abs(float64(sample.Len())-expected) < 5*math.Sqrt(expected), Equals, true)
+	again, err := read(10, 1)
+	c.Assert(err, IsNil)
+	c.Assert(again.GetEpoch(), DeepEquals, sample.GetEpoch())
+
+	_, err = read(0, 1)
+	c.Assert(err, NotNil)
+	_, err = read(101, 1)
+	c.Assert(err, NotNil)
+
+	// Each key of a query is sampled on its own, the same as when it is read
+	// alone, and keys with the same timestamps don't keep the same rows
+	other := NewTimeBucketKey("EURUSD/1H/OHLC")
+	q := NewQuery(ThisInstance.CatalogDir)
+	q.AddTargetKey(NewTimeBucketKey("NZDUSD,EURUSD/1H/OHLC"))
+	q.SetSamplePercent(10, 1)
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+	reader, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+	csm, _, err := reader.Read()
+	c.Assert(err, IsNil)
+	c.Assert(csm[*key].GetEpoch(), DeepEquals, sample.GetEpoch())
+	c.Assert(csm[*other].Len() > 0, Equals, true)
+	c.Assert(csm[*other].GetEpoch(), Not(DeepEquals), sample.GetEpoch())
+
+	// A percent limit keeps the sampling of the rows
+	q = NewQuery(ThisInstance.CatalogDir)
+	q.AddTargetKey(key)
+	q.SetRowLimitPercent(FIRST, 50)
+	parsed, err = q.Parse()
+	c.Assert(err, IsNil)
+	parsed.Limit.IsSample = true
+	parsed.Limit.SamplePercent = 10
+	parsed.Limit.SampleSeed = 1
+	reader, err = NewReader(parsed)
+	c.Assert(err, IsNil)
+	c.Assert(reader.IOPMap[*key].Limit.IsPercent, Equals, false)
+	c.Assert(reader.IOPMap[*key].Limit.IsSample, Equals, true)
+	c.Assert(reader.IOPMap[*key].Limit.SamplePercent, Equals, 10.0)
+	c.Assert(reader.IOPMap[*key].Limit.SampleSeed, Equals, int64(1))
+
+	// The last sampled record of a LAST read is not taken for the last
+	// record of its file
+	q = NewQuery(ThisInstance.CatalogDir)
+	q.AddTargetKey(key)
+	q.SetRowLimit(LAST, 10)
+	parsed, err = q.Parse()
+	c.Assert(err, IsNil)
+	parsed.Limit.IsSample = true
+	parsed.Limit.SamplePercent = 10
+	parsed.Limit.SampleSeed = 1
+	reader, err = NewReader(parsed)
+	c.Assert(err, IsNil)
+	c.Assert(reader.IOPMap[*key].FilePlan, Not(HasLen), 0)
+	for _, fp := range reader.IOPMap[*key].FilePlan {
+		c.Assert(fp.seekingLast, Equals, false)
+	}
+}
+
+func (s *TestSuite) TestWriteEpochFloat(c *C) {
+	base := time.Date(2003, time.April, 1, 10, 0, 0, 0, time.UTC).Unix()
+	read := func(key *TimeBucketKey) *ColumnSeries {
+		q := NewQuery(ThisInstance.CatalogDir)
+		q.AddTargetKey(key)
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		reader, err := NewReader(parsed)
+		c.Assert(err, IsNil)
+		csm, _, err := reader.Read()
+		c.Assert(err, IsNil)
+		return csm[*key]
+	}
+
+	// FIXED records are at the start of their interval
+	fixed := NewTimeBucketKey("FLOATEPOCH/1Min/OHLC")
+	err := WriteEpochFloat(*fixed, []float64{float64(base) + 30.5, float64(base) + 61.25},
+		map[string]interface{}{"Open": []float32{1, 2}})
+	c.Assert(err, IsNil)
+	cs := read(fixed)
+	c.Assert(cs.GetEpoch(), DeepEquals, []int64{base, base + 60})
+	c.Assert(cs.GetByName("Open"), DeepEquals, []float32{1, 2})
+
+	// VARIABLE records keep the fraction of a second
+	tick := NewTimeBucketKey("FLOATEPOCH/1Min/TICK")
+	shapes := []DataShape{{Name: "Epoch", Type: INT64}, {Name: "Ask", Type: FLOAT32}, {Name: "Bid", Type: FLOAT32}}
+	c.Assert(CreateBucket(*tick, shapes, *utils.TimeframeFromString("1Min"), VARIABLE), IsNil)
+	epochs := []float64{float64(base) + 0.5, float64(base) + 1.25}
+	err = WriteEpochFloat(*tick, epochs, map[string]interface{}{
+		"Bid": []float32{1, 2},
+		"Ask": []float32{3, 4},
+	})
+	c.Assert(err, IsNil)
+	cs = read(tick)
+	c.Assert(cs.Len(), Equals, 2)
+	c.Assert(cs.GetByName("Ask"), DeepEquals, []float32{3, 4})
+	nanos := cs.GetByName("Nanoseconds").([]int32)
+	for i, epoch := range cs.GetEpoch() {
+		got := float64(epoch) + float64(nanos[i])/1e9
+		c.Assert(math.Abs(got-epochs[i]) < 1e-6, Equals, true, Commentf("%v != %v", got, epochs[i]))
+	}
+
+	c.Assert(WriteEpochFloat(*fixed, []float64{math.NaN()},
+		map[string]interface{}{"Open": []float32{1}}), NotNil)
+}
+
+func (s *TestSuite) TestMaterializeView(c *C) {
+	source := *NewTimeBucketKey("VIEWSRC/1Min/OHLC")
+	viewKey := *NewTimeBucketKey("VIEWSRC/5Min/OHLC")
+	base := time.Date(2004, time.March, 1, 10, 0, 0, 0, time.UTC).Unix()
+	write := func(epochs []int64, open, close []float32) {
+		cs := NewColumnSeries()
+		cs.AddColumn("Epoch", epochs)
+		cs.AddColumn("Open", open)
+		cs.AddColumn("Close", close)
+		csm := NewColumnSeriesMap()
+		csm.AddColumnSeries(source, cs)
+		c.Assert(WriteCSM(csm, false), IsNil)
+	}
+	read := func() *ColumnSeries {
+		q := NewQuery(ThisInstance.CatalogDir)
+		q.AddTargetKey(&viewKey)
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		reader, err := NewReader(parsed)
+		c.Assert(err, IsNil)
+		csm, _, err := reader.Read()
+		c.Assert(err, IsNil)
+		return csm[viewKey]
+	}
+	write([]int64{base, base + 60, base + 120}, []float32{1, 2, 3}, []float32{4, 5, 6})
+
+	agg := AggSpec{Method: AggMethod{"Open": AggFirst, "Close": AggLast}}
+	err := MaterializeView(*NewTimeBucketKey("VIEWSRC/90Sec/OHLC"), source, agg, time.Millisecond)
+	c.Assert(err, Equals, ErrIncompatibleTimeframe)
+	c.Assert(MaterializeView(viewKey, source, agg, 10*time.Millisecond), IsNil)
+	c.Assert(MaterializeView(viewKey, source, agg, 10*time.Millisecond), Equals, ErrViewExists)
+	cs := read()
+	c.Assert(cs.GetEpoch(), DeepEquals, []int64{base})
+	c.Assert(cs.GetByName("Open"), DeepEquals, []float32{1})
+	c.Assert(cs.GetByName("Close"), DeepEquals, []float32{6})
+
+	// Writes to the source are picked up at the next refresh
+	write([]int64{base + 240, base + 300}, []float32{7, 8}, []float32{9, 10})
+	deadline := time.Now().Add(5 * time.Second)
+	for cs = read(); cs.Len() < 2 && time.Now().Before(deadline); cs = read() {
+		time.Sleep(10 * time.Millisecond)
+	}
+	c.Assert(cs.GetEpoch(), DeepEquals, []int64{base, base + 300})
+	c.Assert(cs.GetByName("Close"), DeepEquals, []float32{9, 10})
+
+	list, err := ListViews()
+	c.Assert(err, IsNil)
+	c.Assert(len(list), Equals, 1)
+	c.Assert(list[0].SourceKey, Equals, source)
+	c.Assert(list[0].RefreshInterval, Equals, 10*time.Millisecond)
+
+	c.Assert(DropView(viewKey), IsNil)
+	c.Assert(DropView(viewKey), Equals, ErrViewNotFound)
+	_, err = ThisInstance.CatalogDir.GetLatestTimeBucketInfoFromKey(&viewKey)
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestAlignToRecord(c *C) {
+	c.Assert(alignToRecord(0, 24), Equals, int64(Headersize))
+	c.Assert(alignToRecord(Headersize, 24), Equals, int64(Headersize))
+	c.Assert(alignToRecord(Headersize+1, 24), Equals, int64(Headersize+24))
+	c.Assert(alignToRecord(Headersize+48, 24), Equals, int64(Headersize+48))
+	c.Assert(alignToRecord(Headersize+49, 24), Equals, int64(Headersize+72))
+}
+
+func (s *TestSuite) TestMaxConcurrency(c *C) {
+	read := func(maxConcurrency int) (ColumnSeriesMap, *reader) {
+		q := NewQuery(s.DataDirectory)
+		q.AddRestriction("AttributeGroup", "OHLC")
+		q.AddRestriction("Timeframe", "1Min")
+		q.SetRange(
+			time.Date(2002, time.March, 1, 0, 0, 0, 0, time.UTC).Unix(),
+			time.Date(2002, time.April, 1, 0, 0, 0, 0, time.UTC).Unix())
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		parsed.MaxConcurrency = maxConcurrency
+		reader, err := NewReader(parsed)
+		c.Assert(err, IsNil)
+		csm, _, err := reader.Read()
+		c.Assert(err, IsNil)
+		return csm, reader
+	}
+	serial, r := read(1)
+	c.Assert(r.workers(), Equals, 1)
+	c.Assert(len(serial) > 1, Equals, true)
+	_, r = read(0)
+	c.Assert(r.workers() <= runtime.NumCPU(), Equals, true)
+
+	// The instance may read more keys at once than it has CPUs
+	defer func() { utils.InstanceConfig.ReadWorkers = 0 }()
+	utils.InstanceConfig.ReadWorkers = 64
+	parallel, r := read(0)
+	c.Assert(r.workers(), Equals, len(serial))
+	c.Assert(len(parallel), Equals, len(serial))
+	for key, cs := range serial {
+		c.Assert(parallel[key].GetEpoch(), DeepEquals, cs.GetEpoch())
+		c.Assert(parallel[key].GetByName("Close"), DeepEquals, cs.GetByName("Close"))
+	}
+	_, r = read(2)
+	c.Assert(r.workers(), Equals, 2)
+}
+
+func (s *TestSuite) TestFullHistoryTPrev(c *C) {
+	// The 1970 year file is planned as the previous file of the range
+	key := *NewTimeBucketKey("EPOCHZERO/1Min/OHLC")
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{60, 120})
+	cs.AddColumn("Open", []float32{1, 2})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(key, cs)
+	c.Assert(WriteCSM(csm, false), IsNil)
+
+	q := NewQuery(ThisInstance.CatalogDir)
+	q.AddTargetKey(&key)
+	q.SetRange(MinEpoch, 3600)
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+	reader, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+	csm, tPrevMap, err := reader.Read()
+	c.Assert(err, IsNil)
+	c.Assert(csm[key].GetEpoch(), DeepEquals, []int64{60, 120})
+	c.Assert(tPrevMap[key], Equals, int64(0))
+}
+
+func (s *TestSuite) TestVacuum(c *C) {
+	base := time.Date(2005, time.May, 2, 10, 0, 0, 0, time.UTC).Unix()
+	fixed := *NewTimeBucketKey("VACUUM/1Min/OHLC")
+	tick := *NewTimeBucketKey("VACUUM/1Min/TICK")
+	shapes := []DataShape{{Name: "Epoch", Type: INT64}, {Name: "Bid", Type: FLOAT32}}
+	c.Assert(CreateBucket(tick, shapes, *utils.TimeframeFromString("1Min"), VARIABLE), IsNil)
+	write := func(key TimeBucketKey, epochs []int64, name string, values []float32) {
+		cs := NewColumnSeries()
+		cs.AddColumn("Epoch", epochs)
+		cs.AddColumn(name, values)
+		csm := NewColumnSeriesMap()
+		csm.AddColumnSeries(key, cs)
+		c.Assert(WriteCSM(csm, key == tick), IsNil)
+	}
+	read := func(key TimeBucketKey) *ColumnSeries {
+		q := NewQuery(ThisInstance.CatalogDir)
+		q.AddTargetKey(&key)
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		reader, err := NewReader(parsed)
+		c.Assert(err, IsNil)
+		csm, _, err := reader.Read()
+		c.Assert(err, IsNil)
+		return csm[key]
+	}
+	write(fixed, []int64{base, base + 60}, "Open", []float32{1, 2})
+	// The first data of the interval at base is left behind by the rewrite
+	write(tick, []int64{base + 1}, "Bid", []float32{1})
+	write(tick, []int64{base + 61}, "Bid", []float32{2})
+	write(tick, []int64{base + 2}, "Bid", []float32{3})
+	tbi, err := getTimeBucketInfoForYear(&tick, 2005)
+	c.Assert(err, IsNil)
+	fi, err := os.Stat(tbi.Path)
+	c.Assert(err, IsNil)
+	sizeBefore := fi.Size()
+	ticks := read(tick)
+
+	c.Assert(Vacuum(1, func(VacuumProgress) {}), NotNil)
+	vacuumed := map[string]VacuumProgress{}
+	c.Assert(Vacuum(0.5, func(vp VacuumProgress) { vacuumed[vp.Path] = vp }), IsNil)
+	vp, ok := vacuumed[tbi.Path]
+	c.Assert(ok, Equals, true)
+	c.Assert(vp.Error, Equals, "")
+	c.Assert(vp.NullRatio > 0.99, Equals, true)
+
+	cs := read(fixed)
+	c.Assert(cs.GetEpoch(), DeepEquals, []int64{base, base + 60})
+	c.Assert(cs.GetByName("Open"), DeepEquals, []float32{1, 2})
+	after := read(tick)
+	c.Assert(after.GetEpoch(), DeepEquals, ticks.GetEpoch())
+	c.Assert(after.GetByName("Bid"), DeepEquals, ticks.GetByName("Bid"))
+	fi, err = os.Stat(tbi.Path)
+	c.Assert(err, IsNil)
+	c.Assert(fi.Size() < sizeBefore, Equals, true)
+
+	// Writes go on once the vacuum is done
+	write(fixed, []int64{base + 120}, "Open", []float32{3})
+	c.Assert(read(fixed).Len(), Equals, 3)
+
+	// A file written all along the compactions is left as it was
+	fixedInfo, err := getTimeBucketInfoForYear(&fixed, 2005)
+	c.Assert(err, IsNil)
+	rewrite := newFileRewrite(fixedInfo.Path)
+	c.Assert(rewrite.begin(), IsNil)
+	_, err = vacuumYearFile(fixedInfo, 0.99)
+	rewrite.save()
+	c.Assert(err, Equals, ErrYearFileBusy)
+	_, err = os.Stat(fixedInfo.Path + ".vacuum")
+	c.Assert(os.IsNotExist(err), Equals, true)
+
+	// The switch to the compacted file waits for paused writes to resume
+	timeout := utils.InstanceConfig.PausedWriteTimeout
+	utils.InstanceConfig.PausedWriteTimeout = 10 * time.Millisecond
+	defer func() { utils.InstanceConfig.PausedWriteTimeout = timeout }()
+	c.Assert(Pause(), IsNil)
+	_, err = vacuumYearFile(fixedInfo, 0.99)
+	c.Assert(Resume(), IsNil)
+	c.Assert(err, Equals, ErrWritesPaused)
+	_, err = vacuumYearFile(fixedInfo, 0.99)
+	c.Assert(err, IsNil)
+	c.Assert(read(fixed).Len(), Equals, 3)
+}
+
+func (s *TestSuite) TestRecoverYearFiles(c *C) {
+	base := time.Date(2005, time.May, 2, 10, 0, 0, 0, time.UTC).Unix()
+	fixed := *NewTimeBucketKey("RECOVER/1Min/OHLC")
+	tick := *NewTimeBucketKey("RECOVER/1Min/TICK")
+	shapes := []DataShape{{Name: "Epoch", Type: INT64}, {Name: "Bid", Type: FLOAT32}, {Name: "Ask", Type: FLOAT32}}
+	c.Assert(CreateBucket(tick, shapes, *utils.TimeframeFromString("1Min"), VARIABLE), IsNil)
+	write := func(key TimeBucketKey, epochs []int64, names []string, values []float32) {
+		cs := NewColumnSeries()
+		cs.AddColumn("Epoch", epochs)
+		for _, name := range names {
+			cs.AddColumn(name, values)
+		}
+		csm := NewColumnSeriesMap()
+		csm.AddColumnSeries(key, cs)
+		c.Assert(WriteCSM(csm, key == tick), IsNil)
+		ThisInstance.WALFile.RequestFlush()
+	}
+	// The data of an interrupted write is left at the end of the files
+	interrupt := func(key TimeBucketKey) (string, int64) {
+		tbi, err := getTimeBucketInfoForYear(&key, 2005)
+		c.Assert(err, IsNil)
+		fi, err := os.Stat(tbi.Path)
+		c.Assert(err, IsNil)
+		fp, err := os.OpenFile(tbi.Path, os.O_APPEND|os.O_WRONLY, 0600)
+		c.Assert(err, IsNil)
+		_, err = fp.Write([]byte{1, 2, 3, 4, 5, 6, 7})
+		c.Assert(err, IsNil)
+		c.Assert(fp.Close(), IsNil)
+		return tbi.Path, fi.Size()
+	}
+	write(fixed, []int64{base}, []string{"Open"}, []float32{1})
+	write(tick, []int64{base + 1}, []string{"Bid", "Ask"}, []float32{1})
+	fixedPath, fixedSize := interrupt(fixed)
+	tickPath, tickSize := interrupt(tick)
+
+	// The index of a VARIABLE file is not scanned if not modified since a clean shutdown
+	c.Assert(markCleanShutdown(ThisInstance.RootDir), IsNil)
+	earlier := time.Now().Add(-time.Hour)
+	c.Assert(os.Chtimes(tickPath, earlier, earlier), IsNil)
+	recoverYearFiles(ThisInstance.CatalogDir, takeCleanShutdown(ThisInstance.RootDir))
+	fi, err := os.Stat(tickPath)
+	c.Assert(err, IsNil)
+	c.Assert(fi.Size(), Equals, tickSize+7)
+	_, err = os.Stat(filepath.Join(ThisInstance.RootDir, CleanShutdownName))
+	c.Assert(os.IsNotExist(err), Equals, true)
+
+	recoverYearFiles(ThisInstance.CatalogDir, takeCleanShutdown(ThisInstance.RootDir))
+	for path, size := range map[string]int64{fixedPath: fixedSize, tickPath: tickSize} {
+		fi, err := os.Stat(path)
+		c.Assert(err, IsNil)
+		c.Assert(fi.Size(), Equals, size)
+	}
+
+	// The next write to the interval is appended to its data again
+	write(tick, []int64{base + 2}, []string{"Bid", "Ask"}, []float32{2})
+	q := NewQuery(ThisInstance.CatalogDir)
+	q.AddTargetKey(&tick)
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+	reader, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+	csm, _, err := reader.Read()
+	c.Assert(err, IsNil)
+	c.Assert(csm[tick].GetEpoch(), DeepEquals, []int64{base + 1, base + 2})
+	c.Assert(csm[tick].GetByName("Bid"), DeepEquals, []float32{1, 2})
+}
+
+func (s *TestSuite) TestBulkWriter(c *C) {
+	key := *NewTimeBucketKey("BULKWRITE/1Min/OHLC")
+	t0 := time.Date(2016, time.March, 3, 10, 0, 0, 0, time.UTC).Unix()
+	request := func(i int) *WriteRequest {
+		cs := NewColumnSeries()
+		cs.AddColumn("Epoch", []int64{t0 + int64(i)*60})
+		cs.AddColumn("Open", []float32{float32(i)})
+		cs.AddColumn("Close", []float32{float32(i)})
+		return &WriteRequest{Key: key, Data: cs}
+	}
+	defer func(size int) { utils.InstanceConfig.BulkWriteBatchSize = size }(utils.InstanceConfig.BulkWriteBatchSize)
+	utils.InstanceConfig.BulkWriteBatchSize = 3
+
+	acks := make(chan WriteAck, 10)
+	bw := NewBulkWriter(func(ack WriteAck) { acks <- ack })
+	for i := 0; i < 4; i++ {
+		c.Assert(bw.Write(request(i)), IsNil)
+	}
+	ack := <-acks
+	c.Assert(ack.Batch, Equals, int64(1))
+	c.Assert(ack.Requests, Equals, 3)
+	c.Assert(ack.Err, IsNil)
+	// The last request is written once it has waited long enough
+	select {
+	case ack = <-acks:
+	case <-time.After(time.Second):
+		c.Fatal("the last request was not written")
+	}
+	c.Assert(ack.Batch, Equals, int64(2))
+	c.Assert(ack.Requests, Equals, 1)
+	c.Assert(ack.Err, IsNil)
+
+	c.Assert(bw.Write(&WriteRequest{Key: key}), NotNil)
+	bw.Close()
+	c.Assert(acks, HasLen, 0)
+
+	q := NewQuery(ThisInstance.CatalogDir)
+	q.AddTargetKey(&key)
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+	reader, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+	csm, _, err := reader.Read()
+	c.Assert(err, IsNil)
+	c.Assert(csm[key].GetEpoch(), DeepEquals, []int64{t0, t0 + 60, t0 + 120, t0 + 180})
+	c.Assert(csm[key].GetByName("Close").([]float32), DeepEquals, []float32{0, 1, 2, 3})
+}
+
+func (s *TestSuite) TestStorageTiers(c *C) {
+	key := *NewTimeBucketKey("TIER/1Min/OHLC")
+	t0 := time.Date(2003, time.March, 3, 10, 0, 0, 0, time.UTC).Unix()
+	write := func(epoch int64, value float32) {
+		cs := NewColumnSeries()
+		cs.AddColumn("Epoch", []int64{epoch})
+		cs.AddColumn("Bid", []float32{value})
+		cs.AddColumn("Ask", []float32{value})
+		csm := NewColumnSeriesMap()
+		csm.AddColumnSeries(key, cs)
+		c.Assert(WriteCSM(csm, false), IsNil)
+		ThisInstance.WALFile.RequestFlush()
+	}
+	read := func() []float32 {
+		q := NewQuery(ThisInstance.CatalogDir)
+		q.AddTargetKey(&key)
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		reader, err := NewReader(parsed)
+		c.Assert(err, IsNil)
+		csm, _, err := reader.Read()
+		c.Assert(err, IsNil)
+		return csm[key].GetByName("Ask").([]float32)
+	}
+	write(t0, 1)
+	c.Assert(PromoteYearFile(key, 2003), Equals, ErrNoColdStorage)
+
+	coldDir := c.MkDir()
+	defer func() { utils.InstanceConfig.ColdStorageDirectory = "" }()
+	utils.InstanceConfig.ColdStorageDirectory = coldDir
+	coldPath := filepath.Join(coldDir, "TIER/1Min/OHLC/2003.bin")
+	tbi, err := yearFileInfo(key, 2003)
+	c.Assert(err, IsNil)
+
+	// A file written all along the copies is left where it is
+	rewrite := newFileRewrite(tbi.Path)
+	c.Assert(rewrite.begin(), IsNil)
+	c.Assert(PromoteYearFile(key, 2003), Equals, ErrYearFileBusy)
+	rewrite.save()
+	fileStatsRebuilds.Wait()
+	c.Assert(tbi.GetStorageTier(), Equals, HotTier)
+	_, err = os.Stat(coldPath)
+	c.Assert(os.IsNotExist(err), Equals, true)
+
+	// The writes are not paused for the copy
+	c.Assert(Pause(), IsNil)
+	err = PromoteYearFile(key, 2003)
+	c.Assert(Resume(), IsNil)
+	c.Assert(err, IsNil)
+	c.Assert(tbi.GetStorageTier(), Equals, ColdTier)
+	fi, err := os.Lstat(tbi.Path)
+	c.Assert(err, IsNil)
+	c.Assert(fi.Mode()&os.ModeSymlink, Not(Equals), os.FileMode(0))
+	_, err = os.Stat(coldPath)
+	c.Assert(err, IsNil)
+	c.Assert(read(), DeepEquals, []float32{1})
+
+	// The cold file is written through the link and the tier outlives a restart
+	write(t0+60, 2)
+	c.Assert(read(), DeepEquals, []float32{1, 2})
+	reloaded := NewDirectory(s.Rootdir)
+	tbi, err = reloaded.GetLatestTimeBucketInfoFromKey(&key)
+	c.Assert(err, IsNil)
+	c.Assert(tbi.GetStorageTier(), Equals, ColdTier)
+
+	// The cold file is not replaced by an atomic write
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{t0 + 120})
+	cs.AddColumn("Bid", []float32{3})
+	cs.AddColumn("Ask", []float32{3})
+	err = WriteAtomicMulti(map[TimeBucketKey]*RowSeries{key: cs.ToRowSeries(key)})
+	c.Assert(err, ErrorMatches, ".*"+ErrColdYearFile.Error())
+	c.Assert(read(), DeepEquals, []float32{1, 2})
+	// Nor left behind by a resize
+	err = Resize(key, *utils.TimeframeFromString("5Min"))
+	c.Assert(err, ErrorMatches, ".*"+ErrColdYearFile.Error())
+	c.Assert(read(), DeepEquals, []float32{1, 2})
+
+	// The cold file follows its bucket when the symbol is renamed
+	renamed := *NewTimeBucketKey("TIERED/1Min/OHLC")
+	c.Assert(RenameSymbol(key, renamed), IsNil)
+	_, err = os.Stat(filepath.Join(coldDir, "TIERED/1Min/OHLC/2003.bin"))
+	c.Assert(err, IsNil)
+	_, err = os.Stat(coldPath)
+	c.Assert(os.IsNotExist(err), Equals, true)
+	c.Assert(RenameSymbol(renamed, key), IsNil)
+	c.Assert(read(), DeepEquals, []float32{1, 2})
+
+	c.Assert(DemoteYearFile(key, 2003), IsNil)
+	fi, err = os.Lstat(filepath.Join(s.Rootdir, "TIER/1Min/OHLC/2003.bin"))
+	c.Assert(err, IsNil)
+	c.Assert(fi.Mode().IsRegular(), Equals, true)
+	_, err = os.Stat(coldPath)
+	c.Assert(os.IsNotExist(err), Equals, true)
+	c.Assert(read(), DeepEquals, []float32{1, 2})
+
+	// Only the years older than the hot ones are moved
+	promoteColdYears(time.Now().Year()-2003, nil)
+	tbi, err = yearFileInfo(key, 2003)
+	c.Assert(err, IsNil)
+	c.Assert(tbi.GetStorageTier(), Equals, HotTier)
+	promoted := promoteColdYears(time.Now().Year()-2004, nil)
+	c.Assert(promoted > 0, Equals, true)
+	c.Assert(tbi.GetStorageTier(), Equals, ColdTier)
+
+	for _, tbi := range ThisInstance.CatalogDir.GatherTimeBucketInfo() {
+		c.Assert(demoteYearFile(tbi), IsNil)
+	}
+}
+
+func (s *TestSuite) TestNaNCount(c *C) {
+	key := *NewTimeBucketKey("NANCOUNT/1Min/OHLC")
+	t0 := time.Date(2003, time.March, 3, 10, 0, 0, 0, time.UTC).Unix()
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{t0, t0 + 60, t0 + 120})
+	cs.AddColumn("Bid", []float32{1, float32(math.NaN()), float32(math.Inf(1))})
+	cs.AddColumn("Ask", []float64{math.NaN(), 2, 3})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(key, cs)
+	c.Assert(WriteCSM(csm, false), IsNil)
+
+	newReader := func(failOnNaN bool) *reader {
+		q := NewQuery(ThisInstance.CatalogDir)
+		q.AddTargetKey(&key)
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		parsed.FailOnNaN = failOnNaN
+		reader, err := NewReader(parsed)
+		c.Assert(err, IsNil)
+		return reader
+	}
+	r := newReader(false)
+	csm, _, err := r.Read()
+	c.Assert(err, IsNil)
+	c.Assert(csm[key].Len(), Equals, 3)
+	c.Assert(r.Stats().NaNCount, Equals, int64(2))
+	c.Assert(r.Stats().InfCount, Equals, int64(1))
+
+	_, _, err = newReader(true).Read()
+	c.Assert(err, Equals, ErrNaNValue)
+}
+
+func (s *TestSuite) TestColumnFilter(c *C) {
+	key := *NewTimeBucketKey("EURUSD/1Min/OHLC")
+	read := func(filter ...string) (*ColumnSeries, error) {
+		q := NewQuery(s.DataDirectory)
+		q.AddTargetKey(&key)
+		q.SetRange(
+			time.Date(2001, time.December, 31, 0, 0, 0, 0, time.UTC).Unix(),
+			time.Date(2002, time.January, 2, 0, 0, 0, 0, time.UTC).Unix())
+		parsed, err := q.Parse()
+		c.Assert(err, IsNil)
+		parsed.ColumnFilter = filter
+		reader, err := NewReader(parsed)
+		if err != nil {
+			return nil, err
+		}
+		csm, _, err := reader.Read()
+		c.Assert(err, IsNil)
+		return csm[key], nil
+	}
+	all, err := read()
+	c.Assert(err, IsNil)
+	c.Assert(all.GetColumnNames(), DeepEquals, []string{"Epoch", "Open", "High", "Low", "Close"})
+
+	projected, err := read("Close", "Open")
+	c.Assert(err, IsNil)
+	c.Assert(projected.GetColumnNames(), DeepEquals, []string{"Epoch", "Open", "Close"})
+	c.Assert(projected.GetEpoch(), DeepEquals, all.GetEpoch())
+	c.Assert(projected.GetByName("Open"), DeepEquals, all.GetByName("Open"))
+	c.Assert(projected.GetByName("Close"), DeepEquals, all.GetByName("Close"))
+
+	_, err = read("Volume")
+	c.Assert(err, NotNil)
+
+	// The rows of VARIABLE records are projected once built
+	tick := *NewTimeBucketKey("FILTERTICKS/1Min/TICK")
+	cs := NewColumnSeries()
+	t0 := time.Date(2003, time.March, 3, 10, 0, 0, 0, time.UTC).Unix()
+	cs.AddColumn("Epoch", []int64{t0, t0})
+	cs.AddColumn("Bid", []float32{1, 2})
+	cs.AddColumn("Ask", []float32{3, 4})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(tick, cs)
+	c.Assert(WriteCSM(csm, true), IsNil)
+	q := NewQuery(ThisInstance.CatalogDir)
+	q.AddTargetKey(&tick)
+	parsed, err := q.Parse()
+	c.Assert(err, IsNil)
+	parsed.ColumnFilter = []string{"Ask"}
+	reader, err := NewReader(parsed)
+	c.Assert(err, IsNil)
+	csm, _, err = reader.Read()
+	c.Assert(err, IsNil)
+	c.Assert(csm[tick].GetColumnNames(), DeepEquals, []string{"Epoch", "Ask", "Nanoseconds"})
+	c.Assert(csm[tick].GetByName("Ask"), DeepEquals, []float32{3, 4})
+}
+
+func (s *ColdStorageTests) SetUpSuite(c *C) {
+	s.Rootdir = c.MkDir()
+	s.ColdDir = c.MkDir()
+	MakeDummyCurrencyDir(s.Rootdir, true, false)
+	utils.InstanceConfig.ColdStorageDirectory = s.ColdDir
+	// Only the year files of 2000 are older than the hot ones
+	utils.InstanceConfig.ColdAfterYears = time.Now().UTC().Year() - 2001
+	NewInstanceSetup(s.Rootdir, true, true, false)
+}
+
+func (s *ColdStorageTests) TearDownSuite(c *C) {
+	StopBackgroundTasks()
+	utils.InstanceConfig.ColdStorageDirectory = ""
+	utils.InstanceConfig.ColdAfterYears = 0
+	CleanupDummyDataDir(s.Rootdir)
+}
+
+func (s *ColdStorageTests) TestPromoteOnStartup(c *C) {
+	tiers := func() (cold, hot int) {
+		for _, tbi := range ThisInstance.CatalogDir.GatherTimeBucketInfo() {
+			if tbi.GetStorageTier() == ColdTier {
+				c.Assert(tbi.Year, Equals, int16(2000))
+				cold++
+			} else {
+				hot++
+			}
+		}
+		return cold, hot
+	}
+	var cold, hot int
+	for deadline := time.Now().Add(10 * time.Second); time.Now().Before(deadline); {
+		if cold, hot = tiers(); cold > 0 && cold*2 == hot {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	c.Assert(cold > 0, Equals, true)
+	c.Assert(hot, Equals, 2*cold)
+	_, err := os.Stat(filepath.Join(s.ColdDir, "EURUSD/1Min/OHLC/2000.bin"))
+	c.Assert(err, IsNil)
+}
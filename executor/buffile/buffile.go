@@ -29,7 +29,13 @@ type BufferedFile struct {
 const defaultBlockSize = 32 * 1024
 
 func New(filePath string) (*BufferedFile, error) {
-	fp, err := os.OpenFile(filePath, os.O_RDWR, 0700)
+	return NewWithFlag(filePath, os.O_RDWR)
+}
+
+// NewWithFlag opens the file with the given flag, which must allow reads
+// and writes, e.g. os.O_RDWR|os.O_SYNC
+func NewWithFlag(filePath string, flag int) (*BufferedFile, error) {
+	fp, err := os.OpenFile(filePath, flag, 0700)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,131 @@
+package executor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alpacahq/marketstore/utils"
+	. "github.com/alpacahq/marketstore/utils/io"
+)
+
+// BulkWriteMaxDelay is the longest a request waits in a BulkWriter before
+// its batch is written
+const BulkWriteMaxDelay = 10 * time.Millisecond
+
+// WriteRequest is a write of a ColumnSeries to a bucket through a BulkWriter
+type WriteRequest struct {
+	Key  TimeBucketKey
+	Data *ColumnSeries
+}
+
+// WriteAck reports the write of a batch of requests of a BulkWriter
+type WriteAck struct {
+	// Sequence number of the batch, from 1
+	Batch int64
+	// Number of requests written in the batch
+	Requests int
+	// Error of WriteBatch, nil if the whole batch was written
+	Err error
+}
+
+// BulkWriter groups the requests written to it into batches committed by a
+// single WriteBatch call, for the ingest of many small writes to cost one
+// sync per file and batch rather than one per write. A batch is written once
+// it holds utils.InstanceConfig.BulkWriteBatchSize requests, or
+// BulkWriteMaxDelay after its first request.
+type BulkWriter struct {
+	requests chan *WriteRequest
+	done     chan struct{}
+	ack      func(WriteAck)
+}
+
+// NewBulkWriter starts a BulkWriter calling ack once each batch is written,
+// from a single goroutine and in batch order.
+func NewBulkWriter(ack func(WriteAck)) *BulkWriter {
+	batchSize := utils.InstanceConfig.BulkWriteBatchSize
+	if batchSize <= 0 {
+		batchSize = utils.DefaultBulkWriteBatchSize
+	}
+	bw := &BulkWriter{
+		requests: make(chan *WriteRequest, batchSize),
+		done:     make(chan struct{}),
+		ack:      ack,
+	}
+	go bw.run(batchSize)
+	return bw
+}
+
+// Write queues req for the next batch. It must not be called once the
+// BulkWriter is closed.
+func (bw *BulkWriter) Write(req *WriteRequest) error {
+	if req.Data == nil || req.Data.Len() == 0 {
+		return fmt.Errorf("BulkWriter: no data to write to %s", req.Key.String())
+	}
+	bw.requests <- req
+	return nil
+}
+
+// Close writes the requests left and waits for their ack
+func (bw *BulkWriter) Close() {
+	close(bw.requests)
+	<-bw.done
+}
+
+func (bw *BulkWriter) run(batchSize int) {
+	defer close(bw.done)
+	var (
+		batch    = make(map[TimeBucketKey][]*ColumnSeries)
+		requests int
+		seq      int64
+		timer    = time.NewTimer(BulkWriteMaxDelay)
+		deadline <-chan time.Time
+	)
+	timer.Stop()
+	commit := func() {
+		if requests == 0 {
+			return
+		}
+		if !timer.Stop() && deadline != nil {
+			<-timer.C
+		}
+		deadline = nil
+		rows := make(map[TimeBucketKey]*RowSeries, len(batch))
+		for key, series := range batch {
+			cs := series[0]
+			if len(series) > 1 {
+				cs = ColumnSeriesUnion(cs, series[1:]...)
+			}
+			rows[key] = cs.ToRowSeries(key)
+		}
+		seq++
+		bw.ack(WriteAck{Batch: seq, Requests: requests, Err: WriteBatch(rows)})
+		batch = make(map[TimeBucketKey][]*ColumnSeries)
+		requests = 0
+	}
+	for {
+		select {
+		case req, ok := <-bw.requests:
+			if !ok {
+				commit()
+				return
+			}
+			// The columns of a bucket's rows must all have the same shapes,
+			// the requests to a bucket are merged once the batch is written
+			if series, ok := batch[req.Key]; ok &&
+				!dataShapesMatch(series[0].GetDataShapes(), req.Data.GetDataShapes()) {
+				commit()
+			}
+			batch[req.Key] = append(batch[req.Key], req.Data)
+			if requests++; requests == 1 {
+				timer.Reset(BulkWriteMaxDelay)
+				deadline = timer.C
+			}
+			if requests >= batchSize {
+				commit()
+			}
+		case <-deadline:
+			deadline = nil
+			commit()
+		}
+	}
+}
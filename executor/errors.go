@@ -1,11 +1,46 @@
 package executor
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/alpacahq/marketstore/planner"
 	"github.com/alpacahq/marketstore/utils/io"
 	. "github.com/alpacahq/marketstore/utils/log"
 )
 
+var (
+	ErrFilePlanExceedsFileSize  = errors.New("file plan length exceeds the size of the file")
+	ErrIncompatibleTimeframe    = errors.New("timeframe can not be produced by downsampling the bucket")
+	ErrDestinationExists        = errors.New("destination bucket already exists")
+	ErrVariableRecordTooLarge   = errors.New("variable record exceeds the maximum record length")
+	ErrYearNotFound             = errors.New("year file not found in the source bucket")
+	ErrNoDataFound              = errors.New("no data found for the query")
+	ErrInvalidFileFormat        = errors.New("file is not a marketstore year file")
+	ErrReadOnly                 = errors.New("instance is read only")
+	ErrNoScanHistory            = errors.New("no reads of the storage device to estimate from")
+	ErrRecordTypeMismatch       = errors.New("record type not the same across target data")
+	ErrZeroEpoch                = errors.New("epoch 0 is reserved for empty records")
+	ErrBucketAlreadyExists      = errors.New("bucket already exists")
+	ErrWritesPaused             = errors.New("timed out waiting for writes to be resumed")
+	ErrAlreadyPaused            = errors.New("writes are already paused")
+	ErrNotPaused                = errors.New("writes are not paused")
+	ErrInvalidVariableRecordLen = errors.New("variable record length must be positive")
+	ErrWrongReplica             = errors.New("query is addressed to another replica")
+	ErrCatalogLocked            = errors.New("catalog is locked by a maintenance operation")
+	ErrViewExists               = errors.New("view is already materialized")
+	ErrViewNotFound             = errors.New("view not found")
+	ErrFileReplaced             = errors.New("file was replaced since the read was planned")
+	ErrNoColdStorage            = errors.New("no cold storage is configured")
+	ErrColdYearFile             = errors.New("year file is in the cold storage")
+	ErrNaNValue                 = errors.New("NaN or infinite value in a float column")
+	ErrNoNearestTarget          = errors.New("nearest query has no target time")
+	ErrYearFileBusy             = errors.New("year file kept being written during the copy")
+)
+
 type RecordLengthNotConsistent string
 
 func (msg RecordLengthNotConsistent) Error() string {
@@ -67,6 +102,54 @@ func (msg ShortReadError) Error() string {
 	return errReport("%s: Unexpectedly short read", string(msg))
 }
 
+// PartialWriteError is returned by WriteBatch when only some of the batches
+// could be written.
+type PartialWriteError struct {
+	Succeeded []io.TimeBucketKey
+	Failed    map[io.TimeBucketKey]error
+}
+
+func (e *PartialWriteError) Error() string {
+	keys := make([]string, 0, len(e.Failed))
+	for key, err := range e.Failed {
+		keys = append(keys, fmt.Sprintf("%s (%v)", key.String(), err))
+	}
+	sort.Strings(keys)
+	return fmt.Sprintf("%d of %d batches failed to write: %s",
+		len(e.Failed), len(e.Failed)+len(e.Succeeded), strings.Join(keys, ", "))
+}
+
+// PartialReadError is returned by Read along with the results of the other
+// keys when some of the keys of a ParseResult with AllowPartialResults set
+// could not be read.
+type PartialReadError struct {
+	Failed map[io.TimeBucketKey]error
+}
+
+func (e *PartialReadError) Error() string {
+	keys := make([]string, 0, len(e.Failed))
+	for key, err := range e.Failed {
+		keys = append(keys, fmt.Sprintf("%s (%v)", key.String(), err))
+	}
+	sort.Strings(keys)
+	return fmt.Sprintf("%d keys failed to read: %s", len(e.Failed), strings.Join(keys, ", "))
+}
+
+// ErrEmptyResultSet is returned by Read when a key has no rows within the
+// range of a ParseResult with RequireNonEmpty set.
+type ErrEmptyResultSet struct {
+	Key   io.TimeBucketKey
+	Range *planner.DateRange
+}
+
+func (e ErrEmptyResultSet) Error() string {
+	if e.Range == nil {
+		return fmt.Sprintf("no rows found for %s", e.Key.String())
+	}
+	return fmt.Sprintf("no rows found for %s between %v and %v", e.Key.String(),
+		time.Unix(e.Range.Start, 0).UTC(), time.Unix(e.Range.End, 0).UTC())
+}
+
 func errReport(base string, msg string) string {
 	base = io.GetCallerFileContext(2) + ":" + base
 	Log(ERROR, base, msg)
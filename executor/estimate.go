@@ -0,0 +1,105 @@
+package executor
+
+import (
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/alpacahq/marketstore/planner"
+	. "github.com/alpacahq/marketstore/utils/io"
+)
+
+// Weight of the latest read in the moving average of the scan rate of a device
+const scanRateAlpha = 0.2
+
+var (
+	scanRateMu sync.Mutex
+	// Exponential moving average of the bytes read per second, by device
+	scanRates = map[uint64]float64{}
+)
+
+// observeScanRate adds a read of n bytes of f that took elapsed to the
+// scan rate of the device holding f
+func observeScanRate(f *os.File, n int64, elapsed time.Duration) {
+	if n <= 0 || elapsed <= 0 {
+		return
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		return
+	}
+	dev, _ := fileID(fi)
+	rate := float64(n) / elapsed.Seconds()
+
+	scanRateMu.Lock()
+	defer scanRateMu.Unlock()
+	if prev, ok := scanRates[dev]; ok {
+		rate = scanRateAlpha*rate + (1-scanRateAlpha)*prev
+	}
+	scanRates[dev] = rate
+}
+
+// EstimateQueryTime estimates how long reading key for the parsed query will
+// take, from the bytes of its IO plan and the scan rates of the devices
+// holding its files. The plan bytes are an upper bound, so the estimate is
+// high for sparse data or limited queries. ErrNoScanHistory is returned until
+// the devices of all of the files have been read from. The query is planned
+// for the estimate, use the EstimateQueryTime of its reader once planned.
+func EstimateQueryTime(key TimeBucketKey, pr *planner.ParseResult) (time.Duration, error) {
+	var sfl SortedFileList
+	for _, qf := range pr.QualifiedFiles {
+		if qf.Key == key {
+			sfl = append(sfl, qf)
+		}
+	}
+	if len(sfl) == 0 {
+		return 0, ErrNoDataFound
+	}
+	sort.Sort(sfl)
+	// Planning sets the range of the query if it has none, on a copy
+	parsed := *pr
+	if parsed.Range == nil {
+		parsed.Range = planner.NewDateRange()
+	}
+	iop, err := NewIOPlan(sfl, &parsed, false)
+	if err != nil {
+		return 0, err
+	}
+	return estimatePlanTime(iop)
+}
+
+// EstimateQueryTime sums the estimates of the plans of the keys of the
+// reader, see the EstimateQueryTime function
+func (r *reader) EstimateQueryTime() (time.Duration, error) {
+	var total time.Duration
+	for _, iop := range r.IOPMap {
+		estimate, err := estimatePlanTime(iop)
+		if err != nil {
+			return 0, err
+		}
+		total += estimate
+	}
+	return total, nil
+}
+
+// estimatePlanTime divides the bytes of each file of iop by the scan rate of
+// its device
+func estimatePlanTime(iop *ioplan) (time.Duration, error) {
+	var seconds float64
+	scanRateMu.Lock()
+	defer scanRateMu.Unlock()
+	for _, fp := range iop.FilePlan {
+		fi, err := os.Stat(fp.FullPath)
+		if err != nil {
+			return 0, err
+		}
+		dev, _ := fileID(fi)
+		rate, ok := scanRates[dev]
+		if !ok {
+			return 0, ErrNoScanHistory
+		}
+		seconds += float64(fp.Length) / rate
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
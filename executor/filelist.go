@@ -0,0 +1,92 @@
+package executor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/alpacahq/marketstore/planner"
+	. "github.com/alpacahq/marketstore/utils/io"
+)
+
+// Version of the binary format of SortedFileList, bumped on every change so
+// that lists cached in an older format are rebuilt rather than misread
+const fileListFormatVersion = 1
+
+var errShortFileList = errors.New("SortedFileList: unexpected end of data")
+
+// MarshalBinary encodes the key, path and year of each file of the list.
+// The headers of the files are not included.
+func (fl SortedFileList) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	var scratch [binary.MaxVarintLen64]byte
+	putString := func(s string) {
+		n := binary.PutUvarint(scratch[:], uint64(len(s)))
+		buf.Write(scratch[:n])
+		buf.WriteString(s)
+	}
+
+	buf.WriteByte(fileListFormatVersion)
+	n := binary.PutUvarint(scratch[:], uint64(len(fl)))
+	buf.Write(scratch[:n])
+	for _, qf := range fl {
+		putString(qf.Key.String())
+		putString(qf.File.Path)
+		binary.LittleEndian.PutUint16(scratch[:2], uint16(qf.File.Year))
+		buf.Write(scratch[:2])
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a list encoded by MarshalBinary. The header of each
+// file is read from disk when it is first needed, as for the catalog.
+func (fl *SortedFileList) UnmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+	getString := func() (string, error) {
+		length, err := binary.ReadUvarint(r)
+		if err != nil || length > uint64(r.Len()) {
+			return "", errShortFileList
+		}
+		s := make([]byte, length)
+		r.Read(s)
+		return string(s), nil
+	}
+
+	version, err := r.ReadByte()
+	if err != nil {
+		return errShortFileList
+	}
+	if version != fileListFormatVersion {
+		return fmt.Errorf("SortedFileList: unknown format version %d", version)
+	}
+	count, err := binary.ReadUvarint(r)
+	// Each file takes at least 4 bytes
+	if err != nil || count > uint64(r.Len())/4 {
+		return errShortFileList
+	}
+	list := make(SortedFileList, 0, count)
+	for i := uint64(0); i < count; i++ {
+		key, err := getString()
+		if err != nil {
+			return err
+		}
+		path, err := getString()
+		if err != nil {
+			return err
+		}
+		var year [2]byte
+		if n, _ := r.Read(year[:]); n != len(year) {
+			return errShortFileList
+		}
+		list = append(list, planner.QualifiedFile{
+			Key: *NewTimeBucketKeyFromString(key),
+			File: &TimeBucketInfo{
+				Year: int16(binary.LittleEndian.Uint16(year[:])),
+				Path: path,
+			},
+		})
+	}
+	*fl = list
+	return nil
+}
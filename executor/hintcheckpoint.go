@@ -0,0 +1,101 @@
+package executor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/alpacahq/marketstore/executor/readhint"
+	"github.com/alpacahq/marketstore/utils"
+	. "github.com/alpacahq/marketstore/utils/io"
+	. "github.com/alpacahq/marketstore/utils/log"
+)
+
+// ReadHintCheckpointName is the file of the root directory the read hints
+// are saved to, so that they survive a restart
+const ReadHintCheckpointName = "readhints.ckpt"
+
+// checkpointReadHints saves the read hints of the files below rootDir
+func checkpointReadHints(rootDir string) error {
+	hints := map[string]readhint.Hint{}
+	for filePath, hint := range readhint.Snapshot() {
+		if rel, err := filepath.Rel(rootDir, filePath); err == nil && !strings.HasPrefix(rel, "..") {
+			hints[rel] = hint
+		}
+	}
+	return readhint.Persist(filepath.Join(rootDir, ReadHintCheckpointName), hints)
+}
+
+// loadReadHints sets the read hints saved by the last checkpoint, returning
+// how many were set. A hint is dropped unless it is at a record of a year
+// file that was not modified since the hint was known, as later writes may
+// have gone past it.
+func loadReadHints(rootDir string) (int, error) {
+	hints, err := readhint.Load(filepath.Join(rootDir, ReadHintCheckpointName))
+	if os.IsNotExist(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	var loaded int
+	for rel, hint := range hints {
+		filePath := filepath.Join(rootDir, rel)
+		if strings.HasPrefix(filepath.Clean(rel), "..") || !isYearFile(filePath) {
+			continue
+		}
+		fi, err := os.Stat(filePath)
+		if err != nil || fi.ModTime().After(hint.SetAt) || hint.Offset >= fi.Size() {
+			continue
+		}
+		tbi, err := NewTimeBucketInfoFromFile(filePath)
+		if err != nil || hint.Offset < Headersize ||
+			(hint.Offset-Headersize)%int64(tbi.GetRecordLength()) != 0 {
+			continue
+		}
+		readhint.SetLastKnownWithTime(filePath, hint.Offset, hint.SetAt)
+		loaded++
+	}
+	return loaded, nil
+}
+
+// checkpointReadHintsEvery saves the read hints at every interval, and once
+// more when the instance shuts down
+func checkpointReadHintsEvery(rootDir string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	tickerCheck := time.NewTicker(100 * time.Millisecond)
+	defer tickerCheck.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-tickerCheck.C:
+			if !ThisInstance.ShutdownPending {
+				continue
+			}
+		}
+		if err := checkpointReadHints(rootDir); err != nil {
+			Log(ERROR, "Failed to checkpoint the read hints - Error: %v", err)
+		}
+		if ThisInstance.ShutdownPending {
+			ThisInstance.WALWg.Done()
+			return
+		}
+	}
+}
+
+// startReadHintCheckpoints loads the read hints of the last checkpoint and
+// begins saving them in the background
+func startReadHintCheckpoints(rootDir string) {
+	loaded, err := loadReadHints(rootDir)
+	if err != nil {
+		Log(ERROR, "Failed to load the read hints - Error: %v", err)
+	} else if loaded > 0 {
+		Log(INFO, "Loaded %d read hints", loaded)
+	}
+	if utils.InstanceConfig.ReadOnly || utils.InstanceConfig.LastKnownCheckpointInterval <= 0 {
+		return
+	}
+	ThisInstance.WALWg.Add(1)
+	go checkpointReadHintsEvery(rootDir, utils.InstanceConfig.LastKnownCheckpointInterval)
+}
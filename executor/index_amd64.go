@@ -0,0 +1,10 @@
+package executor
+
+import "unsafe"
+
+// recordIndex returns the index at the start of the record at pos in buffer,
+// loaded directly as x86-64 is little endian and allows unaligned loads.
+// The caller guarantees the 8 bytes are within the buffer.
+func recordIndex(buffer []byte, pos int64) int64 {
+	return *(*int64)(unsafe.Pointer(&buffer[pos]))
+}
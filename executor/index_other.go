@@ -0,0 +1,11 @@
+//go:build !amd64
+// +build !amd64
+
+package executor
+
+import "encoding/binary"
+
+// recordIndex returns the index at the start of the record at pos in buffer
+func recordIndex(buffer []byte, pos int64) int64 {
+	return int64(binary.LittleEndian.Uint64(buffer[pos:]))
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/alpacahq/marketstore/catalog"
+	"github.com/alpacahq/marketstore/planner"
 	"github.com/alpacahq/marketstore/plugins/trigger"
 	"github.com/alpacahq/marketstore/utils"
 	. "github.com/alpacahq/marketstore/utils/log"
@@ -49,22 +50,40 @@ func NewInstanceSetup(relRootDir string, options ...bool) {
 	if ThisInstance == nil {
 		ThisInstance = new(InstanceMetadata)
 	}
+	if utils.InstanceConfig.UseMmap && !mmapSupported {
+		Log(WARNING, "use_mmap is not supported on this platform, reading the files with system calls")
+		utils.InstanceConfig.UseMmap = false
+	}
 	var err error
 	Log(INFO, "Root Directory: %s", relRootDir)
 	rootDir, err := filepath.Abs(filepath.Clean(relRootDir))
 	if err != nil {
 		Log(ERROR, "Cannot take absolute path of root directory %s", err.Error())
 	}
+	// Maintenance of the files by another process must be done first
+	if err = waitCatalogLock(rootDir); err != nil {
+		Log(FATAL, "Unable to start on %s: %v, remove %s once the operation is over",
+			rootDir, err, filepath.Join(rootDir, CatalogLockName))
+	}
 	ThisInstance.InstanceID = time.Now().UTC().UnixNano()
 	ThisInstance.RootDir = rootDir
 	// Initialize a global catalog
 	if initCatalog {
 		ThisInstance.CatalogDir = catalog.NewDirectory(rootDir)
+		// The replay of the WAL appends to the files of interrupted writes
+		if !utils.InstanceConfig.ReadOnly {
+			recoverYearFiles(ThisInstance.CatalogDir, takeCleanShutdown(rootDir))
+		}
 	}
 	ThisInstance.WALBypass = WALBypass
 	if initWALCache {
 		// Allocate a new WALFile and cache
-		if WALBypass {
+		if utils.InstanceConfig.ReadOnly {
+			// Replaying the WAL or starting a new one would write to the root directory
+			Log(INFO, "Read only instance, the WAL is not replayed")
+			ThisInstance.TXNPipe = NewTransactionPipe()
+			ThisInstance.WALFile = &WALFileType{RootPath: ThisInstance.RootDir}
+		} else if WALBypass {
 			ThisInstance.TXNPipe = NewTransactionPipe()
 			ThisInstance.WALFile = &WALFileType{RootPath: ThisInstance.RootDir}
 		} else {
@@ -73,10 +92,76 @@ func NewInstanceSetup(relRootDir string, options ...bool) {
 				Log(FATAL, "Unable to startup Cache and WAL")
 			}
 		}
-		if backgroundSync {
+		if backgroundSync && !utils.InstanceConfig.ReadOnly {
 			// Startup the WAL and Primary cache flushers
 			go ThisInstance.WALFile.SyncWAL(500*time.Millisecond, 5*time.Minute, utils.InstanceConfig.WALRotateInterval)
 			ThisInstance.WALWg.Add(1)
 		}
 	}
+	// Views are refreshed and files vacuumed or moved to the cold storage once
+	// the WAL has been replayed
+	if initCatalog && initWALCache && !utils.InstanceConfig.ReadOnly {
+		restoreViews(rootDir)
+		if utils.InstanceConfig.VacuumPolicy.Interval > 0 {
+			go vacuumEvery(utils.InstanceConfig.VacuumPolicy)
+		}
+		if utils.InstanceConfig.ColdAfterYears > 0 && coldStorage() != nil {
+			stop := startBackgroundTask()
+			go func() {
+				defer backgroundTasks.Done()
+				promoteColdYearsDaily(utils.InstanceConfig.ColdAfterYears, stop)
+			}()
+		}
+	}
+	// The hints are looked for once the WAL has been replayed to the files.
+	// They are not kept at all without EnableLastKnown, nor checkpointed.
+	if initCatalog && utils.InstanceConfig.EnableLastKnown {
+		startReadHintCheckpoints(rootDir)
+		go warmReadHints(ThisInstance.CatalogDir, utils.InstanceConfig.LastKnownWarmYears)
+	}
+}
+
+var (
+	backgroundMu sync.Mutex
+	// stopBackground is closed to stop the background maintenance of the
+	// instance, the moves to the cold storage
+	stopBackground  = make(chan struct{})
+	backgroundTasks sync.WaitGroup
+)
+
+// startBackgroundTask counts a background maintenance goroutine, which must
+// call backgroundTasks.Done once it returns, and returns the channel closed
+// when it must stop
+func startBackgroundTask() <-chan struct{} {
+	backgroundMu.Lock()
+	defer backgroundMu.Unlock()
+	backgroundTasks.Add(1)
+	return stopBackground
+}
+
+// StopBackgroundTasks stops the background maintenance of the instance and
+// waits for it to return. Tasks started after are stopped by the next call.
+func StopBackgroundTasks() {
+	backgroundMu.Lock()
+	close(stopBackground)
+	stopBackground = make(chan struct{})
+	backgroundMu.Unlock()
+	backgroundTasks.Wait()
+}
+
+// checkReplica returns ErrWrongReplica if the parse result is addressed to
+// another replica than this instance
+func checkReplica(pr *planner.ParseResult) error {
+	if pr.ReplicaID != "" && pr.ReplicaID != utils.InstanceConfig.ReplicaID {
+		return ErrWrongReplica
+	}
+	return nil
+}
+
+// checkWritable returns ErrReadOnly if the instance only serves reads
+func checkWritable() error {
+	if utils.InstanceConfig.ReadOnly {
+		return ErrReadOnly
+	}
+	return nil
 }
@@ -0,0 +1,126 @@
+package executor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/alpacahq/marketstore/utils/log"
+)
+
+// CatalogLockName is the file written to the root directory while a
+// maintenance operation runs, which instances starting up wait for
+const CatalogLockName = "catalog.lock"
+
+// catalogLockPoll is how often a starting instance looks for the lock again
+var catalogLockPoll = time.Second
+
+// CatalogLock is the content of the catalog lock file
+type CatalogLock struct {
+	Operation        string        `json:"operation"`
+	PID              int           `json:"pid"`
+	StartTime        time.Time     `json:"start_time"`
+	ExpectedDuration time.Duration `json:"expected_duration"`
+}
+
+// lockCatalog writes the lock file of the operation to rootDir, or returns
+// ErrCatalogLocked if another operation holds it
+func lockCatalog(rootDir, operation string, expected time.Duration) error {
+	data, err := json.Marshal(CatalogLock{
+		Operation:        operation,
+		PID:              os.Getpid(),
+		StartTime:        time.Now().UTC(),
+		ExpectedDuration: expected,
+	})
+	if err != nil {
+		return err
+	}
+	fp, err := os.OpenFile(filepath.Join(rootDir, CatalogLockName), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if os.IsExist(err) {
+		return ErrCatalogLocked
+	} else if err != nil {
+		return err
+	}
+	defer fp.Close()
+	_, err = fp.Write(data)
+	return err
+}
+
+// unlockCatalog removes the lock file from rootDir
+func unlockCatalog(rootDir string) error {
+	err := os.Remove(filepath.Join(rootDir, CatalogLockName))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// readCatalogLock returns the lock held on rootDir, nil if there is none
+func readCatalogLock(rootDir string) (*CatalogLock, error) {
+	data, err := ioutil.ReadFile(filepath.Join(rootDir, CatalogLockName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	lock := new(CatalogLock)
+	if err = json.Unmarshal(data, lock); err != nil {
+		return nil, err
+	}
+	return lock, nil
+}
+
+// heldCatalogLock returns the lock held on rootDir like readCatalogLock,
+// except that a lock which can not be parsed or whose process is no longer
+// running is stale, and is removed with a warning instead of returned. It is
+// called on startup, when a lock of this very process was left by an earlier
+// run that had the same PID, e.g. PID 1 in a container.
+func heldCatalogLock(rootDir string) (*CatalogLock, error) {
+	lock, err := readCatalogLock(rootDir)
+	var stale string
+	switch err.(type) {
+	case nil:
+		if lock == nil {
+			return nil, nil
+		}
+		if lock.PID <= 0 || !processRunning(lock.PID) {
+			stale = fmt.Sprintf("process %d of the %s is no longer running", lock.PID, lock.Operation)
+		} else if lock.PID == os.Getpid() {
+			stale = fmt.Sprintf("the %s was left by an earlier run as process %d", lock.Operation, lock.PID)
+		}
+	case *json.SyntaxError, *json.UnmarshalTypeError:
+		stale = fmt.Sprintf("it can not be parsed: %v", err)
+	default:
+		return nil, err
+	}
+	if stale == "" {
+		return lock, nil
+	}
+	Log(WARNING, "Removing the stale %s of %s, %s", CatalogLockName, rootDir, stale)
+	return nil, unlockCatalog(rootDir)
+}
+
+// waitCatalogLock waits for the lock held on rootDir to be released, for
+// as long as its operation is expected to last. It returns ErrCatalogLocked
+// if the lock is still held after that. Stale locks are removed.
+func waitCatalogLock(rootDir string) error {
+	lock, err := heldCatalogLock(rootDir)
+	if err != nil || lock == nil {
+		return err
+	}
+	Log(INFO, "Waiting for the %s started at %v by process %d to release %s",
+		lock.Operation, lock.StartTime, lock.PID, CatalogLockName)
+	deadline := lock.StartTime.Add(lock.ExpectedDuration)
+	for {
+		if lock, err = heldCatalogLock(rootDir); err != nil || lock == nil {
+			return err
+		}
+		if !time.Now().Before(deadline) {
+			return ErrCatalogLocked
+		}
+		time.Sleep(catalogLockPoll)
+	}
+}
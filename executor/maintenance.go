@@ -0,0 +1,572 @@
+package executor
+
+import (
+	"encoding/binary"
+	"fmt"
+	stdio "io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/alpacahq/marketstore/executor/readhint"
+	"github.com/alpacahq/marketstore/planner"
+	"github.com/alpacahq/marketstore/utils"
+	. "github.com/alpacahq/marketstore/utils/io"
+	. "github.com/alpacahq/marketstore/utils/log"
+)
+
+// TruncateAfter removes every record of the bucket whose time is later than
+// epoch by zeroing its slot, and returns the number of records removed.
+// The zeroing is sent through the transaction pipe like any other write so
+// it is logged in the WAL unless the instance bypasses it. Slots keep their
+// positions, so later writes to the current year still land where expected.
+// Only FIXED record types are supported.
+func TruncateAfter(key TimeBucketKey, epoch int64) (int64, error) {
+	if err := checkWritable(); err != nil {
+		return 0, err
+	}
+	if err := beginWrite(); err != nil {
+		return 0, err
+	}
+	defer endWrite()
+	tbis, err := getTimeBucketInfos(&key)
+	if err != nil {
+		return 0, err
+	}
+	if len(tbis) > 0 && tbis[0].GetRecordType() != FIXED {
+		return 0, fmt.Errorf("TruncateAfter: only supported for fixed records")
+	}
+
+	flushQueuedWrites()
+
+	t := ToSystemTimezone(time.Unix(epoch, 0))
+	var count int64
+	for _, tbi := range tbis {
+		if tbi.Year < int16(t.Year()) {
+			continue
+		}
+		startOffset := int64(Headersize)
+		if tbi.Year == int16(t.Year()) {
+			// Start with the slot following the one that holds epoch
+			startOffset = EpochToOffset(epoch, tbi.GetTimeframe(), tbi.GetRecordLength()) +
+				int64(tbi.GetRecordLength())
+		}
+		n, err := zeroRecordsFrom(tbi, startOffset)
+		count += n
+		if err != nil {
+			return count, err
+		}
+	}
+	// The zeroed records are on disk once this returns
+	ThisInstance.WALFile.WaitFlush()
+	return count, nil
+}
+
+// zeroRecordsFrom queues writes that null out every non-null record of the
+// year file from offset onward, coalescing adjacent records into one write.
+func zeroRecordsFrom(tbi *TimeBucketInfo, offset int64) (count int64, err error) {
+	f, err := os.OpenFile(tbi.Path, os.O_RDONLY, 0666)
+	if err != nil {
+		Log(ERROR, "Truncate: opening %s\n%s", tbi.Path, err)
+		return 0, err
+	}
+	defer f.Close()
+
+	recordLen := int64(tbi.GetRecordLength())
+	walKeyPath := ThisInstance.WALFile.FullPathToWALKey(tbi.Path)
+	var runStart, runLen int64
+	flushRun := func() {
+		if runLen == 0 {
+			return
+		}
+		ThisInstance.TXNPipe.writeChannel <- &WriteCommand{
+			RecordType: FIXED,
+			WALKeyPath: walKeyPath,
+			Offset:     runStart,
+			Index:      0,
+			// The index field written at Offset is zero, so the payload covers the rest of the run
+			Data: make([]byte, runLen*recordLen-8),
+		}
+		count += runLen
+		runLen = 0
+	}
+
+	buffer := make([]byte, RecordsPerRead*recordLen)
+	for {
+		n, readErr := f.ReadAt(buffer, offset)
+		numRecords := int64(n) / recordLen
+		for i := int64(0); i < numRecords; i++ {
+			if binary.LittleEndian.Uint64(buffer[i*recordLen:]) == 0 {
+				flushRun()
+				continue
+			}
+			if runLen == 0 {
+				runStart = offset + i*recordLen
+			}
+			runLen++
+		}
+		offset += numRecords * recordLen
+		if readErr == stdio.EOF || numRecords == 0 {
+			break
+		} else if readErr != nil {
+			Log(ERROR, "Truncate: reading data from %s\n%s", tbi.Path, readErr)
+			flushRun()
+			return count, readErr
+		}
+	}
+	flushRun()
+	return count, nil
+}
+
+// ReIndex rebuilds the index field of the records of the bucket's year file,
+// for recovery from a corrupted index whose data columns are intact. Every
+// slot holds the record of a fixed interval after the base time of the file,
+// so the index of a record follows from its position. Records with any non
+// zero data get the index of their slot, and records whose data is all zero
+// keep their index only if it is that of their slot, as they can not be told
+// apart from NULL records otherwise. The indexes are rewritten in place,
+// bypassing the WAL, and the number of records changed is returned.
+// Only FIXED record types are supported.
+func ReIndex(key TimeBucketKey, year int16) (int64, error) {
+	if err := checkWritable(); err != nil {
+		return 0, err
+	}
+	if err := beginWrite(); err != nil {
+		return 0, err
+	}
+	defer endWrite()
+	tbi, err := getTimeBucketInfoForYear(&key, year)
+	if err != nil {
+		return 0, err
+	}
+	if tbi.GetRecordType() != FIXED {
+		return 0, fmt.Errorf("ReIndex: only supported for fixed records")
+	}
+
+	flushQueuedWrites()
+
+	rewrite := newFileRewrite(tbi.Path)
+	if err := rewrite.begin(); err != nil {
+		Log(ERROR, "ReIndex: removing the stats of %s\n%s", tbi.Path, err)
+		return 0, err
+	}
+	defer rewrite.save()
+	f, err := os.OpenFile(tbi.Path, os.O_RDWR, 0666)
+	if err != nil {
+		Log(ERROR, "ReIndex: opening %s\n%s", tbi.Path, err)
+		return 0, err
+	}
+	defer f.Close()
+
+	recordLen := int64(tbi.GetRecordLength())
+	buffer := make([]byte, RecordsPerRead*recordLen)
+	var count int64
+	offset := int64(Headersize)
+	for {
+		n, readErr := f.ReadAt(buffer, offset)
+		numRecords := int64(n) / recordLen
+		for i := int64(0); i < numRecords; i++ {
+			record := buffer[i*recordLen : (i+1)*recordLen]
+			index := binary.LittleEndian.Uint64(record)
+			expected := uint64((offset-Headersize)/recordLen + i + 1)
+			dataIsZero := isZero(record[8:])
+			if index == expected || (index == 0 && dataIsZero) {
+				continue
+			}
+			if dataIsZero {
+				// Most likely a NULL slot with a corrupted index
+				expected = 0
+			}
+			binary.LittleEndian.PutUint64(record, expected)
+			if _, err := f.WriteAt(record[:8], offset+i*recordLen); err != nil {
+				Log(ERROR, "ReIndex: writing %s\n%s", tbi.Path, err)
+				return count, err
+			}
+			count++
+		}
+		offset += numRecords * recordLen
+		if readErr == stdio.EOF || numRecords == 0 {
+			break
+		} else if readErr != nil {
+			Log(ERROR, "ReIndex: reading data from %s\n%s", tbi.Path, readErr)
+			return count, readErr
+		}
+	}
+	if count > 0 {
+		if err := fdatasync(f); err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
+func isZero(b []byte) bool {
+	for _, v := range b {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Resize moves the bucket at key to newTimeframe, e.g. from 1Min to 5Min.
+// The records are aggregated into candles of the new timeframe using OHLCV
+// semantics: Open takes the first value, High the maximum, Low the minimum,
+// Volume the sum and every other column, including Close, the last value.
+// Each year file of the new bucket is built aside and renamed into place, so
+// readers never see a partially written year. The bucket at key is removed
+// once the new one is complete. ErrIncompatibleTimeframe is returned when
+// newTimeframe can not be built from whole candles of the current timeframe.
+// Only FIXED record types are supported, and buckets with year files in the
+// cold storage are refused with ErrColdYearFile.
+func Resize(key TimeBucketKey, newTimeframe utils.Timeframe) error {
+	if err := checkWritable(); err != nil {
+		return err
+	}
+	if err := beginWrite(); err != nil {
+		return err
+	}
+	defer endWrite()
+	tf, err := key.GetTimeFrame()
+	if err != nil {
+		return err
+	}
+	if newTimeframe.Duration < tf.Duration ||
+		newTimeframe.Duration%tf.Duration != 0 || newTimeframe.Duration > utils.Day {
+		return ErrIncompatibleTimeframe
+	}
+	newKey := key
+	newKey.SetItemInCategory("Timeframe", newTimeframe.String)
+	if newKey == key {
+		return nil
+	}
+
+	tbis, err := getTimeBucketInfos(&key)
+	if err != nil {
+		return err
+	}
+	if len(tbis) == 0 {
+		return fmt.Errorf("Resize: no data files found for %s", key.String())
+	}
+	if tbis[0].GetRecordType() != FIXED {
+		return fmt.Errorf("Resize: only supported for fixed records")
+	}
+	// Removing the bucket would leave the cold copies behind
+	for _, tbi := range tbis {
+		if tbi.GetStorageTier() == ColdTier {
+			return fmt.Errorf("Resize: %s: %v", tbi.Path, ErrColdYearFile)
+		}
+	}
+	cDir := ThisInstance.CatalogDir
+	if _, err := cDir.GetLatestTimeBucketInfoFromKey(&newKey); err == nil {
+		return fmt.Errorf("Resize: destination %s already exists", newKey.String())
+	}
+
+	flushQueuedWrites()
+
+	var newTbi *TimeBucketInfo
+	for _, tbi := range tbis {
+		if newTbi == nil {
+			newTbi = NewTimeBucketInfo(
+				newTimeframe,
+				newKey.GetPathToYearFiles(cDir.GetPath()),
+				"Created By Resize", tbi.Year,
+				tbi.GetDataShapes(), FIXED)
+			if err = cDir.AddTimeBucket(&newKey, newTbi); err != nil {
+				return err
+			}
+		} else if newTbi, err = cDir.GetSubDirectoryAndAddFile(newTbi.Path, tbi.Year); err != nil {
+			return err
+		}
+
+		cs, err := readYear(key, tbi.Year)
+		if err != nil {
+			return err
+		}
+		if cs == nil || cs.Len() == 0 {
+			continue
+		}
+		cs = aggregateOHLCV(cs, tbi.GetDataShapes(), newTimeframe.Duration, tbi.Year)
+		if err = replaceYearFile(newTbi, cs.ToRowSeries(newKey)); err != nil {
+			return err
+		}
+	}
+	return cDir.RemoveTimeBucket(&key)
+}
+
+// readYear returns every record of the bucket's year file
+func readYear(key TimeBucketKey, year int16) (*ColumnSeries, error) {
+	t0 := time.Date(int(year), time.January, 1, 0, 0, 0, 0, utils.InstanceConfig.Timezone)
+	q := planner.NewQuery(ThisInstance.CatalogDir)
+	q.AddTargetKey(&key)
+	q.SetRange(t0.Unix(), t0.AddDate(1, 0, 0).Unix()-1)
+	pr, err := q.Parse()
+	if err != nil {
+		return nil, err
+	}
+	r, err := NewReader(pr)
+	if err != nil {
+		return nil, err
+	}
+	csm, _, err := r.Read()
+	if err != nil {
+		return nil, err
+	}
+	return csm[key], nil
+}
+
+// aggregateOHLCV groups the records of cs by the slot they fall in at timeframe
+// tf, the candle's time being the start of the slot.
+func aggregateOHLCV(cs *ColumnSeries, dsv []DataShape, tf time.Duration, year int16) *ColumnSeries {
+	var (
+		bounds []int
+		epochs []int64
+	)
+	prevIndex := int64(-1)
+	for i, epoch := range cs.GetEpoch() {
+		if index := EpochToIndex(epoch, tf); index != prevIndex {
+			bounds = append(bounds, i)
+			epochs = append(epochs, IndexToTime(index, tf, year).Unix())
+			prevIndex = index
+		}
+	}
+	bounds = append(bounds, cs.Len())
+
+	out := NewColumnSeries()
+	out.AddColumn("Epoch", epochs)
+	for _, ds := range dsv {
+		out.AddColumn(ds.Name, aggregateColumn(ds.Name, cs.GetColumn(ds.Name), bounds))
+	}
+	return out
+}
+
+// aggregateColumn reduces each group [bounds[i], bounds[i+1]) of col to a single value
+func aggregateColumn(name string, col interface{}, bounds []int) interface{} {
+	in := reflect.ValueOf(col)
+	out := reflect.MakeSlice(in.Type(), len(bounds)-1, len(bounds)-1)
+	for i := 0; i < len(bounds)-1; i++ {
+		start, end := bounds[i], bounds[i+1]
+		pick := end - 1
+		switch name {
+		case "Open":
+			pick = start
+		case "High":
+			for j := start; j < end; j++ {
+				if less(in.Index(pick), in.Index(j)) {
+					pick = j
+				}
+			}
+		case "Low":
+			for j := start; j < end; j++ {
+				if less(in.Index(j), in.Index(pick)) {
+					pick = j
+				}
+			}
+		case "Volume":
+			if sum(out.Index(i), in.Slice(start, end)) {
+				continue
+			}
+		}
+		out.Index(i).Set(in.Index(pick))
+	}
+	return out.Interface()
+}
+
+func less(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	}
+	return false
+}
+
+// sum stores the sum of values in dst, it returns false for non numeric types
+func sum(dst, values reflect.Value) bool {
+	switch dst.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var total int64
+		for i := 0; i < values.Len(); i++ {
+			total += values.Index(i).Int()
+		}
+		dst.SetInt(total)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var total uint64
+		for i := 0; i < values.Len(); i++ {
+			total += values.Index(i).Uint()
+		}
+		dst.SetUint(total)
+	case reflect.Float32, reflect.Float64:
+		var total float64
+		for i := 0; i < values.Len(); i++ {
+			total += values.Index(i).Float()
+		}
+		dst.SetFloat(total)
+	default:
+		return false
+	}
+	return true
+}
+
+// replaceYearFile writes the rows of rs to a new copy of the year file and
+// renames it over the original.
+func replaceYearFile(tbi *TimeBucketInfo, rs *RowSeries) error {
+	tmpPath := tbi.Path + ".resize"
+	fp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0600)
+	if err != nil {
+		Log(ERROR, "Resize: creating %s\n%s", tmpPath, err)
+		return err
+	}
+	err = func() error {
+		defer fp.Close()
+		if err := WriteHeader(fp, tbi); err != nil {
+			return err
+		}
+		if err := fp.Truncate(FileSize(tbi.GetTimeframe(), int(tbi.Year), int(tbi.GetRecordLength()))); err != nil {
+			return err
+		}
+		data := rs.GetData()
+		recordLen := len(data) / rs.GetNumRows()
+		for i, t := range rs.GetTime() {
+			// Records carry their epoch in the first 8 bytes, on disk this is the index
+			index := TimeToIndex(t, tbi.GetTimeframe())
+			record := data[i*recordLen : (i+1)*recordLen]
+			binary.LittleEndian.PutUint64(record, uint64(index))
+			if _, err := fp.WriteAt(record, IndexToOffset(index, tbi.GetRecordLength())); err != nil {
+				return err
+			}
+		}
+		return fp.Sync()
+	}()
+	if err != nil {
+		Log(ERROR, "Resize: writing %s\n%s", tmpPath, err)
+		os.Remove(tmpPath)
+		return err
+	}
+	rewrite := newFileRewrite(tbi.Path)
+	if err := rewrite.begin(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	defer rewrite.save()
+	return os.Rename(tmpPath, tbi.Path)
+}
+
+// RenameSymbol moves the bucket at oldKey to newKey, e.g. after a ticker
+// change, by renaming its directory. The read hints, the catalog entries of
+// the old path and the copies of its cold year files are moved along. ErrDestinationExists is returned if there is
+// already a bucket at newKey, merging into it is not supported.
+func RenameSymbol(oldKey, newKey TimeBucketKey) error {
+	if err := checkWritable(); err != nil {
+		return err
+	}
+	if err := beginWrite(); err != nil {
+		return err
+	}
+	defer endWrite()
+	cDir := ThisInstance.CatalogDir
+	oldPath := oldKey.GetPathToYearFiles(cDir.GetPath())
+	newPath := newKey.GetPathToYearFiles(cDir.GetPath())
+	if _, err := os.Stat(newPath); err == nil {
+		return ErrDestinationExists
+	}
+
+	flushQueuedWrites()
+	tbis, err := getTimeBucketInfos(&oldKey)
+	if err != nil {
+		return err
+	}
+	// The cold copies are found by the path of their year file
+	undo, err := moveColdYearFiles(tbis, newPath)
+	if err != nil {
+		return err
+	}
+	if err := cDir.RenameTimeBucket(&oldKey, &newKey); err != nil {
+		undo()
+		return err
+	}
+	readhint.MoveLastKnown(oldPath, newPath)
+	return nil
+}
+
+// CopyBucket copies the year files of src for the given years to the bucket
+// at dst, creating and registering dst in the catalog if needed. Files are
+// hard linked when both buckets are on the same filesystem, in which case the
+// copies share their data with src, so it is meant for years that are no
+// longer written to, e.g. to move past years to cold storage. ErrYearNotFound
+// is returned if src has no file for one of the years.
+func CopyBucket(src, dst TimeBucketKey, years []int16) error {
+	if err := checkWritable(); err != nil {
+		return err
+	}
+	if err := beginWrite(); err != nil {
+		return err
+	}
+	defer endWrite()
+	srcTbis, err := getTimeBucketInfos(&src)
+	if err != nil {
+		return err
+	}
+	srcFiles := make(map[int16]*TimeBucketInfo, len(srcTbis))
+	for _, tbi := range srcTbis {
+		srcFiles[tbi.Year] = tbi
+	}
+	for _, year := range years {
+		if _, ok := srcFiles[year]; !ok {
+			return ErrYearNotFound
+		}
+	}
+	if dstTbis, err := getTimeBucketInfos(&dst); err == nil && len(dstTbis) > 0 {
+		have, want := dstTbis[0], srcTbis[0]
+		if have.GetTimeframe() != want.GetTimeframe() || have.GetRecordType() != want.GetRecordType() ||
+			!planner.ElementsEqual(have.GetElementTypes(), want.GetElementTypes()) {
+			return fmt.Errorf("CopyBucket: %s does not have the data shape of %s", dst.String(), src.String())
+		}
+	}
+
+	flushQueuedWrites()
+	return ThisInstance.CatalogDir.AddTimeBucketFiles(&dst, func(dir string) error {
+		for _, year := range years {
+			dstPath := filepath.Join(dir, strconv.Itoa(int(year))+".bin")
+			if _, err := os.Stat(dstPath); err == nil {
+				return ErrDestinationExists
+			}
+			if err := linkOrCopyFile(srcFiles[year].Path, dstPath); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// linkOrCopyFile hard links src to dst, or copies it through a temporary
+// file when they can not be linked, e.g. across filesystems.
+func linkOrCopyFile(src, dst string) error {
+	if err := os.Link(src, dst); err == nil {
+		return nil
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	tmp, err := ioutil.TempFile(filepath.Dir(dst), ".copy")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+	if _, err = stdio.Copy(tmp, in); err != nil {
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), dst)
+}
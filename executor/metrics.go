@@ -0,0 +1,44 @@
+package executor
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/alpacahq/marketstore/utils/io"
+)
+
+var (
+	bucketWritesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "marketstore_bucket_writes_total",
+		Help: "Number of successful writes to the bucket",
+	}, []string{"key"})
+	bucketLastWriteEpoch = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "marketstore_bucket_last_write_epoch",
+		Help: "Latest record epoch of the last successful write to the bucket",
+	}, []string{"key"})
+	registerMetrics sync.Once
+)
+
+// recordBucketWrite updates the write metrics of the bucket at key after a
+// successful write whose latest record is at lastEpoch. The series of a
+// bucket are only created by its first write, so idle buckets of the catalog
+// are not exported.
+func recordBucketWrite(key io.TimeBucketKey, lastEpoch int64) {
+	registerMetrics.Do(func() {
+		prometheus.MustRegister(bucketWritesTotal, bucketLastWriteEpoch)
+	})
+	label := key.GetItemKey()
+	bucketWritesTotal.WithLabelValues(label).Inc()
+	bucketLastWriteEpoch.WithLabelValues(label).Set(float64(lastEpoch))
+}
+
+// latestEpoch returns the latest of the record epochs of a write
+func latestEpoch(epochs []int64) (latest int64) {
+	for _, epoch := range epochs {
+		if epoch > latest {
+			latest = epoch
+		}
+	}
+	return latest
+}
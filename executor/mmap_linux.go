@@ -0,0 +1,54 @@
+package executor
+
+import (
+	"io"
+	"os"
+	"syscall"
+)
+
+// mmapSupported tells that year files can be mapped on this platform
+const mmapSupported = true
+
+// mmapFile is a year file mapped in memory, read without a system call for
+// each buffer of records
+type mmapFile struct {
+	data []byte
+}
+
+// mmapYearFile maps the whole of f in memory for reading. The mapping must
+// be released with Close, and stays valid once f is closed.
+func mmapYearFile(f *os.File) (*mmapFile, error) {
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if fi.Size() == 0 {
+		return &mmapFile{}, nil
+	}
+	data, err := syscall.Mmap(int(f.Fd()), 0, int(fi.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, err
+	}
+	return &mmapFile{data: data}, nil
+}
+
+// ReadAt copies the mapped bytes at off to p, as os.File.ReadAt reads them
+func (m *mmapFile) ReadAt(p []byte, off int64) (int, error) {
+	if off >= int64(len(m.data)) {
+		return 0, io.EOF
+	}
+	n := copy(p, m.data[off:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (m *mmapFile) Close() error {
+	if m.data == nil {
+		return nil
+	}
+	err := syscall.Munmap(m.data)
+	m.data = nil
+	return err
+}
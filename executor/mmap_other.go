@@ -0,0 +1,28 @@
+//go:build !linux
+// +build !linux
+
+package executor
+
+import (
+	"errors"
+	"os"
+)
+
+// mmapSupported is false as the files can't be mapped on this platform,
+// they are read with system calls whatever use_mmap says
+const mmapSupported = false
+
+// mmapFile is not supported on this platform, the files are always read
+type mmapFile struct{}
+
+func mmapYearFile(f *os.File) (*mmapFile, error) {
+	return nil, errors.New("memory mapped reads are not supported on this platform")
+}
+
+func (m *mmapFile) ReadAt(p []byte, off int64) (int, error) {
+	return 0, errors.New("memory mapped reads are not supported on this platform")
+}
+
+func (m *mmapFile) Close() error {
+	return nil
+}
@@ -0,0 +1,89 @@
+package executor
+
+import (
+	"fmt"
+
+	"github.com/alpacahq/marketstore/planner"
+	. "github.com/alpacahq/marketstore/utils/io"
+)
+
+// Cursor is the position of a PagedReader after a page
+type Cursor struct {
+	// Epoch of the last record read from each bucket with records left
+	LastEpochs map[TimeBucketKey]int64
+}
+
+// PagedReader reads the records of a query forward in pages. The IO plans
+// are built once, each page is read from the plans cut after the records of
+// the previous one.
+type PagedReader struct {
+	r        *reader
+	pageSize int32
+}
+
+// NewPagedReader returns a reader of pages of at most pageSize records of
+// each bucket of pr. The row limit of pr is replaced by the page size, so
+// only forward reads are supported.
+func NewPagedReader(pr *planner.ParseResult, pageSize int) (*PagedReader, error) {
+	if pageSize <= 0 {
+		return nil, fmt.Errorf("NewPagedReader: page size must be positive")
+	}
+	if pr.Limit != nil && (pr.Limit.Direction == LAST || pr.Limit.Direction == NEAREST) {
+		return nil, fmt.Errorf("NewPagedReader: only forward reads can be paged")
+	}
+	r, err := NewReader(pr)
+	if err != nil {
+		return nil, err
+	}
+	// The limit may be shared by the plans
+	for _, iop := range r.IOPMap {
+		iop.Limit = &planner.RowLimit{Number: int32(pageSize), Direction: FIRST}
+	}
+	return &PagedReader{r: r, pageSize: int32(pageSize)}, nil
+}
+
+// Read returns the page following cursor, the first page if cursor is nil,
+// along with the cursor of the next page. The cursor is nil once every
+// record has been read, the last page may then be empty. The buckets whose
+// read failed are left out of the next pages.
+func (p *PagedReader) Read(cursor *Cursor) (ColumnSeriesMap, *Cursor, error) {
+	page := p.r.clone()
+	page.IOPMap = make(map[TimeBucketKey]*ioplan, len(p.r.IOPMap))
+	for key, iop := range p.r.IOPMap {
+		if cursor == nil {
+			page.IOPMap[key] = iop.Clone()
+			continue
+		}
+		lastEpoch, ok := cursor.LastEpochs[key]
+		if !ok {
+			continue
+		}
+		next, err := iop.NextPageIOP(lastEpoch)
+		if err != nil {
+			return nil, nil, err
+		}
+		page.IOPMap[key] = next.Clone()
+	}
+	// Only the first page can be required not to be empty
+	page.pr.RequireNonEmpty = cursor == nil && p.r.pr.RequireNonEmpty
+
+	csm, _, err := page.Read()
+	p.r.stats.add(page.stats)
+	if csm == nil {
+		return nil, nil, err
+	}
+	next := &Cursor{LastEpochs: make(map[TimeBucketKey]int64, len(csm))}
+	for key, cs := range csm {
+		iop, ok := page.IOPMap[key]
+		// Pages of VARIABLE records hold the rows of pageSize intervals
+		if !ok || cs.Len() == 0 || (iop.RecordType == FIXED && cs.Len() < int(p.pageSize)) {
+			continue
+		}
+		epochs := cs.GetEpoch()
+		next.LastEpochs[key] = epochs[len(epochs)-1]
+	}
+	if len(next.LastEpochs) == 0 {
+		next = nil
+	}
+	return csm, next, err
+}
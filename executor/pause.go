@@ -0,0 +1,84 @@
+package executor
+
+import (
+	"context"
+	"sync"
+
+	"github.com/alpacahq/marketstore/utils"
+)
+
+// Writes are paused by closing the gate. The in-flight writes hold the read
+// lock of inFlight, which Pause takes to wait for them to finish.
+var (
+	gateMu   sync.Mutex
+	resumed  chan struct{} // closed by Resume, nil while writes are not paused
+	inFlight sync.RWMutex
+)
+
+// Pause halts every write to the database, e.g. to take a consistent snapshot
+// of the year files. It returns once the writes in progress are done and the
+// WAL is flushed to the files. Writes made while paused block until Resume is
+// called, or return ErrWritesPaused after the configured paused_write_timeout.
+// Reads are not affected. The catalog lock is held until Resume so that no
+// other instance starts on the files in the meantime.
+func Pause() error {
+	if err := checkWritable(); err != nil {
+		return err
+	}
+	gateMu.Lock()
+	if resumed != nil {
+		gateMu.Unlock()
+		return ErrAlreadyPaused
+	}
+	err := lockCatalog(ThisInstance.RootDir, "pause", utils.InstanceConfig.PausedWriteTimeout)
+	if err != nil {
+		gateMu.Unlock()
+		return err
+	}
+	resumed = make(chan struct{})
+	gateMu.Unlock()
+
+	inFlight.Lock()
+	inFlight.Unlock()
+	ThisInstance.WALFile.WaitFlush()
+	return nil
+}
+
+// Resume lets the writes blocked by Pause proceed
+func Resume() error {
+	gateMu.Lock()
+	defer gateMu.Unlock()
+	if resumed == nil {
+		return ErrNotPaused
+	}
+	close(resumed)
+	resumed = nil
+	return unlockCatalog(ThisInstance.RootDir)
+}
+
+// beginWrite waits for writes to be resumed if they are paused, and must be
+// followed by endWrite once the write is done
+func beginWrite() error {
+	ctx, cancel := context.WithTimeout(context.Background(), utils.InstanceConfig.PausedWriteTimeout)
+	defer cancel()
+	for {
+		gateMu.Lock()
+		wait := resumed
+		if wait == nil {
+			inFlight.RLock()
+			gateMu.Unlock()
+			return nil
+		}
+		gateMu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return ErrWritesPaused
+		}
+	}
+}
+
+func endWrite() {
+	inFlight.RUnlock()
+}
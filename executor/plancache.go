@@ -0,0 +1,153 @@
+package executor
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/alpacahq/marketstore/planner"
+)
+
+// PlanStore keeps the encoded file lists of query plans by name. The default
+// store is in memory, a shared one e.g. on Redis or a bolt DB can be set with
+// SetPlanStore.
+type PlanStore interface {
+	Get(name string) ([]byte, bool)
+	Put(name string, data []byte)
+	Delete(name string)
+}
+
+// Number of file lists kept by the default store, past which an arbitrary
+// one is dropped for each new one
+const memPlanStoreSize = 10000
+
+type memPlanStore struct {
+	sync.Mutex
+	lists map[string][]byte
+}
+
+func newMemPlanStore() *memPlanStore {
+	return &memPlanStore{lists: map[string][]byte{}}
+}
+
+func (s *memPlanStore) Get(name string) ([]byte, bool) {
+	s.Lock()
+	defer s.Unlock()
+	data, ok := s.lists[name]
+	return data, ok
+}
+
+func (s *memPlanStore) Put(name string, data []byte) {
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.lists[name]; !ok && len(s.lists) >= memPlanStoreSize {
+		for dropped := range s.lists {
+			delete(s.lists, dropped)
+			break
+		}
+	}
+	s.lists[name] = data
+}
+
+func (s *memPlanStore) Delete(name string) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.lists, name)
+}
+
+// planCache keeps, by key and date range, the year files the stats of which
+// say they hold records in the range, so that planning the same query again
+// doesn't read the stats of every file. The lists of a key are dropped when
+// any of its files is written.
+type planCache struct {
+	mu    sync.Mutex
+	store PlanStore
+	// The names in the store of the lists of each key directory
+	names map[string]map[string]bool
+	// Bumped by each write to a key directory, so that lists planned from
+	// stats read before the write are not stored after it
+	generations map[string]uint64
+	// Names are unique to the process, a shared store may hold lists of
+	// files written since by another
+	prefix string
+}
+
+var plans = newPlanCache(newMemPlanStore())
+
+func newPlanCache(store PlanStore) *planCache {
+	return &planCache{
+		store:       store,
+		names:       map[string]map[string]bool{},
+		generations: map[string]uint64{},
+		prefix:      fmt.Sprintf("%x", time.Now().UnixNano()),
+	}
+}
+
+// SetPlanStore replaces the store of the file lists of query plans, the
+// lists of the previous store are not used anymore
+func SetPlanStore(store PlanStore) {
+	plans.mu.Lock()
+	defer plans.mu.Unlock()
+	plans.store = store
+	plans.names = map[string]map[string]bool{}
+}
+
+// planName returns the name of the list of the key directory dir for dr
+func (c *planCache) planName(dir string, dr *planner.DateRange) string {
+	var buf [16]byte
+	binary.LittleEndian.PutUint64(buf[:8], uint64(dr.Start))
+	binary.LittleEndian.PutUint64(buf[8:], uint64(dr.End))
+	hasher := fnv.New64a()
+	hasher.Write(buf[:])
+	return fmt.Sprintf("%s:%s:%x", c.prefix, dir, hasher.Sum64())
+}
+
+// get returns the cached files of the key directory dir holding records in
+// dr, and the generation to store a new list with if there are none
+func (c *planCache) get(dir string, dr *planner.DateRange) (fl SortedFileList, ok bool, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	name := c.planName(dir, dr)
+	if c.names[dir][name] {
+		if data, found := c.store.Get(name); found && fl.UnmarshalBinary(data) == nil {
+			return fl, true, 0
+		}
+		delete(c.names[dir], name)
+	}
+	return nil, false, c.generations[dir]
+}
+
+// put stores the files of the key directory dir holding records in dr,
+// unless the directory was written since generation
+func (c *planCache) put(dir string, dr *planner.DateRange, generation uint64, fl SortedFileList) {
+	data, err := fl.MarshalBinary()
+	if err != nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generations[dir] != generation {
+		return
+	}
+	name := c.planName(dir, dr)
+	if c.names[dir] == nil {
+		c.names[dir] = map[string]bool{}
+	}
+	c.names[dir][name] = true
+	c.store.Put(name, data)
+}
+
+// invalidate drops the lists of the key of the year file at path
+func (c *planCache) invalidate(path string) {
+	dir := filepath.Dir(path)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generations[dir]++
+	for name := range c.names[dir] {
+		c.store.Delete(name)
+	}
+	delete(c.names, dir)
+}
@@ -0,0 +1,86 @@
+package executor
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alpacahq/marketstore/planner"
+	"github.com/alpacahq/marketstore/utils"
+	. "github.com/alpacahq/marketstore/utils/io"
+)
+
+// MarshalText writes a line of tab separated fields for each file plan, e.g.
+// for analysis with awk or sort:
+//
+//	path	offset	length	baseTime	recordLen
+//
+// where baseTime is in nanoseconds since the Unix epoch and recordLen is that
+// of the file, which is shorter than the plan's for files with an older
+// schema. The previous file plans are not included.
+func (iop *ioplan) MarshalText() ([]byte, error) {
+	var buf bytes.Buffer
+	for _, fp := range iop.FilePlan {
+		if strings.ContainsAny(fp.FullPath, "\t\n") {
+			return nil, fmt.Errorf("MarshalText: path %q contains a tab or newline", fp.FullPath)
+		}
+		fmt.Fprintf(&buf, "%s\t%d\t%d\t%d\t%d\n",
+			fp.FullPath, fp.Offset, fp.Length, fp.BaseTime,
+			iop.versionedRecordLen(fp.tbi.GetSchemaVersion()))
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalText rebuilds the file plans of a plan written by MarshalText, for
+// simulating scans offline. The year of each file is taken from its base
+// time and its header is only read from the path if needed, so the files do
+// not have to exist for the plan to be inspected. The record length of the
+// plan is the longest of its files.
+func (iop *ioplan) UnmarshalText(text []byte) error {
+	var plans []*ioFilePlan
+	var recordLen int32
+	scanner := bufio.NewScanner(bytes.NewReader(text))
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Split(scanner.Text(), "\t")
+		if len(fields) != 5 {
+			return fmt.Errorf("UnmarshalText: line %d has %d fields, expected 5", line, len(fields))
+		}
+		var values [4]int64
+		for i, field := range fields[1:] {
+			value, err := strconv.ParseInt(field, 10, 64)
+			if err != nil {
+				return fmt.Errorf("UnmarshalText: line %d: %v", line, err)
+			}
+			values[i] = value
+		}
+		offset, length, baseTime, fileRecordLen := values[0], values[1], values[2], values[3]
+		if fileRecordLen <= 0 || fileRecordLen > math.MaxInt32 {
+			return fmt.Errorf("UnmarshalText: line %d: invalid record length %d", line, fileRecordLen)
+		}
+		if int32(fileRecordLen) > recordLen {
+			recordLen = int32(fileRecordLen)
+		}
+		year := time.Unix(0, baseTime).In(utils.InstanceConfig.Timezone).Year()
+		plans = append(plans, &ioFilePlan{
+			tbi:      &TimeBucketInfo{Year: int16(year), Path: fields[0]},
+			Offset:   offset,
+			Length:   length,
+			FullPath: fields[0],
+			BaseTime: baseTime,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	iop.FilePlan = plans
+	iop.PrevFilePlan = nil
+	iop.RecordLen = recordLen
+	if iop.Limit == nil {
+		iop.Limit = planner.NewRowLimit()
+	}
+	return nil
+}
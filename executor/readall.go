@@ -0,0 +1,96 @@
+package executor
+
+import (
+	"sync"
+	"time"
+
+	"github.com/alpacahq/marketstore/planner"
+	. "github.com/alpacahq/marketstore/utils/io"
+)
+
+// How long the whole history read for a bucket is reused before reading again
+const readAllYearsCacheTTL = 5 * time.Second
+
+// readAllEntry is the cached history of a bucket. Its fields are guarded by
+// readAllMu, and load is held by the caller reading the history from disk so
+// that the others wait for it rather than read it again.
+type readAllEntry struct {
+	load    sync.Mutex
+	cs      *ColumnSeries
+	expires time.Time
+}
+
+var (
+	readAllMu    sync.Mutex
+	readAllCache = map[TimeBucketKey]*readAllEntry{}
+)
+
+// ReadAllYears returns every record of the bucket at key, over all its year
+// files. The result is cached for a few seconds and shared with the other
+// callers, so it must not be modified and records written in the meantime
+// may be missing. The series is empty if the bucket has no records.
+func ReadAllYears(key TimeBucketKey) (*ColumnSeries, error) {
+	entry := readAllCacheEntry(key)
+	entry.load.Lock()
+	defer entry.load.Unlock()
+	readAllMu.Lock()
+	cs, expires := entry.cs, entry.expires
+	readAllMu.Unlock()
+	if cs != nil && time.Now().Before(expires) {
+		return cs, nil
+	}
+
+	cs, err := readAllYears(key)
+	readAllMu.Lock()
+	defer readAllMu.Unlock()
+	if err != nil {
+		if readAllCache[key] == entry && entry.cs == nil {
+			delete(readAllCache, key)
+		}
+		return nil, err
+	}
+	entry.cs, entry.expires = cs, time.Now().Add(readAllYearsCacheTTL)
+	return cs, nil
+}
+
+// readAllCacheEntry returns the cache entry of key, added if missing, after
+// dropping the expired entries of the other buckets
+func readAllCacheEntry(key TimeBucketKey) *readAllEntry {
+	readAllMu.Lock()
+	defer readAllMu.Unlock()
+	now := time.Now()
+	for k, entry := range readAllCache {
+		if k != key && entry.cs != nil && !now.Before(entry.expires) {
+			delete(readAllCache, k)
+		}
+	}
+	entry, ok := readAllCache[key]
+	if !ok {
+		entry = new(readAllEntry)
+		readAllCache[key] = entry
+	}
+	return entry
+}
+
+func readAllYears(key TimeBucketKey) (*ColumnSeries, error) {
+	// The range of the query is unbounded unless set
+	q := planner.NewQuery(ThisInstance.CatalogDir)
+	q.AddTargetKey(&key)
+	pr, err := q.Parse()
+	if err != nil {
+		return nil, err
+	}
+	r, err := NewReader(pr)
+	if err != nil {
+		return nil, err
+	}
+	csm, _, err := r.Read()
+	if err != nil {
+		return nil, err
+	}
+	cs := csm[key]
+	if cs == nil {
+		cs = NewColumnSeries()
+	}
+	return cs, nil
+}
@@ -0,0 +1,93 @@
+package readhint
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// LockFreeHintTableSize is the number of slots of the lock free hint table
+const LockFreeHintTableSize = 1 << 16
+
+const (
+	// Low bits of a slot holding the offset, year files are far below 1TB
+	offsetBits = 40
+	offsetMask = 1<<offsetBits - 1
+)
+
+// LockFreeHintTable holds the hints in a fixed number of slots updated with
+// atomic operations only, for the hints to be set at the highest rates
+// without contending on a lock. Each slot packs the offset with a tag of the
+// hash of the file path in a single word. Files whose paths hash to the same
+// slot evict each other's hint, the last one set wins. As paths are not
+// kept the hints cannot be listed nor moved.
+type LockFreeHintTable struct {
+	slots [LockFreeHintTableSize]uint64
+	// Time of each hint in nanoseconds, set after its slot. A time mixed up
+	// with the one of a colliding file only shifts the age of the hint.
+	setAt [LockFreeHintTableSize]int64
+}
+
+var lockFreeTable = &LockFreeHintTable{}
+
+// hashPath is the FNV-1a hash of filePath, without allocating
+func hashPath(filePath string) uint64 {
+	h := uint64(14695981039346656037)
+	for i := 0; i < len(filePath); i++ {
+		h ^= uint64(filePath[i])
+		h *= 1099511628211
+	}
+	return h
+}
+
+// slot returns the index of the slot of the hash and the tag of its value
+func slot(hash uint64) (int, uint64) {
+	return int(hash % LockFreeHintTableSize), hash >> offsetBits << offsetBits
+}
+
+// Get returns the offset hinted for filePath and the time it was set
+func (t *LockFreeHintTable) Get(filePath string) (int64, time.Time, bool) {
+	i, tag := slot(hashPath(filePath))
+	val := atomic.LoadUint64(&t.slots[i])
+	if val == 0 || val&^offsetMask != tag {
+		return 0, time.Time{}, false
+	}
+	return int64(val & offsetMask), time.Unix(0, atomic.LoadInt64(&t.setAt[i])), true
+}
+
+// Set hints offset for filePath as known at time at, unless a larger offset
+// is already hinted for the file. Offsets that do not fit in a slot are
+// ignored.
+func (t *LockFreeHintTable) Set(filePath string, offset int64, at time.Time) {
+	if offset <= 0 || offset > offsetMask {
+		return
+	}
+	i, tag := slot(hashPath(filePath))
+	val := tag | uint64(offset)
+	for {
+		previous := atomic.LoadUint64(&t.slots[i])
+		if previous&^offsetMask == tag && previous != 0 {
+			prevOffset := int64(previous & offsetMask)
+			if prevOffset > offset {
+				return
+			}
+			if prevOffset == offset {
+				// An equal offset refreshes the hint
+				if at.UnixNano() > atomic.LoadInt64(&t.setAt[i]) {
+					atomic.StoreInt64(&t.setAt[i], at.UnixNano())
+				}
+				return
+			}
+		}
+		if atomic.CompareAndSwapUint64(&t.slots[i], previous, val) {
+			atomic.StoreInt64(&t.setAt[i], at.UnixNano())
+			return
+		}
+	}
+}
+
+// Reset clears every hint of the table
+func (t *LockFreeHintTable) Reset() {
+	for i := range t.slots {
+		atomic.StoreUint64(&t.slots[i], 0)
+	}
+}
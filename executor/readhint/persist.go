@@ -0,0 +1,101 @@
+package readhint
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// persistMagic begins the files written by Persist, followed by the format
+// version
+var persistMagic = [4]byte{'M', 'K', 'R', 'H'}
+
+const persistVersion = uint32(1)
+
+// ErrInvalidHintFile is returned by Load for a file not written by Persist
+var ErrInvalidHintFile = errors.New("not a read hint file")
+
+// Persist saves hints to the file at path, e.g. the ones of Snapshot, as the
+// count of hints followed by the path, offset and time of each. The file is
+// written aside and renamed over path, so that it is never seen partly
+// written.
+func Persist(path string, hints map[string]Hint) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(tmp)
+	w.Write(persistMagic[:])
+	var buf [8]byte
+	binary.LittleEndian.PutUint32(buf[:], persistVersion)
+	w.Write(buf[:4])
+	binary.LittleEndian.PutUint32(buf[:], uint32(len(hints)))
+	w.Write(buf[:4])
+	for filePath, hint := range hints {
+		binary.LittleEndian.PutUint16(buf[:], uint16(len(filePath)))
+		w.Write(buf[:2])
+		w.WriteString(filePath)
+		binary.LittleEndian.PutUint64(buf[:], uint64(hint.Offset))
+		w.Write(buf[:])
+		binary.LittleEndian.PutUint64(buf[:], uint64(hint.SetAt.UnixNano()))
+		w.Write(buf[:])
+	}
+	err = w.Flush()
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+// Load returns the hints saved by Persist to the file at path. The hints
+// are only advisory, so they are left to the caller to check and set.
+func Load(path string) (map[string]Hint, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	var header [12]byte
+	if _, err = io.ReadFull(r, header[:]); err != nil {
+		return nil, ErrInvalidHintFile
+	}
+	if [4]byte{header[0], header[1], header[2], header[3]} != persistMagic ||
+		binary.LittleEndian.Uint32(header[4:]) != persistVersion {
+		return nil, ErrInvalidHintFile
+	}
+	count := binary.LittleEndian.Uint32(header[8:])
+	hints := make(map[string]Hint)
+	var buf [16]byte
+	for i := uint32(0); i < count; i++ {
+		if _, err = io.ReadFull(r, buf[:2]); err != nil {
+			return nil, ErrInvalidHintFile
+		}
+		filePath := make([]byte, binary.LittleEndian.Uint16(buf[:]))
+		if _, err = io.ReadFull(r, filePath); err != nil {
+			return nil, ErrInvalidHintFile
+		}
+		if _, err = io.ReadFull(r, buf[:]); err != nil {
+			return nil, ErrInvalidHintFile
+		}
+		hints[string(filePath)] = Hint{
+			Offset: int64(binary.LittleEndian.Uint64(buf[:])),
+			SetAt:  time.Unix(0, int64(binary.LittleEndian.Uint64(buf[8:]))),
+		}
+	}
+	return hints, nil
+}
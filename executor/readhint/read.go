@@ -2,46 +2,118 @@ package readhint
 
 import (
 	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/alpacahq/marketstore/utils"
 )
 
+// lastKnown is the offset of the last non-NULL record of a file along with
+// the time the hint was set
+type lastKnown struct {
+	offset int64
+	setAt  time.Time
+}
+
 type fileOffsetMap struct {
 	sync.RWMutex
-	mp map[string]int64
+	mp map[string]lastKnown
 }
 
-var lastKnownMap = &fileOffsetMap{mp: map[string]int64{}}
+var lastKnownMap = &fileOffsetMap{mp: map[string]lastKnown{}}
 
-func GetLastKnown(filePath string) (int64, bool) {
+// GetLastKnown returns the hinted offset of the last non-NULL record in this
+// file. A hint set more than maxAge ago is treated as absent, since later
+// writes may have gone past it. A maxAge of 0 means hints never go stale.
+func GetLastKnown(filePath string, maxAge time.Duration) (int64, bool) {
 	if !utils.InstanceConfig.EnableLastKnown {
 		return 0, false
 	}
+	if utils.InstanceConfig.LockFreeHints {
+		offset, setAt, ok := lockFreeTable.Get(filePath)
+		if ok && maxAge > 0 && time.Since(setAt) > maxAge {
+			return 0, false
+		}
+		return offset, ok
+	}
 	// avoid "defer" for performance reason
 	lastKnownMap.RLock()
 	val, ok := lastKnownMap.mp[filePath]
 	lastKnownMap.RUnlock()
-	return val, ok
+	if ok && maxAge > 0 && time.Since(val.setAt) > maxAge {
+		return 0, false
+	}
+	return val.offset, ok
 }
 
 // Set the byte offset where the last non-NULL record stays in this file.
 // Note offset is the beginning of the record.
 func SetLastKnown(filePath string, offset int64) {
+	SetLastKnownWithTime(filePath, offset, time.Now())
+}
+
+// SetLastKnownWithTime sets the offset of the last non-NULL record in this
+// file as it was known at time t.
+func SetLastKnownWithTime(filePath string, offset int64, t time.Time) {
 	if !utils.InstanceConfig.EnableLastKnown {
 		return
 	}
+	if utils.InstanceConfig.LockFreeHints {
+		lockFreeTable.Set(filePath, offset, t)
+		return
+	}
 	lastKnownMap.Lock()
-	// check if it is bigger, since it's ok to read false-NULL records whereas
-	// the opposite is not.
-	if previous, ok := lastKnownMap.mp[filePath]; !ok || previous < offset {
-		lastKnownMap.mp[filePath] = offset
+	// check if it is not smaller, since it's ok to read false-NULL records
+	// whereas the opposite is not. An equal offset refreshes the hint.
+	if previous, ok := lastKnownMap.mp[filePath]; !ok || previous.offset < offset ||
+		(previous.offset == offset && t.After(previous.setAt)) {
+		lastKnownMap.mp[filePath] = lastKnown{offset: offset, setAt: t}
 	}
 	lastKnownMap.Unlock()
 }
 
+// MoveLastKnown moves the offsets of the files below oldDir to the same
+// files below newDir, after the directory was renamed. The hints of the
+// lock free table cannot be told apart and are all dropped.
+func MoveLastKnown(oldDir, newDir string) {
+	if utils.InstanceConfig.LockFreeHints {
+		lockFreeTable.Reset()
+		return
+	}
+	lastKnownMap.Lock()
+	for filePath, hint := range lastKnownMap.mp {
+		if strings.HasPrefix(filePath, oldDir+"/") {
+			delete(lastKnownMap.mp, filePath)
+			lastKnownMap.mp[newDir+strings.TrimPrefix(filePath, oldDir)] = hint
+		}
+	}
+	lastKnownMap.Unlock()
+}
+
+// Hint is the offset of the last non-NULL record of a file along with the
+// time it was known, as saved by a checkpoint
+type Hint struct {
+	Offset int64     `json:"offset"`
+	SetAt  time.Time `json:"set_at"`
+}
+
+// Snapshot returns the hint of every file, none with the lock free table
+func Snapshot() map[string]Hint {
+	if utils.InstanceConfig.LockFreeHints {
+		return map[string]Hint{}
+	}
+	lastKnownMap.RLock()
+	defer lastKnownMap.RUnlock()
+	hints := make(map[string]Hint, len(lastKnownMap.mp))
+	for filePath, hint := range lastKnownMap.mp {
+		hints[filePath] = Hint{Offset: hint.offset, SetAt: hint.setAt}
+	}
+	return hints
+}
+
 func PrintLastKnowns() {
 	for key, val := range lastKnownMap.mp {
-		fmt.Printf("%s -> %d\n", key, val)
+		fmt.Printf("%s -> %d\n", key, val.offset)
 	}
 }
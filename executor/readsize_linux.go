@@ -0,0 +1,75 @@
+package executor
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+// Read sizes for block devices that do not report an optimal IO size
+const (
+	rotationalReadSize    = 4 << 10
+	nonRotationalReadSize = 128 << 10
+)
+
+var readSizeByDevice sync.Map
+
+// suggestedReadSize returns the optimal read size of the block device holding
+// the file at path, as reported in /sys/block/*/queue/optimal_io_size. It is
+// probed once per device and 0 if the device is not a block device.
+func suggestedReadSize(path string) int64 {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0
+	}
+	dev := uint64(st.Dev)
+	if size, ok := readSizeByDevice.Load(dev); ok {
+		return size.(int64)
+	}
+	size := probeReadSize(dev)
+	readSizeByDevice.Store(dev, size)
+	return size
+}
+
+func probeReadSize(dev uint64) int64 {
+	major := (dev>>8)&0xfff | (dev>>32)&^0xfff
+	minor := dev&0xff | (dev>>12)&^0xff
+	devDir, err := filepath.EvalSymlinks(fmt.Sprintf("/sys/dev/block/%d:%d", major, minor))
+	if err != nil {
+		return 0
+	}
+	// The queue of a partition is found on its parent device
+	for _, queueDir := range []string{
+		filepath.Join(devDir, "queue"),
+		filepath.Join(devDir, "..", "queue"),
+	} {
+		if size, ok := readSysfsInt(filepath.Join(queueDir, "optimal_io_size")); ok {
+			if size > 0 {
+				return size
+			}
+			if rotational, _ := readSysfsInt(filepath.Join(queueDir, "rotational")); rotational == 1 {
+				return rotationalReadSize
+			}
+			return nonRotationalReadSize
+		}
+	}
+	return 0
+}
+
+func readSysfsInt(path string) (int64, bool) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, false
+	}
+	value, err := strconv.ParseInt(strings.TrimSpace(string(buf)), 10, 64)
+	return value, err == nil
+}
@@ -0,0 +1,11 @@
+//go:build !linux
+// +build !linux
+
+package executor
+
+// defaultReadSize is used where the storage device can not be probed
+const defaultReadSize = 4 << 10
+
+func suggestedReadSize(path string) int64 {
+	return defaultReadSize
+}
@@ -0,0 +1,148 @@
+package executor
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/alpacahq/marketstore/catalog"
+	. "github.com/alpacahq/marketstore/utils/io"
+	. "github.com/alpacahq/marketstore/utils/log"
+)
+
+// CleanShutdownName is the file left in the root directory once the WAL is
+// flushed on shutdown, so that the next start knows which files the writes
+// of the last run are done with
+const CleanShutdownName = "clean.shutdown"
+
+// markCleanShutdown leaves the clean shutdown file in rootDir
+func markCleanShutdown(rootDir string) error {
+	fp, err := os.OpenFile(filepath.Join(rootDir, CleanShutdownName), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	err = fp.Sync()
+	if closeErr := fp.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// takeCleanShutdown removes the clean shutdown file from rootDir and returns
+// the time it was left at, the zero time if the last run did not shut down
+// cleanly
+func takeCleanShutdown(rootDir string) time.Time {
+	path := filepath.Join(rootDir, CleanShutdownName)
+	fi, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	if err = os.Remove(path); err != nil {
+		Log(ERROR, "Recovery: removing %s - Error: %v", path, err)
+		return time.Time{}
+	}
+	return fi.ModTime()
+}
+
+// recoverYearFiles repairs the year files of the catalog left behind by a
+// write interrupted when the process was killed. It must be done before the
+// WAL is replayed, as the replay appends to the VARIABLE files. The index of
+// a VARIABLE file is only scanned if the file was modified since
+// cleanShutdown, any write after it may have been interrupted.
+func recoverYearFiles(d *catalog.Directory, cleanShutdown time.Time) {
+	start := time.Now()
+	var recovered int
+	for _, tbi := range d.GatherTimeBucketInfo() {
+		if tbi.GetRecordType() == VARIABLE && !cleanShutdown.IsZero() {
+			if fi, err := os.Stat(tbi.Path); err == nil && fi.ModTime().Before(cleanShutdown) {
+				continue
+			}
+		}
+		changed, err := recoverYearFile(tbi)
+		if err != nil {
+			Log(ERROR, "Recovery: %s - Error: %v", tbi.Path, err)
+			continue
+		}
+		if changed {
+			os.Remove(statsPath(tbi.Path))
+			recovered++
+		}
+	}
+	if recovered > 0 {
+		Log(WARNING, "Recovered %d year files from interrupted writes in %v", recovered, time.Since(start))
+	}
+}
+
+// recoverYearFile brings the year file of tbi back to its last complete
+// write, returning true if it was changed. FIXED files must have the size
+// they are created with. The data of VARIABLE records is appended before
+// the index is updated, so data past the last one referenced was never
+// committed: it is cut off for the next writes to the interval to be
+// appended to its data again. Records whose data did not make it to the
+// file whole are cleared.
+func recoverYearFile(tbi *TimeBucketInfo) (bool, error) {
+	fi, err := os.Stat(tbi.Path)
+	if err != nil {
+		return false, err
+	}
+	recordLen := int64(tbi.GetRecordLength())
+	size := FileSize(tbi.GetTimeframe(), int(tbi.Year), int(recordLen))
+	if tbi.GetRecordType() != VARIABLE {
+		if fi.Size() == size {
+			return false, nil
+		}
+		Log(WARNING, "Recovery: resizing %s from %d to %d bytes", tbi.Path, fi.Size(), size)
+		return true, os.Truncate(tbi.Path, size)
+	}
+	if fi.Size() <= size {
+		if fi.Size() == size {
+			return false, nil
+		}
+		// No data was appended to a file missing a part of its index
+		Log(WARNING, "Recovery: resizing %s from %d to %d bytes", tbi.Path, fi.Size(), size)
+		return true, os.Truncate(tbi.Path, size)
+	}
+
+	fileSize := fi.Size()
+	var changed bool
+	end := size
+	fp, err := os.OpenFile(tbi.Path, os.O_RDWR, 0600)
+	if err != nil {
+		return false, err
+	}
+	defer fp.Close()
+	err = readIndex(fp, recordLen, size, func(offset int64, record []byte) error {
+		// {Index, Offset, Len} of the interval data
+		if binary.LittleEndian.Uint64(record) == 0 {
+			return nil
+		}
+		dataEnd := int64(binary.LittleEndian.Uint64(record[8:]) + binary.LittleEndian.Uint64(record[16:]))
+		if dataEnd > fileSize {
+			Log(WARNING, "Recovery: clearing the record of %s at %d, its data is incomplete", tbi.Path, offset)
+			if _, err := fp.WriteAt(make([]byte, recordLen), offset); err != nil {
+				return err
+			}
+			changed = true
+			return nil
+		}
+		if dataEnd > end {
+			end = dataEnd
+		}
+		return nil
+	})
+	if err != nil {
+		return changed, err
+	}
+	if end < fileSize {
+		Log(WARNING, "Recovery: truncating %s from %d to %d bytes", tbi.Path, fileSize, end)
+		if err = fp.Truncate(end); err != nil {
+			return changed, err
+		}
+		changed = true
+	}
+	if changed {
+		err = fp.Sync()
+	}
+	return changed, err
+}
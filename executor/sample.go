@@ -0,0 +1,65 @@
+package executor
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/alpacahq/marketstore/planner"
+	. "github.com/alpacahq/marketstore/utils/io"
+)
+
+// SampleRows returns n rows of the bucket at key chosen uniformly at random
+// among all of its rows, or every row if it has no more than n, in time
+// order. The same seed gives the same sample of the same data. The epochs of
+// the sample are chosen by reservoir sampling in a first scan whose time
+// qualifier keeps no records, so only the sample is read into memory by the
+// second scan.
+func SampleRows(key TimeBucketKey, n int, seed int64) (*ColumnSeries, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("SampleRows: sample size %d is not positive", n)
+	}
+	rng := rand.New(rand.NewSource(seed))
+	reservoir := make([]int64, 0, n)
+	var seen int64
+	_, err := sampleRead(key, func(epoch int64) bool {
+		seen++
+		if len(reservoir) < n {
+			reservoir = append(reservoir, epoch)
+		} else if i := rng.Int63n(seen); i < int64(n) {
+			reservoir[i] = epoch
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sample := make(map[int64]struct{}, len(reservoir))
+	for _, epoch := range reservoir {
+		sample[epoch] = struct{}{}
+	}
+	return sampleRead(key, func(epoch int64) bool {
+		_, ok := sample[epoch]
+		return ok
+	})
+}
+
+// sampleRead reads every record of the bucket that passes qual
+func sampleRead(key TimeBucketKey, qual planner.TimeQualFunc) (*ColumnSeries, error) {
+	q := planner.NewQuery(ThisInstance.CatalogDir)
+	q.AddTargetKey(&key)
+	q.AddTimeQual(qual)
+	pr, err := q.Parse()
+	if err != nil {
+		return nil, err
+	}
+	r, err := NewReader(pr)
+	if err != nil {
+		return nil, err
+	}
+	csm, _, err := r.Read()
+	if err != nil {
+		return nil, err
+	}
+	return csm[key], nil
+}
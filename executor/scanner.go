@@ -3,10 +3,17 @@ package executor
 import (
 	"encoding/binary"
 	"fmt"
+	"hash/fnv"
 	"io"
+	"io/ioutil"
 	"math"
+	"math/rand"
 	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/alpacahq/marketstore/executor/readhint"
@@ -18,6 +25,14 @@ import (
 
 const RecordsPerRead = 2000
 
+// A corrupt file can fail on every read, so its errors are sampled
+const errorLogSampleRate = 1000
+
+var readErrorLog = Sampled(errorLogSampleRate)
+
+// Timing of every read is logged at DEBUG level when DEBUG_READS=1 is set
+var debugReads = os.Getenv("DEBUG_READS") == "1"
+
 type SortedFileList []planner.QualifiedFile
 
 func (fl SortedFileList) Len() int           { return len(fl) }
@@ -29,15 +44,80 @@ type ioFilePlan struct {
 	Offset   int64
 	Length   int64
 	FullPath string // Full file path, including leaf (Year) file
-	// The time that begins each file in seconds since the Unix epoch
-	BaseTime    int64
+	// The time that begins each file in nanoseconds since the Unix epoch
+	BaseTime int64
+	// set for backward scans until the last record of the file is found, and
+	// cleared by the read that finds it, so a plan must not be read by
+	// several goroutines at once, see ioplan.Clone
 	seekingLast bool
+	// set once the plan length has been checked against the file on disk
+	lengthValidated bool
+	// Optimal read size for the storage device holding the file, 0 if unknown
+	SuggestedReadSize int64
+	// Bytes cut from the end of the planned range by the read hint of the file
+	skippedByHint int64
+	// Inode of the file when the plan was built, 0 if unknown, to detect the
+	// file being replaced before it is read
+	Inode uint64
 }
 
 func (iofp *ioFilePlan) GetFileYear() int16 {
 	return iofp.tbi.Year
 }
 
+// fileInode returns the inode of the file at path, or of the cold file it
+// links to, 0 if it is not known
+func fileInode(path string) uint64 {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	_, ino := fileID(fi)
+	return ino
+}
+
+// checkFileReplaced returns ErrFileReplaced if the open file f is not the
+// one the file plan was built for, e.g. after a vacuum renamed another file
+// over it
+func checkFileReplaced(f *os.File, fp *ioFilePlan) error {
+	if fp.Inode == 0 {
+		return nil
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if _, ino := fileID(fi); ino != 0 && ino != fp.Inode {
+		return ErrFileReplaced
+	}
+	return nil
+}
+
+// validateFilePlanLength checks that the byte range described by the file plan
+// lies within the file on disk. A plan that runs past the end of the file would
+// otherwise read zeros and silently treat them as null records.
+func validateFilePlanLength(fp *ioFilePlan) error {
+	fileInfo, err := os.Stat(fp.FullPath)
+	if err != nil {
+		return err
+	}
+	if fp.Offset+fp.Length > fileInfo.Size() {
+		Log(ERROR, "Read: plan for %s ends at %d, past the file size %d",
+			fp.FullPath, fp.Offset+fp.Length, fileInfo.Size())
+		return ErrFilePlanExceedsFileSize
+	}
+	fp.lengthValidated = true
+	return nil
+}
+
+// IOPlanWarning flags a condition of an ioplan that callers may need to
+// account for in their results.
+type IOPlanWarning string
+
+// NoPreviousData is set when no data precedes the range of a forward scan, so
+// the tPrev of the first record is a synthetic value rather than a record time.
+const NoPreviousData IOPlanWarning = "Warning: NoPreviousData"
+
 type ioplan struct {
 	FilePlan          []*ioFilePlan
 	PrevFilePlan      []*ioFilePlan
@@ -46,19 +126,311 @@ type ioplan struct {
 	VariableRecordLen int
 	Limit             *planner.RowLimit
 	TimeQuals         []planner.TimeQualFunc
+	// Schema of the newest file, older files hold a prefix of its columns
+	SchemaVersion int16
+	elementTypes  []EnumElementType
+	Warnings      []IOPlanWarning
+	// ProjectionMask tells which columns of the newest file are returned,
+	// all of them when nil
+	ProjectionMask []bool
+}
+
+// projectionMask returns the mask of the columns of names listed in filter.
+// The Epoch is always returned and needs not be listed.
+func projectionMask(names, filter []string) ([]bool, error) {
+	mask := make([]bool, len(names))
+	for _, col := range filter {
+		if col == "Epoch" {
+			continue
+		}
+		found := false
+		for i, name := range names {
+			if name == col {
+				mask[i], found = true, true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("NewIOPlan: column %s: %v", col, ErrColumnNotFound)
+		}
+	}
+	return mask, nil
+}
+
+// projectRows copies the Epoch and the columns of mask of each row of
+// buffer, described by shapes, to rows of their own. It returns the rows
+// along with their shapes and length.
+func projectRows(buffer []byte, shapes []DataShape, rowLen int, mask []bool) ([]byte, []DataShape, int) {
+	type span struct{ offset, size int }
+	spans := []span{{0, shapes[0].Len()}}
+	projected := []DataShape{shapes[0]}
+	offset := shapes[0].Len()
+	for i, ds := range shapes[1:] {
+		if i < len(mask) && mask[i] {
+			spans = append(spans, span{offset, ds.Len()})
+			projected = append(projected, ds)
+		}
+		offset += ds.Len()
+	}
+	var newRowLen int
+	for _, sp := range spans {
+		newRowLen += sp.size
+	}
+	numRows := len(buffer) / rowLen
+	out := make([]byte, 0, numRows*newRowLen)
+	for i := 0; i < numRows; i++ {
+		row := buffer[i*rowLen:]
+		for _, sp := range spans {
+			out = append(out, row[sp.offset:sp.offset+sp.size]...)
+		}
+	}
+	return out, projected, newRowLen
+}
+
+// projectedNames returns the names of the Epoch, of the columns of mask and
+// of the Nanoseconds of VARIABLE rows described by shapes
+func projectedNames(shapes []DataShape, mask []bool) []string {
+	names := []string{shapes[0].Name}
+	for i, ds := range shapes[1:] {
+		if i < len(mask) && mask[i] {
+			names = append(names, ds.Name)
+		}
+	}
+	return append(names, "Nanoseconds")
 }
 
-func NewIOPlan(fl SortedFileList, pr *planner.ParseResult) (iop *ioplan, err error) {
+// restat updates the file plans for the files now on disk once they were
+// replaced. The records of a replaced year file keep their offsets, so the
+// ranges to read are unchanged.
+func (iop *ioplan) restat() {
+	for _, fps := range [][]*ioFilePlan{iop.FilePlan, iop.PrevFilePlan} {
+		for _, fp := range fps {
+			fp.Inode = fileInode(fp.FullPath)
+			fp.lengthValidated = false
+		}
+	}
+}
+
+// Clone returns a deep copy of the plan. Reads update the file plans, so
+// each goroutine reading the same plan needs its own copy.
+func (iop *ioplan) Clone() *ioplan {
+	clone := *iop
+	clone.FilePlan = cloneFilePlans(iop.FilePlan)
+	clone.PrevFilePlan = cloneFilePlans(iop.PrevFilePlan)
+	if iop.Limit != nil {
+		limit := *iop.Limit
+		clone.Limit = &limit
+	}
+	clone.TimeQuals = append([]planner.TimeQualFunc(nil), iop.TimeQuals...)
+	clone.elementTypes = append([]EnumElementType(nil), iop.elementTypes...)
+	clone.Warnings = append([]IOPlanWarning(nil), iop.Warnings...)
+	clone.ProjectionMask = append([]bool(nil), iop.ProjectionMask...)
+	return &clone
+}
+
+func cloneFilePlans(fps []*ioFilePlan) []*ioFilePlan {
+	if fps == nil {
+		return nil
+	}
+	clones := make([]*ioFilePlan, len(fps))
+	for i, fp := range fps {
+		clone := *fp
+		clones[i] = &clone
+	}
+	return clones
+}
+
+// setWarnings updates the warnings that depend on the previous file plans,
+// which may be present but empty when the range starts with the first file.
+func (iop *ioplan) setWarnings() {
+	iop.Warnings = nil
+	var prevLength int64
+	for _, fp := range iop.PrevFilePlan {
+		prevLength += fp.Length
+	}
+	if prevLength == 0 && iop.Limit.Direction != LAST {
+		iop.Warnings = append(iop.Warnings, NoPreviousData)
+	}
+}
+
+// PlanSummary is an estimate of the work done by an IO plan. Rows counts the
+// record slots in the planned ranges, so it is an upper bound for sparse data.
+type PlanSummary struct {
+	Files int
+	Bytes int64
+	Rows  int64
+}
+
+// PlanSummary estimates the reads of the plan without touching the files.
+func (iop *ioplan) PlanSummary() (ps PlanSummary) {
+	for _, fp := range iop.FilePlan {
+		ps.Files++
+		ps.Bytes += fp.Length
+		ps.Rows += fp.Length / int64(iop.versionedRecordLen(fp.tbi.GetSchemaVersion()))
+	}
+	return ps
+}
+
+// ScanStats counts the work done by the reads of a reader
+type ScanStats struct {
+	// Bytes at the end of the planned ranges that were not read because the
+	// read hints place the last record of their file before them
+	BytesSkippedByHint int64
+	// NaN and infinite values read from the float columns of FIXED records,
+	// which are almost always data quality issues
+	NaNCount int64
+	InfCount int64
+}
+
+func (ss *ScanStats) add(other ScanStats) {
+	ss.BytesSkippedByHint += other.BytesSkippedByHint
+	ss.NaNCount += other.NaNCount
+	ss.InfCount += other.InfCount
+}
+
+// versionedRecordLen returns the record length of files written with the
+// given schema version, which hold only the first version columns of the plan.
+func (iop *ioplan) versionedRecordLen(version int16) int32 {
+	if version >= iop.SchemaVersion {
+		return iop.RecordLen
+	}
+	var fieldRecordLength int
+	for _, elType := range iop.elementTypes[:version] {
+		fieldRecordLength += elType.Size()
+	}
+	return int32(AlignedSize(fieldRecordLength)) + 8 // add an 8-byte epoch field
+}
+
+// NextPageIOP returns a copy of the plan that starts with the record following
+// lastEpoch, for keyset pagination without parsing the query again. File plans
+// for years before the one holding lastEpoch are dropped and become the
+// previous file plans of the new page.
+func (iop *ioplan) NextPageIOP(lastEpoch int64) (*ioplan, error) {
+	year := int16(ToSystemTimezone(time.Unix(lastEpoch, 0)).Year())
+	if len(iop.FilePlan) > 0 && year < iop.FilePlan[0].GetFileYear() {
+		return nil, fmt.Errorf("NextPageIOP: %d precedes the start of the plan", lastEpoch)
+	}
+	next := *iop
+	next.FilePlan = make([]*ioFilePlan, 0, len(iop.FilePlan))
+	prevPaths := make([]*ioFilePlan, 0)
+	for _, fp := range iop.FilePlan {
+		switch {
+		case fp.GetFileYear() < year:
+			prevPaths = append(prevPaths, fp)
+		case fp.GetFileYear() == year:
+			startOffset := EpochToOffset(lastEpoch, fp.tbi.GetTimeframe(), fp.tbi.GetRecordLength()) +
+				int64(fp.tbi.GetRecordLength())
+			if startOffset <= fp.Offset {
+				return nil, fmt.Errorf("NextPageIOP: %d precedes the start of the plan", lastEpoch)
+			}
+			prev := *fp
+			prev.Length = startOffset - fp.Offset
+			prevPaths = append(prevPaths, &prev)
+			if endOffset := fp.Offset + fp.Length; startOffset < endOffset {
+				page := *fp
+				page.Offset = startOffset
+				page.Length = endOffset - startOffset
+				next.FilePlan = append(next.FilePlan, &page)
+			}
+		default:
+			next.FilePlan = append(next.FilePlan, fp)
+		}
+	}
+	next.PrevFilePlan = make([]*ioFilePlan, 0, len(prevPaths)+len(iop.PrevFilePlan))
+	next.PrevFilePlan = append(next.PrevFilePlan, prevPaths...)
+	next.PrevFilePlan = append(next.PrevFilePlan, iop.PrevFilePlan...)
+	sortPrevFilePlan(next.PrevFilePlan)
+	next.setWarnings()
+	return &next, nil
+}
+
+// sortPrevFilePlan orders the previous file plans for the backward scan, most
+// recent first. The order follows the base time of each file rather than its
+// year, as a bucket's year need not begin on January 1.
+func sortPrevFilePlan(fps []*ioFilePlan) {
+	sort.SliceStable(fps, func(i, j int) bool {
+		return fps[i].BaseTime > fps[j].BaseTime
+	})
+}
+
+// sortFilePlan orders the file plans for the forward scan by base time, so
+// the order does not depend on the year order of the file list.
+func sortFilePlan(fps []*ioFilePlan) {
+	sort.SliceStable(fps, func(i, j int) bool {
+		return fps[i].BaseTime < fps[j].BaseTime
+	})
+}
+
+// isOlderSchema returns true if the file holds fixed records with a prefix of
+// the plan's columns.
+func (iop *ioplan) isOlderSchema(tbi *TimeBucketInfo) bool {
+	version := tbi.GetSchemaVersion()
+	return iop.RecordType == FIXED && tbi.GetRecordType() == FIXED &&
+		version < iop.SchemaVersion &&
+		planner.ElementsEqual(tbi.GetElementTypes(), iop.elementTypes[:version])
+}
+
+// NewIOPlan builds the plan for reading the files in fl over the range of pr.
+// With createIfNotExists, year files known to the catalog but not on disk yet,
+// e.g. during the first write to a year, are created empty instead of failing
+// the read.
+func NewIOPlan(fl SortedFileList, pr *planner.ParseResult, createIfNotExists bool) (iop *ioplan, err error) {
+	if len(fl) == 0 {
+		return nil, ErrNoDataFound
+	}
 	iop = new(ioplan)
 	iop.FilePlan = make([]*ioFilePlan, 0)
 	iop.PrevFilePlan = make([]*ioFilePlan, 0)
 	iop.Limit = pr.Limit
+	var elementNames []string
 	/*
 		At this point we have a date unconstrained group of sorted files
 		We will do two things here:
 		1) create the list of date qualified files to read for the primary data
 		2) create a list of files with times prior to the date range in reverse order
 	*/
+	for _, file := range fl {
+		if createIfNotExists {
+			if err = createEmptyYearFile(file.File); err != nil {
+				return nil, err
+			}
+		}
+		if iop.RecordLen == 0 || file.File.GetSchemaVersion() > iop.SchemaVersion {
+			iop.RecordLen = file.File.GetRecordLength()
+			iop.RecordType = file.File.GetRecordType()
+			iop.SchemaVersion = file.File.GetSchemaVersion()
+			iop.elementTypes = file.File.GetElementTypes()
+			elementNames = file.File.GetElementNames()
+		}
+	}
+	if len(pr.ColumnFilter) > 0 {
+		if iop.ProjectionMask, err = projectionMask(elementNames, pr.ColumnFilter); err != nil {
+			return nil, err
+		}
+	}
+	if iop.RecordType == VARIABLE {
+		varRecLen, err := fl[len(fl)-1].File.GetVariableRecordLength()
+		if err != nil {
+			return nil, err
+		}
+		iop.VariableRecordLen = int(varRecLen)
+		if iop.VariableRecordLen <= 0 {
+			return nil, ErrInvalidVariableRecordLen
+		}
+	}
+	// The files with records in the range are cached by key directory
+	var (
+		inRange   map[string]bool
+		planned   SortedFileList
+		cacheable = true
+	)
+	keyDir := filepath.Dir(fl[0].File.Path)
+	cachedList, cached, generation := plans.get(keyDir, pr.Range)
+	if cached {
+		inRange = make(map[string]bool, len(cachedList))
+		for _, qf := range cachedList {
+			inRange[qf.File.Path] = true
+		}
+	}
 	prevPaths := make([]*ioFilePlan, 0)
 	for _, file := range fl {
 		fileStartTime := time.Date(
@@ -73,15 +445,15 @@ func NewIOPlan(fl SortedFileList, pr *planner.ParseResult) (iop *ioplan, err err
 			int(file.File.GetRecordLength()))
 		length := endOffset - startOffset
 		maxLength := length + int64(file.File.GetRecordLength())
-		if iop.RecordLen == 0 {
-			iop.RecordLen = file.File.GetRecordLength()
-			iop.RecordType = file.File.GetRecordType()
-			iop.VariableRecordLen = int(file.File.GetVariableRecordLength())
-		} else {
-			// check that we're reading the same recordlength across all files, return err if not
-			if file.File.GetRecordLength() != iop.RecordLen {
-				return nil, RecordLengthNotConsistent("NewIOPlan")
-			}
+		readSize := suggestedReadSize(file.File.Path)
+		inode := fileInode(file.File.Path)
+		// A VARIABLE file read as FIXED or the reverse would be misread
+		if file.File.GetRecordType() != iop.RecordType {
+			return nil, ErrRecordTypeMismatch
+		}
+		// check that we're reading the same recordlength across all files, return err if not
+		if file.File.GetRecordLength() != iop.RecordLen && !iop.isOlderSchema(file.File) {
+			return nil, RecordLengthNotConsistent("NewIOPlan")
 		}
 		if file.File.Year < pr.Range.StartYear {
 			// Add the whole file to the previous files list for use in back scanning before the start
@@ -92,8 +464,12 @@ func NewIOPlan(fl SortedFileList, pr *planner.ParseResult) (iop *ioplan, err err
 					startOffset,
 					length,
 					file.File.Path,
-					fileStartTime.Unix(),
+					fileStartTime.UnixNano(),
 					false,
+					false,
+					readSize,
+					0,
+					inode,
 				},
 			)
 		} else if file.File.Year <= pr.Range.EndYear {
@@ -114,29 +490,46 @@ func NewIOPlan(fl SortedFileList, pr *planner.ParseResult) (iop *ioplan, err err
 					file.File.GetTimeframe(),
 					file.File.GetRecordLength()) + int64(file.File.GetRecordLength())
 			}
-			if lastKnownOffset, ok := readhint.GetLastKnown(file.File.Path); ok {
-				hinted := lastKnownOffset + int64(file.File.GetRecordLength())
-				if hinted < endOffset {
-					endOffset = hinted
-				}
-			}
 			length = endOffset - startOffset
 			// Limit the scan to the end of the fixed length data
 			if length > maxLength {
 				length = maxLength
 			}
+			var skippedByHint int64
+			if lastKnownOffset, ok := readhint.GetLastKnown(file.File.Path, utils.InstanceConfig.LastKnownMaxAge); ok {
+				hinted := lastKnownOffset + int64(file.File.GetRecordLength())
+				if hinted-startOffset < length {
+					skippedByHint = length - (hinted - startOffset)
+					length = hinted - startOffset
+				}
+			}
 			fp := &ioFilePlan{
 				file.File,
 				startOffset,
 				length,
 				file.File.Path,
-				fileStartTime.Unix(),
+				fileStartTime.UnixNano(),
+				false,
 				false,
+				readSize,
+				skippedByHint,
+				inode,
 			}
-			if iop.Limit.Direction == LAST {
+			// The last record kept by a sampled read is not the last of the file
+			if iop.Limit.Direction == LAST && !iop.Limit.IsSample {
 				fp.seekingLast = true
 			}
-			iop.FilePlan = append(iop.FilePlan, fp)
+			// The stats of the file tell if it has records in the range
+			if cached {
+				if inRange[file.File.Path] {
+					iop.FilePlan = append(iop.FilePlan, fp)
+				}
+			} else if st := ReadFileStats(file.File.Path); st == nil || st.Overlaps(pr.Range.Start, pr.Range.End) {
+				iop.FilePlan = append(iop.FilePlan, fp)
+				planned = append(planned, file)
+				// Files written or without stats yet are not cached
+				cacheable = cacheable && st != nil
+			}
 			// in backward scan, tell the last known index for the later reader
 			// Add a previous file if we are at the beginning of the range
 			if file.File.Year == pr.Range.StartYear {
@@ -148,21 +541,106 @@ func NewIOPlan(fl SortedFileList, pr *planner.ParseResult) (iop *ioplan, err err
 						int64(Headersize),
 						length,
 						file.File.Path,
-						fileStartTime.Unix(),
+						fileStartTime.UnixNano(),
+						false,
 						false,
+						readSize,
+						0,
+						inode,
 					},
 				)
 			}
 		}
 	}
-	// Reverse the prevPath filelist order
-	for i := len(prevPaths) - 1; i >= 0; i-- {
-		iop.PrevFilePlan = append(iop.PrevFilePlan, prevPaths[i])
+	if !cached && cacheable {
+		plans.put(keyDir, pr.Range, generation, planned)
+	}
+	sortFilePlan(iop.FilePlan)
+	iop.PrevFilePlan = append(iop.PrevFilePlan, prevPaths...)
+	sortPrevFilePlan(iop.PrevFilePlan)
+	if iop.Limit.IsPercent {
+		if iop.Limit.Number < 0 || iop.Limit.Number > 100 {
+			return nil, fmt.Errorf("NewIOPlan: limit of %d percent is not within 0 and 100", iop.Limit.Number)
+		}
+		// The limit is shared by the plans of all keys, each gets its own row count
+		totalRows := iop.PlanSummary().Rows
+		limit := *iop.Limit
+		limit.Number = int32(float64(totalRows) * float64(iop.Limit.Number) / 100.0)
+		limit.IsPercent = false
+		iop.Limit = &limit
 	}
 	iop.TimeQuals = pr.TimeQuals
+	if iop.Limit.IsSample {
+		percent := iop.Limit.SamplePercent
+		if percent <= 0 || percent > 100 {
+			return nil, fmt.Errorf("NewIOPlan: sample of %v percent is not within 0 and 100", percent)
+		}
+		iop.TimeQuals = append(append([]planner.TimeQualFunc(nil), pr.TimeQuals...),
+			sampleQual(iop.Limit.SampleSeed, fl[0].Key, percent))
+	}
+	iop.setWarnings()
 	return iop, nil
 }
 
+// sampleQual keeps each record with a probability of percent. Each key draws
+// from its own source, seeded from the seed and the key, so the samples of
+// the keys of a query are independent and don't depend on the order the keys
+// are read in. Clones of the plan share the qualifier, hence the lock.
+func sampleQual(seed int64, key TimeBucketKey, percent float64) planner.TimeQualFunc {
+	hasher := fnv.New64a()
+	hasher.Write([]byte(key.String()))
+	rng := rand.New(rand.NewSource(seed + int64(hasher.Sum64())))
+	var mu sync.Mutex
+	return func(int64) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return rng.Float64() < percent/100
+	}
+}
+
+// createEmptyYearFile writes the year file of tbi with its header and only
+// null records if it is not on disk. The file is prepared aside and linked
+// into place, so concurrent readers never see it without its header.
+func createEmptyYearFile(tbi *TimeBucketInfo) error {
+	if _, err := os.Stat(tbi.Path); err == nil {
+		return nil
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(tbi.Path), ".create")
+	if err != nil {
+		Log(ERROR, "Read: creating %s\n%s", tbi.Path, err)
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+	if err = WriteHeader(tmp, tbi); err != nil {
+		return err
+	}
+	if err = tmp.Truncate(FileSize(tbi.GetTimeframe(), int(tbi.Year), int(tbi.GetRecordLength()))); err != nil {
+		return err
+	}
+	if err = os.Link(tmp.Name(), tbi.Path); err != nil && !os.IsExist(err) {
+		Log(ERROR, "Read: creating %s\n%s", tbi.Path, err)
+		return err
+	}
+	return nil
+}
+
+// openPlannedFile opens the year file of fp. A file known to the catalog but
+// not on disk yet, during the first write to its year, is created empty
+// unless the instance is read only.
+func openPlannedFile(fp *ioFilePlan) (*os.File, error) {
+	f, err := openYearFile(fp.tbi, fp.FullPath)
+	if os.IsNotExist(err) && !utils.InstanceConfig.ReadOnly && fp.tbi.GetStorageTier() != ColdTier {
+		if err = createEmptyYearFile(fp.tbi); err != nil {
+			return nil, err
+		}
+		return openYearFile(fp.tbi, fp.FullPath)
+	}
+	return f, err
+}
+
 type reader struct {
 	pr     planner.ParseResult
 	IOPMap map[TimeBucketKey]*ioplan
@@ -170,6 +648,7 @@ type reader struct {
 	// really ought to be somewhere close to the function...
 	readBuffer []byte
 	fileBuffer []byte
+	stats      ScanStats
 }
 
 func NewReader(pr *planner.ParseResult) (r *reader, err error) {
@@ -179,31 +658,175 @@ func NewReader(pr *planner.ParseResult) (r *reader, err error) {
 		pr.Range = planner.NewDateRange()
 	}
 
+	var maxRecordLen int32
+	if r.IOPMap, maxRecordLen, err = newIOPMap(pr); err != nil {
+		return nil, err
+	}
+	r.allocBuffers(maxRecordLen)
+	return r, nil
+}
+
+// NewBatchReader builds a single reader from several parse results, e.g. one
+// per leg of a multi-leg strategy, so that all of them are read in one call.
+// Plans addressing the same TimeBucketKey are merged, with overlapping file
+// ranges read only once.
+func NewBatchReader(prs []*planner.ParseResult) (r *reader, err error) {
+	if len(prs) == 0 {
+		return nil, fmt.Errorf("NewBatchReader: no parse results supplied")
+	}
+	r = new(reader)
+	r.pr = *prs[0]
+	r.pr.QualifiedFiles = nil
+	r.IOPMap = make(map[TimeBucketKey]*ioplan)
+	maxRecordLen := int32(0)
+	for _, pr := range prs {
+		if pr.Range == nil {
+			pr.Range = planner.NewDateRange()
+		}
+		iopMap, recordLen, err := newIOPMap(pr)
+		if err != nil {
+			return nil, err
+		}
+		for key, iop := range iopMap {
+			if prev, ok := r.IOPMap[key]; ok {
+				if iop, err = mergeIOPlans(prev, iop); err != nil {
+					return nil, err
+				}
+			}
+			r.IOPMap[key] = iop
+		}
+		if maxRecordLen < recordLen {
+			maxRecordLen = recordLen
+		}
+		r.pr.QualifiedFiles = append(r.pr.QualifiedFiles, pr.QualifiedFiles...)
+	}
+	r.allocBuffers(maxRecordLen)
+	return r, nil
+}
+
+func newIOPMap(pr *planner.ParseResult) (iopMap map[TimeBucketKey]*ioplan, maxRecordLen int32, err error) {
+	if err = checkReplica(pr); err != nil {
+		return nil, 0, err
+	}
 	sortedFileMap := make(map[TimeBucketKey]SortedFileList)
 	for _, qf := range pr.QualifiedFiles {
 		sortedFileMap[qf.Key] = append(sortedFileMap[qf.Key], qf)
 	}
-	r.IOPMap = make(map[TimeBucketKey]*ioplan)
-	maxRecordLen := int32(0)
+	iopMap = make(map[TimeBucketKey]*ioplan)
 	for key, sfl := range sortedFileMap {
 		sort.Sort(sfl)
-		if r.IOPMap[key], err = NewIOPlan(sfl, pr); err != nil {
-			return nil, err
+		if iopMap[key], err = NewIOPlan(sfl, pr, false); err != nil {
+			return nil, 0, err
 		}
-		recordLen := r.IOPMap[key].RecordLen
+		recordLen := iopMap[key].RecordLen
 		if maxRecordLen < recordLen {
 			maxRecordLen = recordLen
 		}
 	}
+	return iopMap, maxRecordLen, nil
+}
+
+func (r *reader) allocBuffers(maxRecordLen int32) {
 	// Number of bytes to buffer, some multiple of record length
 	// This should be at least bigger than 4096 and be better multiple of 4KB,
 	// which is the common io size on most of the storage/filesystem.
 	readSize := RecordsPerRead * maxRecordLen
 	r.readBuffer = make([]byte, readSize)
 	r.fileBuffer = make([]byte, readSize)
-	return r, nil
 }
 
+// forwardReadBuffer returns the buffer for a forward scan of the file, which
+// is at least as large as the read size suggested for its storage device.
+func (r *reader) forwardReadBuffer(fp *ioFilePlan, minSize int32) []byte {
+	size := int64(minSize)
+	if fp.SuggestedReadSize > size {
+		size = fp.SuggestedReadSize
+	}
+	if int64(len(r.readBuffer)) < size {
+		r.readBuffer = make([]byte, size)
+	}
+	return r.readBuffer[:size]
+}
+
+// mergeIOPlans combines two plans for the same TimeBucketKey. The previous
+// file plan used for tPrev is taken from whichever plan starts earlier.
+func mergeIOPlans(left, right *ioplan) (*ioplan, error) {
+	if left.RecordType != right.RecordType {
+		return nil, ErrRecordTypeMismatch
+	}
+	if left.RecordLen != right.RecordLen || left.SchemaVersion != right.SchemaVersion {
+		return nil, RecordLengthNotConsistent("NewBatchReader")
+	}
+	if left.Limit.Direction != right.Limit.Direction {
+		return nil, fmt.Errorf("NewBatchReader: can not merge plans with different limit directions")
+	}
+	if len(left.TimeQuals) > 0 || len(right.TimeQuals) > 0 {
+		return nil, fmt.Errorf("NewBatchReader: can not merge time qualified plans")
+	}
+	if !reflect.DeepEqual(left.ProjectionMask, right.ProjectionMask) {
+		return nil, fmt.Errorf("NewBatchReader: can not merge plans with different column filters")
+	}
+	merged := *left
+	merged.Limit = &planner.RowLimit{
+		Number:    left.Limit.Number,
+		Direction: left.Limit.Direction,
+	}
+	if right.Limit.Number > merged.Limit.Number {
+		merged.Limit.Number = right.Limit.Number
+	}
+	if planStartsBefore(right, left) {
+		merged.PrevFilePlan = right.PrevFilePlan
+	}
+	merged.FilePlan = mergeFilePlans(append(append([]*ioFilePlan{}, left.FilePlan...), right.FilePlan...))
+	merged.setWarnings()
+	return &merged, nil
+}
+
+// planStartsBefore reports whether the first byte read by plan a precedes that of plan b
+func planStartsBefore(a, b *ioplan) bool {
+	if len(a.FilePlan) == 0 {
+		return false
+	} else if len(b.FilePlan) == 0 {
+		return true
+	}
+	fa, fb := a.FilePlan[0], b.FilePlan[0]
+	if fa.BaseTime != fb.BaseTime {
+		return fa.BaseTime < fb.BaseTime
+	}
+	return fa.Offset < fb.Offset
+}
+
+// mergeFilePlans orders the file plans and coalesces the ones that overlap
+// within the same file, so no record is read twice.
+func mergeFilePlans(fps []*ioFilePlan) (merged []*ioFilePlan) {
+	sort.Slice(fps, func(i, j int) bool {
+		if fps[i].BaseTime != fps[j].BaseTime {
+			return fps[i].BaseTime < fps[j].BaseTime
+		}
+		return fps[i].Offset < fps[j].Offset
+	})
+	for _, fp := range fps {
+		if n := len(merged); n > 0 {
+			last := merged[n-1]
+			if last.FullPath == fp.FullPath && fp.Offset <= last.Offset+last.Length {
+				if end := fp.Offset + fp.Length; end > last.Offset+last.Length {
+					last.Length = end - last.Offset
+				}
+				last.seekingLast = last.seekingLast || fp.seekingLast
+				continue
+			}
+		}
+		fpCopy := *fp
+		merged = append(merged, &fpCopy)
+	}
+	return merged
+}
+
+// Read reads the data of every key of the reader. The keys are read in
+// parallel by up to read_workers workers, fewer if the MaxConcurrency of the
+// ParseResult is lower. If it allows partial results, the keys that fail are
+// left out of the results and listed in the *PartialReadError returned with
+// them.
 func (r *reader) Read() (csm ColumnSeriesMap, tPrevMap map[TimeBucketKey]int64, err error) {
 	csm = NewColumnSeriesMap()
 	tPrevMap = make(map[TimeBucketKey]int64)
@@ -211,20 +834,210 @@ func (r *reader) Read() (csm ColumnSeriesMap, tPrevMap map[TimeBucketKey]int64,
 	rtMap := r.pr.GetRowType()
 	dsMap := r.pr.GetDataShapes()
 	rlMap := r.pr.GetRowLen()
-	for key, iop := range r.IOPMap {
-		cat := catMap[key]
-		rt := rtMap[key]
-		rlen := rlMap[key]
-		buffer, tPrev, err := r.read(iop)
+	failed := make(map[TimeBucketKey]error)
+
+	var (
+		mu       sync.Mutex
+		firstErr error
+	)
+	readKey := func(w *reader, key TimeBucketKey) {
+		buffer, tPrev, err := w.read(key, r.IOPMap[key])
+		if err == ErrFileReplaced {
+			Log(INFO, "Read: a file of %s was replaced, reading it again", key.String())
+			r.IOPMap[key].restat()
+			buffer, tPrev, err = w.read(key, r.IOPMap[key])
+		}
+		var cs *ColumnSeries
+		if err == nil {
+			shapes, rowLen := dsMap[key], rlMap[key]
+			mask := r.IOPMap[key].ProjectionMask
+			if mask != nil && rtMap[key] == FIXED {
+				buffer, shapes, rowLen = projectRows(buffer, shapes, rowLen, mask)
+			}
+			rs := NewRowSeries(key, tPrev, buffer, shapes, rowLen, catMap[key], rtMap[key])
+			key, cs = rs.ToColumnSeries()
+			if mask != nil && rtMap[key] == VARIABLE {
+				// The rows of VARIABLE records are built by the read
+				err = cs.Project(projectedNames(shapes, mask))
+			}
+			if err == nil && r.pr.RequireNonEmpty && cs.Len() == 0 {
+				err = ErrEmptyResultSet{Key: key, Range: r.pr.Range}
+			}
+		}
+		mu.Lock()
+		defer mu.Unlock()
 		if err != nil {
-			return nil, nil, err
+			if firstErr == nil {
+				firstErr = err
+			}
+			failed[key] = err
+			return
 		}
 		tPrevMap[key] = tPrev
-		rs := NewRowSeries(key, tPrev, buffer, dsMap[key], rlen, cat, rt)
-		key, cs := rs.ToColumnSeries()
 		csm[key] = cs
 	}
-	return csm, tPrevMap, err
+	stop := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return firstErr != nil && !r.pr.AllowPartialResults
+	}
+
+	if workers := r.workers(); workers == 1 {
+		for key := range r.IOPMap {
+			if readKey(r, key); stop() {
+				break
+			}
+		}
+	} else {
+		// Each worker has its own buffers, the stats are added up once done
+		keys := make(chan TimeBucketKey)
+		var wg sync.WaitGroup
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func(w *reader) {
+				defer wg.Done()
+				for key := range keys {
+					readKey(w, key)
+				}
+				mu.Lock()
+				r.stats.add(w.stats)
+				mu.Unlock()
+			}(r.clone())
+		}
+		for key := range r.IOPMap {
+			if stop() {
+				break
+			}
+			keys <- key
+		}
+		close(keys)
+		wg.Wait()
+	}
+
+	if stop() {
+		return nil, nil, firstErr
+	}
+	if len(failed) > 0 {
+		return csm, tPrevMap, &PartialReadError{Failed: failed}
+	}
+	return csm, tPrevMap, nil
+}
+
+// workers returns the number of keys to read at once
+func (r *reader) workers() int {
+	n := runtime.NumCPU()
+	if utils.InstanceConfig.ReadWorkers > 0 {
+		n = utils.InstanceConfig.ReadWorkers
+	}
+	if r.pr.MaxConcurrency > 0 && r.pr.MaxConcurrency < n {
+		n = r.pr.MaxConcurrency
+	}
+	if n > len(r.IOPMap) {
+		n = len(r.IOPMap)
+	}
+	if n < 1 {
+		n = 1
+	}
+	return n
+}
+
+// clone returns a reader of the same plans with buffers of its own
+func (r *reader) clone() *reader {
+	return &reader{
+		pr:         r.pr,
+		IOPMap:     r.IOPMap,
+		readBuffer: make([]byte, len(r.readBuffer)),
+		fileBuffer: make([]byte, len(r.fileBuffer)),
+	}
+}
+
+// Stats returns the counters of the reads made by the reader so far
+func (r *reader) Stats() ScanStats {
+	return r.stats
+}
+
+// Warnings returns the warnings of the IO plans of the reader by key, keys
+// without warnings are left out.
+func (r *reader) Warnings() map[TimeBucketKey][]IOPlanWarning {
+	warnings := make(map[TimeBucketKey][]IOPlanWarning)
+	for key, iop := range r.IOPMap {
+		if len(iop.Warnings) > 0 {
+			warnings[key] = iop.Warnings
+		}
+	}
+	return warnings
+}
+
+// Explain returns the summary of the IO plan of each key of the reader.
+func (r *reader) Explain() map[TimeBucketKey]PlanSummary {
+	summaries := make(map[TimeBucketKey]PlanSummary, len(r.IOPMap))
+	for key, iop := range r.IOPMap {
+		summaries[key] = iop.PlanSummary()
+	}
+	return summaries
+}
+
+// GetRow reads the single record stored at epoch for the given key, using
+// one positioned read instead of building an IO plan. The returned RowSeries
+// is empty if there is no record at that time. Only FIXED record types are
+// supported.
+func GetRow(key TimeBucketKey, epoch int64) (*RowSeries, error) {
+	tbi, err := getTimeBucketInfoForYear(&key, int16(ToSystemTimezone(time.Unix(epoch, 0)).Year()))
+	if err != nil {
+		return nil, err
+	}
+	if tbi.GetRecordType() != FIXED {
+		return nil, fmt.Errorf("GetRow: point lookups are only supported for fixed records")
+	}
+	recordLen := tbi.GetRecordLength()
+	offset := EpochToOffset(epoch, tbi.GetTimeframe(), recordLen)
+
+	f, err := os.OpenFile(tbi.Path, os.O_RDONLY, 0666)
+	if err != nil {
+		Log(ERROR, "Read: opening %s\n%s", tbi.Path, err)
+		return nil, err
+	}
+	defer f.Close()
+
+	buffer := make([]byte, recordLen)
+	if _, err = f.ReadAt(buffer, offset); err != nil {
+		Log(ERROR, "Read: reading data from %s\n%s", tbi.Path, err)
+		return nil, err
+	}
+	index := int64(binary.LittleEndian.Uint64(buffer))
+	if index == 0 {
+		// Null record, nothing has been written at this time
+		buffer = buffer[:0]
+	} else {
+		binary.LittleEndian.PutUint64(buffer, uint64(IndexToTime(index, tbi.GetTimeframe(), tbi.Year).Unix()))
+	}
+	return NewRowSeries(key, 0, buffer, tbi.GetDataShapesWithEpoch(), int(recordLen), new(CandleAttributes), FIXED), nil
+}
+
+// getTimeBucketInfoForYear returns the TimeBucketInfo of the year file for key
+func getTimeBucketInfoForYear(key *TimeBucketKey, year int16) (*TimeBucketInfo, error) {
+	tbis, err := getTimeBucketInfos(key)
+	if err != nil {
+		return nil, err
+	}
+	for _, tbi := range tbis {
+		if tbi.Year == year {
+			return tbi, nil
+		}
+	}
+	return nil, fmt.Errorf("no data file for %s in year %d", key.String(), year)
+}
+
+// getTimeBucketInfos returns the TimeBucketInfo of every year file for key, sorted by year
+func getTimeBucketInfos(key *TimeBucketKey) ([]*TimeBucketInfo, error) {
+	subDir, err := ThisInstance.CatalogDir.GetOwningSubDirectory(
+		filepath.Join(key.GetPathToYearFiles(ThisInstance.CatalogDir.GetPath()), "1970.bin"))
+	if err != nil {
+		return nil, err
+	}
+	tbis := subDir.GetTimeBucketInfoSlice()
+	sort.Slice(tbis, func(i, j int) bool { return tbis[i].Year < tbis[j].Year })
+	return tbis, nil
 }
 
 /*
@@ -237,9 +1050,28 @@ type bufferMeta struct {
 	Intervals int64
 }
 
+// reverseBufferMeta reverses bufMeta in place, as a backward scan records the
+// files from the last to the first
+func reverseBufferMeta(bufMeta []bufferMeta) {
+	lenOF := len(bufMeta)
+	for i := 0; i < lenOF/2; i++ {
+		bufMeta[i], bufMeta[(lenOF-1)-i] = bufMeta[(lenOF-1)-i], bufMeta[i]
+	}
+}
+
 // Reads the data from files, removing holes. The resulting buffer will be packed
 // Uses the index that prepends each row to identify filled rows versus holes
-func (r *reader) read(iop *ioplan) (resultBuffer []byte, tPrev int64, err error) {
+func (r *reader) read(key TimeBucketKey, iop *ioplan) (resultBuffer []byte, tPrev int64, err error) {
+	if debugReads {
+		defer func(start time.Time) {
+			rowLen := int(iop.RecordLen)
+			if iop.RecordType == VARIABLE {
+				rowLen = iop.VariableRecordLen + 8
+			}
+			Log(DEBUG, "read complete: key=%v files=%d duration=%v rows=%d",
+				key.String(), len(iop.FilePlan), time.Since(start), len(resultBuffer)/rowLen)
+		}(time.Now())
+	}
 	const GatherTprev = true
 	// Number of bytes to buffer, some multiple of record length
 	// This should be at least bigger than 4096 and be better multiple of 4KB,
@@ -261,6 +1093,22 @@ func (r *reader) read(iop *ioplan) (resultBuffer []byte, tPrev int64, err error)
 	}
 
 	ex := newIoExec(iop)
+	if iop.Limit.Number != math.MaxInt32 && (direction == FIRST || direction == 0) {
+		// The scan stops at the limit instead of reading past it
+		ex.MaxRecords = int64(iop.Limit.Number)
+	}
+	ex.FailOnNaN = r.pr.FailOnNaN
+	defer func() {
+		if ex.stats.NaNCount > 0 || ex.stats.InfCount > 0 {
+			Log(WARNING, "Read: %s has %d NaN and %d infinite float values",
+				key.String(), ex.stats.NaNCount, ex.stats.InfCount)
+		}
+		r.stats.add(ex.stats)
+	}()
+	if direction == NEAREST {
+		resultBuffer, err = r.readNearest(ex, maxToBuffer)
+		return resultBuffer, 0, err
+	}
 
 	/*
 		if direction == FIRST
@@ -291,7 +1139,10 @@ func (r *reader) read(iop *ioplan) (resultBuffer []byte, tPrev int64, err error)
 				fp,
 				iop.RecordLen,
 				limitBytes,
-				readBuffer)
+				r.forwardReadBuffer(fp, maxToBuffer))
+			if err == ErrFileReplaced {
+				return nil, 0, err
+			}
 			if iop.RecordType == VARIABLE {
 				// If we've added data to the buffer from this file, record it for possible later use
 				if len(resultBuffer) > dataLen {
@@ -307,11 +1158,14 @@ func (r *reader) read(iop *ioplan) (resultBuffer []byte, tPrev int64, err error)
 				break
 			}
 		}
-		if GatherTprev {
+		if GatherTprev && (r.pr.Range == nil || r.pr.Range.Start == planner.MinEpoch) {
+			// No record can precede a range beginning at epoch 0
+			tPrev = 0
+		} else if GatherTprev {
 			// Set the default tPrev to the base time of the oldest file in the PrevPlan minus one minute
 			prevCount := len(iop.PrevFilePlan)
 			if prevCount > 0 {
-				tPrev = time.Unix(iop.PrevFilePlan[prevCount-1].BaseTime, 0).Add(-time.Duration(time.Minute)).UTC().Unix()
+				tPrev = time.Unix(0, iop.PrevFilePlan[prevCount-1].BaseTime).Add(-time.Duration(time.Minute)).UTC().Unix()
 			}
 			// Scan backward until we find the first previous time
 			// Scan the file at the beginning of the date range unless the range started at the file begin
@@ -389,10 +1243,7 @@ func (r *reader) read(iop *ioplan) (resultBuffer []byte, tPrev int64, err error)
 			Reverse the order of the files because the data was filled in reverse order
 		*/
 		if iop.RecordType == VARIABLE {
-			lenOF := len(bufMeta)
-			for i := 0; i < lenOF/2; i++ {
-				bufMeta[(lenOF-1)-i] = bufMeta[i]
-			}
+			reverseBufferMeta(bufMeta)
 		}
 
 		if GatherTprev {
@@ -425,34 +1276,206 @@ func (r *reader) read(iop *ioplan) (resultBuffer []byte, tPrev int64, err error)
 	return resultBuffer, tPrev, err
 }
 
+// readNearest returns the record closest in time to the start of the range,
+// which is either the first record of the forward scan of the plan or the
+// last record of the backward scan of its previous files. A record at the
+// start of the range is the closest, ties go to the earlier record. tPrev is
+// not gathered for these reads. Only FIXED record types are supported.
+func (r *reader) readNearest(ex *ioExec, maxToBuffer int32) ([]byte, error) {
+	iop := ex.plan
+	if iop.RecordType != FIXED {
+		return nil, fmt.Errorf("Nearest scan only supported for fixed records")
+	}
+	// The target is the start of the range
+	if r.pr.Range == nil {
+		return nil, ErrNoNearestTarget
+	}
+	target := r.pr.Range.Start
+
+	// Only the first record at or after the target is needed
+	ex.MaxRecords = 1
+	var after []byte
+	for _, fp := range iop.FilePlan {
+		var finished bool
+		var err error
+		after, finished, err = ex.readForward(after, fp, iop.RecordLen, iop.RecordLen,
+			r.forwardReadBuffer(fp, maxToBuffer))
+		if err != nil {
+			return nil, err
+		} else if finished {
+			break
+		}
+	}
+
+	var before []byte
+	for _, fp := range iop.PrevFilePlan {
+		buffer, finished, bytesRead, err := ex.readBackward(nil, fp, iop.RecordLen, iop.RecordLen,
+			r.readBuffer[:maxToBuffer], r.fileBuffer)
+		if finished {
+			if bytesRead != 0 {
+				before = buffer
+			}
+			break
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case len(after) == 0 && len(before) == 0:
+		return nil, ErrNoDataFound
+	case len(after) == 0:
+		return before, nil
+	case len(before) == 0:
+		return after, nil
+	}
+	afterDistance := int64(binary.LittleEndian.Uint64(after)) - target
+	beforeDistance := target - int64(binary.LittleEndian.Uint64(before))
+	if afterDistance < beforeDistance {
+		return after, nil
+	}
+	return before, nil
+}
+
 type ioExec struct {
 	plan *ioplan
+	// compiledQual ANDs the plan's TimeQuals, built once for the scan loop
+	compiledQual func(epoch int64) bool
+	stats        ScanStats
+	// MaxRecords stops the forward scans once that many records are read,
+	// whichever of it and the bytes to read is hit first, 0 for no limit
+	MaxRecords int64
+	// Records read by the forward scans so far
+	records int64
+	// FailOnNaN makes the scans fail on a NaN or infinite float value
+	// instead of counting it
+	FailOnNaN bool
+	// Float columns of the records, checked for NaN and infinite values
+	floatCols []floatColumn
+}
+
+// floatColumn is the position and type of a float column in a record
+type floatColumn struct {
+	offset int
+	typ    EnumElementType
+}
+
+// floatColumns returns the float columns of the records of a FIXED plan,
+// which follow the 8 byte epoch
+func floatColumns(iop *ioplan) (cols []floatColumn) {
+	if iop.RecordType != FIXED {
+		return nil
+	}
+	offset := 8
+	for _, typ := range iop.elementTypes {
+		switch typ {
+		case FLOAT16, FLOAT32, FLOAT64:
+			cols = append(cols, floatColumn{offset: offset, typ: typ})
+		}
+		offset += typ.Size()
+	}
+	return cols
+}
+
+// checkFloats counts the NaN and infinite values of the float columns of the
+// packed record, returning ErrNaNValue on the first one with FailOnNaN
+func (ex *ioExec) checkFloats(record []byte) error {
+	for _, col := range ex.floatCols {
+		var v float64
+		switch col.typ {
+		case FLOAT16:
+			if col.offset+2 > len(record) {
+				continue
+			}
+			v = float64(Float16ToFloat32(binary.LittleEndian.Uint16(record[col.offset:])))
+		case FLOAT32:
+			if col.offset+4 > len(record) {
+				continue
+			}
+			v = float64(math.Float32frombits(binary.LittleEndian.Uint32(record[col.offset:])))
+		case FLOAT64:
+			if col.offset+8 > len(record) {
+				continue
+			}
+			v = math.Float64frombits(binary.LittleEndian.Uint64(record[col.offset:]))
+		}
+		switch {
+		case math.IsNaN(v):
+			ex.stats.NaNCount++
+		case math.IsInf(v, 0):
+			ex.stats.InfCount++
+		default:
+			continue
+		}
+		if ex.FailOnNaN {
+			return ErrNaNValue
+		}
+	}
+	return nil
 }
 
-func (ex *ioExec) packingReader(packedBuffer *[]byte, f io.ReadSeeker, buffer []byte,
-	maxRead int64, fp *ioFilePlan) error {
-	// Reads data from file f positioned after the header
+// packingResult describes the records kept by a single packingReader call.
+// The offsets are byte positions in the original file and are -1 when no
+// record passed the qualifiers.
+type packingResult struct {
+	FirstValidOffset int64
+	LastValidOffset  int64
+	// Bytes read from the file
+	BytesRead int64
+	// Records kept
+	Records int64
+}
+
+func (ex *ioExec) packingReader(packedBuffer *[]byte, f io.ReaderAt, startPos int64, buffer []byte,
+	maxRead, maxRecords int64, fp *ioFilePlan) (res packingResult, err error) {
+	// Reads data from file f starting at offset startPos, which is after the header
+	// Reads are positioned, so f may be shared and its file position is left unchanged
 	// Will read records of size recordsize, decoding the index value to determine if this is a null or valid record
 	// The output is a buffer "packedBuffer" that contains only valid records
 	// The index value is converted to a UNIX Epoch timestamp based on the basetime and intervalsecs
 	// buffer is the temporary buffer to store read content from file, and indicates the maximum size to read
 	// maxRead limits the number of bytes to be read from the file
+	// maxRecords limits the number of records kept, 0 for no limit
 	// Exit conditions:
 	// ==> leftbytes <= 0
+	// ==> maxRecords records kept
+
+	recordSize := ex.plan.versionedRecordLen(fp.tbi.GetSchemaVersion())
+	// Records of files with an older schema are zero padded to the plan's length
+	padding := make([]byte, ex.plan.RecordLen-recordSize)
+	res = packingResult{FirstValidOffset: -1, LastValidOffset: -1}
 
-	recordSize := ex.plan.RecordLen
+	defer func() {
+		// Update lastKnown only once the first time
+		if fp.seekingLast && res.LastValidOffset >= 0 {
+			readhint.SetLastKnown(fp.FullPath, res.LastValidOffset)
+			fp.seekingLast = false
+		}
+	}()
 
 	var totalRead int64
 	for {
-		n, _ := f.Read(buffer)
+		// File offset of the first record in this buffer
+		bufferPos := startPos + totalRead
+		n, err := f.ReadAt(buffer, bufferPos)
+		// Some platforms return io.ErrUnexpectedEOF along with a partial read,
+		// whose whole records are kept and whose rest is read again
+		partial := err == io.ErrUnexpectedEOF && n > 0
+		if err != nil && err != io.EOF && !partial {
+			return res, fmt.Errorf("packingReader: reading at offset %d: %v", bufferPos, err)
+		}
+		if partial && n >= int(recordSize) {
+			n -= n % int(recordSize)
+		}
 
 		nn := int64(n)
 		totalRead += nn
+		res.BytesRead = totalRead
 		if nn == 0 {
 			// We are done reading
-			return nil
+			return res, nil
 		} else if nn < int64(recordSize) {
-			return fmt.Errorf("packingReader: Short read %d bytes, recordsize: %d bytes", n, recordSize)
+			return res, fmt.Errorf("packingReader: Short read %d bytes, recordsize: %d bytes", n, recordSize)
 		}
 		// Calculate how many are left to read
 		leftBytes := maxRead - totalRead
@@ -466,7 +1489,7 @@ func (ex *ioExec) packingReader(packedBuffer *[]byte, f io.ReadSeeker, buffer []
 		var i int64
 		for i = 0; i < numToRead; i++ {
 			curpos := i * int64(recordSize)
-			index := int64(binary.LittleEndian.Uint64(buffer[curpos:]))
+			index := recordIndex(buffer, curpos)
 			if index != 0 {
 				// Convert the index to a UNIX timestamp (seconds from epoch)
 				index = IndexToTime(index, fp.tbi.GetTimeframe(), fp.GetFileYear()).Unix()
@@ -475,21 +1498,26 @@ func (ex *ioExec) packingReader(packedBuffer *[]byte, f io.ReadSeeker, buffer []
 				}
 				idxpos := len(*packedBuffer)
 				*packedBuffer = append(*packedBuffer, buffer[curpos:curpos+int64(recordSize)]...)
+				*packedBuffer = append(*packedBuffer, padding...)
 				b := *packedBuffer
 				binary.LittleEndian.PutUint64(b[idxpos:], uint64(index))
+				if err = ex.checkFloats(b[idxpos : idxpos+int(recordSize)]); err != nil {
+					Log(ERROR, "Read: invalid float value in %s at offset %d", fp.FullPath, bufferPos+curpos)
+					return res, err
+				}
 
-				// Update lastKnown only once the first time
-				if fp.seekingLast {
-					if offset, err := f.Seek(0, os.SEEK_CUR); err == nil {
-						offset = offset - nn + i*int64(recordSize)
-						readhint.SetLastKnown(fp.FullPath, offset)
-					}
-					fp.seekingLast = false
+				if res.FirstValidOffset < 0 {
+					res.FirstValidOffset = bufferPos + curpos
+				}
+				res.LastValidOffset = bufferPos + curpos
+				if res.Records++; maxRecords > 0 && res.Records >= maxRecords {
+					res.BytesRead = bufferPos - startPos + curpos + int64(recordSize)
+					return res, nil
 				}
 			}
 		}
 		if leftBytes <= 0 {
-			return nil
+			return res, nil
 		}
 	}
 }
@@ -503,30 +1531,74 @@ func (ex *ioExec) readForward(finalBuffer []byte, fp *ioFilePlan, recordLen, byt
 		finalBuffer = make([]byte, 0, len(readBuffer))
 	}
 	// Forward scan
-	f, err := os.OpenFile(filePath, os.O_RDONLY, 0666)
+	f, err := openPlannedFile(fp)
 	if err != nil {
 		Log(ERROR, "Read: opening %s\n%s", filePath, err)
 		return nil, false, err
 	}
 	defer f.Close()
-
-	if _, err = f.Seek(fp.Offset, os.SEEK_SET); err != nil {
-		Log(ERROR, "Read: seeking in %s\n%s", filePath, err)
+	if err = checkFileReplaced(f, fp); err != nil {
 		return finalBuffer, false, err
 	}
 
-	if err = ex.packingReader(&finalBuffer, f, readBuffer, fp.Length, fp); err != nil {
-		Log(ERROR, "Read: reading data from %s\n%s", filePath, err)
-		return finalBuffer, false, err
+	// Make sure the data of some other file is not read as records
+	var magic [len(FileMagic)]byte
+	if _, err = f.ReadAt(magic[:], 0); err != nil || !HasFileMagic(magic[:]) {
+		Log(ERROR, "Read: %s is not a year file", filePath)
+		return finalBuffer, false, ErrInvalidFileFormat
+	}
+
+	if !fp.lengthValidated {
+		if err = validateFilePlanLength(fp); err != nil {
+			return finalBuffer, false, err
+		}
+	}
 
+	// Plans built by hand may begin within a record
+	offset, length := fp.Offset, fp.Length
+	recordSize := int64(ex.plan.versionedRecordLen(fp.tbi.GetSchemaVersion()))
+	if aligned := alignToRecord(offset, recordSize); aligned != offset {
+		Log(WARNING, "Read: offset %d of %s is not at a record boundary, reading from %d",
+			offset, filePath, aligned)
+		if length -= aligned - offset; length < 0 {
+			length = 0
+		}
+		offset = aligned
+	}
+
+	var maxRecords int64
+	if ex.MaxRecords > 0 {
+		if maxRecords = ex.MaxRecords - ex.records; maxRecords <= 0 {
+			return finalBuffer, true, nil
+		}
+	}
+	// The records may be copied from a mapping of the file instead of read
+	var data io.ReaderAt = f
+	if utils.InstanceConfig.UseMmap && mmapSupported {
+		m, err := mmapYearFile(f)
+		if err != nil {
+			return finalBuffer, false, err
+		}
+		defer m.Close()
+		data = m
 	}
+	readBuffer = ex.alignReadBuffer(readBuffer, fp)
+	start := time.Now()
+	res, err := ex.packingReader(&finalBuffer, data, offset, readBuffer, length, maxRecords, fp)
+	if err != nil {
+		readErrorLog.Log(ERROR, "Read: reading data from %s at offset %d\n%s", filePath, offset, err)
+		return finalBuffer, false, err
+	}
+	observeScanRate(f, res.BytesRead, time.Since(start))
+	ex.stats.BytesSkippedByHint += fp.skippedByHint
+	ex.records += res.Records
 	//			fmt.Printf("Length of final buffer: %d\n",len(finalBuffer))
 	if int32(len(finalBuffer)) >= bytesToRead {
 		//				fmt.Printf("Clipping final buffer: %d\n",limitBytes)
 		finalBuffer = finalBuffer[:bytesToRead]
 		return finalBuffer, true, nil
 	}
-	return finalBuffer, false, nil
+	return finalBuffer, ex.MaxRecords > 0 && ex.records >= ex.MaxRecords, nil
 }
 
 func (ex *ioExec) readBackward(finalBuffer []byte, fp *ioFilePlan,
@@ -536,38 +1608,56 @@ func (ex *ioExec) readBackward(finalBuffer []byte, fp *ioFilePlan,
 	filePath := fp.FullPath
 	beginPos := fp.Offset
 
+	readBuffer = ex.alignReadBuffer(readBuffer, fp)
 	maxToBuffer := int32(len(readBuffer))
 	if finalBuffer == nil {
 		finalBuffer = make([]byte, bytesToRead, bytesToRead)
 	}
 
-	f, err := os.OpenFile(filePath, os.O_RDONLY, 0666)
+	f, err := openPlannedFile(fp)
 	if err != nil {
 		Log(ERROR, "Read: opening %s\n%s", filePath, err)
 		return nil, false, 0, err
 	}
 	defer f.Close()
+	if err = checkFileReplaced(f, fp); err != nil {
+		return nil, false, 0, err
+	}
+
+	if !fp.lengthValidated {
+		if err = validateFilePlanLength(fp); err != nil {
+			return nil, false, 0, err
+		}
+	}
 
 	// Seek to the right end of the search set
 	f.Seek(beginPos+fp.Length, os.SEEK_SET)
 	// Seek backward one buffer size (max)
-	maxToRead, curpos, err := seekBackward(f, maxToBuffer, beginPos)
+	maxToRead, curpos, err := SeekBackward(f, maxToBuffer, beginPos)
 	if err != nil {
 		Log(ERROR, "Read: seeking within %s\n%s", filePath, err)
 		return nil, false, 0, err
 	}
+	// The scan begins at the end of the planned range, after the part cut by the hint
+	ex.stats.BytesSkippedByHint += fp.skippedByHint
 
+	var scanned int64
+	defer func(start time.Time) {
+		observeScanRate(f, scanned, time.Since(start))
+	}(time.Now())
 	for {
 		fileBuffer = fileBuffer[:0]
 		// Read a packed buffer of data max size maxToBuffer
-		if err = ex.packingReader(
+		var res packingResult
+		if res, err = ex.packingReader(
 			&fileBuffer,
-			f, readBuffer,
-			maxToRead, fp); err != nil {
+			f, curpos, readBuffer,
+			maxToRead, 0, fp); err != nil {
 
 			Log(ERROR, "Read: reading data from %s\n%s", filePath, err)
 			return nil, false, 0, err
 		}
+		scanned += res.BytesRead
 
 		numRead := int32(len(fileBuffer))
 
@@ -588,11 +1678,9 @@ func (ex *ioExec) readBackward(finalBuffer []byte, fp *ioFilePlan,
 			Check if current cursor has hit the left boundary (offset)
 		*/
 		if curpos != beginPos {
-			// Seek backward two buffers worth - one for the buffer we just read and one
-			// more backward to the new data
-			maxToRead, curpos, err = seekBackward(f, 2*maxToBuffer, beginPos)
-			// Subtract the previous buffer size
-			maxToRead -= int64(maxToBuffer)
+			// The reads are positioned, so the file is still at the start of the
+			// buffer we just read, seek backward one buffer to the new data
+			maxToRead, curpos, err = SeekBackward(f, maxToBuffer, beginPos)
 			// Exit the read operation if we get here with an error
 			if err != nil {
 				Log(ERROR, "Read: seeking within %s\n%s", filePath, err)
@@ -609,41 +1697,67 @@ func (ex *ioExec) readBackward(finalBuffer []byte, fp *ioFilePlan,
 	return finalBuffer, false, bytesRead, nil
 }
 
-func seekBackward(f io.Seeker, relative_offset int32, lowerBound int64) (seekAmt int64, curpos int64, err error) {
-	// Find the current file position
-	curpos, err = f.Seek(0, os.SEEK_CUR)
-	if err != nil {
-		Log(ERROR, "Read: cannot find current file position: %s", err)
-		return 0, curpos, err
+// alignToRecord rounds offset up to the beginning of the next record of a
+// year file with records of recordLen bytes
+func alignToRecord(offset, recordLen int64) int64 {
+	if offset <= Headersize {
+		return Headersize
 	}
-	// If seeking backward would go lower than the lower bound, seek to lower bound
-	if (curpos - int64(relative_offset)) <= int64(lowerBound) {
-		seekAmt = curpos - lowerBound
-	} else {
-		seekAmt = int64(relative_offset)
+	if rem := (offset - Headersize) % recordLen; rem != 0 {
+		offset += recordLen - rem
 	}
-	curpos, err = f.Seek(-seekAmt, os.SEEK_CUR)
-	if err != nil {
-		err = fmt.Errorf("Error: seeking to rel offset: %d lowerBound: %d | %s",
-			relative_offset, lowerBound, err)
-		return 0, curpos, err
-	}
-	return seekAmt, curpos, nil
+	return offset
+}
+
+// alignReadBuffer shortens readBuffer to a whole number of the file's
+// records, which are shorter than the plan's for files with an older schema.
+func (ex *ioExec) alignReadBuffer(readBuffer []byte, fp *ioFilePlan) []byte {
+	recordLen := int(ex.plan.versionedRecordLen(fp.tbi.GetSchemaVersion()))
+	return readBuffer[:len(readBuffer)/recordLen*recordLen]
 }
 
 func (ex *ioExec) checkTimeQuals(epoch int64) bool {
-	if len(ex.plan.TimeQuals) > 0 {
-		for _, timeQual := range ex.plan.TimeQuals {
-			if !timeQual(epoch) {
-				return false
-			}
-		}
+	return ex.compiledQual(epoch)
+}
+
+// compileTimeQuals returns a single function requiring every qual to hold.
+// Common counts are unrolled to avoid the loop in the per record path.
+func compileTimeQuals(quals []planner.TimeQualFunc) func(epoch int64) bool {
+	switch len(quals) {
+	case 0:
+		return func(int64) bool { return true }
+	case 1:
+		return quals[0]
+	case 2:
+		q0, q1 := quals[0], quals[1]
+		return func(epoch int64) bool { return q0(epoch) && q1(epoch) }
+	case 3:
+		q0, q1, q2 := quals[0], quals[1], quals[2]
+		return func(epoch int64) bool { return q0(epoch) && q1(epoch) && q2(epoch) }
+	default:
+		head := compileTimeQuals(quals[:3])
+		tail := compileTimeQuals(quals[3:])
+		return func(epoch int64) bool { return head(epoch) && tail(epoch) }
 	}
-	return true
 }
 
 func newIoExec(iop *ioplan) *ioExec {
 	return &ioExec{
-		plan: iop,
+		plan:         iop,
+		compiledQual: compileTimeQuals(iop.TimeQuals),
+		floatCols:    floatColumns(iop),
 	}
 }
+
+// WithTimeQuals returns a copy of the exec filtering with quals instead of
+// the TimeQuals of its plan, e.g. to read a plan with several filters. The
+// copy reads the same file plans up to the same number of records and
+// starts with no stats.
+func (ex *ioExec) WithTimeQuals(quals []planner.TimeQualFunc) *ioExec {
+	plan := *ex.plan
+	plan.TimeQuals = quals
+	copied := newIoExec(&plan)
+	copied.MaxRecords = ex.MaxRecords
+	copied.FailOnNaN = ex.FailOnNaN
+	return copied
+}
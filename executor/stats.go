@@ -0,0 +1,387 @@
+package executor
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/alpacahq/marketstore/utils/io"
+	. "github.com/alpacahq/marketstore/utils/log"
+)
+
+// FileStats summarizes the records of a year file. It is kept in a
+// {year}.stats sidecar next to the file so that queries can skip the files
+// holding no records in their range without opening them.
+type FileStats struct {
+	MinEpoch int64 `json:"min_epoch"`
+	// For VARIABLE records, the last second of the latest interval
+	MaxEpoch int64 `json:"max_epoch"`
+	RowCount int64 `json:"row_count"`
+	// Intervals of the year without a record
+	NullCount int64 `json:"null_count"`
+	ByteSize  int64 `json:"byte_size"`
+}
+
+// statsPath returns the path of the sidecar of the year file at path
+func statsPath(path string) string {
+	return strings.TrimSuffix(path, filepath.Ext(path)) + ".stats"
+}
+
+// Overlaps returns false if the file holds no record between the epochs
+// start and end
+func (st *FileStats) Overlaps(start, end int64) bool {
+	return st.RowCount > 0 && st.MinEpoch <= end && st.MaxEpoch >= start
+}
+
+// ReadFileStats returns the stats of the year file at path, nil if there are
+// none. Writers remove them before writing to the file, so they are current
+// while they exist.
+func ReadFileStats(path string) *FileStats {
+	data, err := ioutil.ReadFile(statsPath(path))
+	if err != nil {
+		return nil
+	}
+	st := new(FileStats)
+	if err = json.Unmarshal(data, st); err != nil {
+		return nil
+	}
+	fi, err := os.Stat(path)
+	if err != nil || fi.Size() != st.ByteSize {
+		return nil
+	}
+	return st
+}
+
+// writeFileStats replaces the sidecar of the year file at path, with the
+// byte size of the file as of now
+func writeFileStats(path string, st *FileStats) error {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	st.ByteSize = fi.Size()
+	data, err := json.Marshal(st)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".stats")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), statsPath(path))
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+var (
+	statsMu sync.Mutex
+	// fileGenerations is odd while this process writes to a year file, and
+	// grows by two with each write
+	fileGenerations = map[string]int64{}
+	// rebuilds holds the year files whose stats are being rebuilt, true if
+	// they were asked for again since
+	rebuilds = map[string]bool{}
+	// fileStatsRebuilds is done when no stats are being rebuilt
+	fileStatsRebuilds sync.WaitGroup
+)
+
+// fileStatsUpdate holds the stats of a year file as they will be once a
+// batch of writes is done
+type fileStatsUpdate struct {
+	path string
+	// nil if the stats are rebuilt once the writes are done
+	stats *FileStats
+}
+
+// fileGeneration returns the generation of the year file at path, which
+// changes with every write this process makes to it
+func fileGeneration(path string) int64 {
+	statsMu.Lock()
+	defer statsMu.Unlock()
+	return fileGenerations[path]
+}
+
+// newFileRewrite returns the update of the stats of the year file at path
+// for writes they can't follow, they are rebuilt once the writes are done
+func newFileRewrite(path string) *fileStatsUpdate {
+	return &fileStatsUpdate{path: path}
+}
+
+// newFileStatsUpdate applies the writes about to be made to the year file at
+// path to its stats. The file must not be written before this returns. If
+// the file has no current stats, or if null records clear slots that can't
+// be accounted for without a scan, the stats are rebuilt in the background
+// after the writes instead.
+func newFileStatsUpdate(path string, writes []offsetIndexBuffer) *fileStatsUpdate {
+	u := newFileRewrite(path)
+	fp, err := os.Open(path)
+	if err != nil {
+		return u
+	}
+	defer fp.Close()
+	tbi, err := NewTimeBucketInfoFromFile(path)
+	if err != nil {
+		return u
+	}
+	st := ReadFileStats(path)
+	if st == nil {
+		return u
+	}
+	fi, err := fp.Stat()
+	if err != nil {
+		return u
+	}
+
+	variable := tbi.GetRecordType() == VARIABLE
+	var varRecLen int64
+	slot := make([]byte, 8)
+	if variable {
+		if varRecLen, err = variableRowLen(tbi); err != nil {
+			return u
+		}
+		slot = make([]byte, 24)
+	}
+	tf := tbi.GetTimeframe()
+	minIndex, maxIndex := int64(0), int64(0)
+	if st.RowCount > 0 {
+		minIndex = EpochToIndex(st.MinEpoch, tf)
+		maxIndex = EpochToIndex(st.MaxEpoch, tf)
+	}
+	// The slots as they are after the writes so far, VARIABLE data is
+	// appended to the end of the file like WriteBufferToFileIndirect does
+	slots := map[int64]*IndirectRecordInfo{}
+	eof := fi.Size()
+	for _, buffer := range writes {
+		index := buffer.Index()
+		if index == 0 {
+			return u
+		}
+		current, filled := slots[index]
+		if !filled && st.RowCount > 0 && index >= minIndex && index <= maxIndex {
+			if _, err = fp.ReadAt(slot, buffer.Offset()); err != nil {
+				return u
+			}
+			if binary.LittleEndian.Uint64(slot) != 0 {
+				filled = true
+				current = &IndirectRecordInfo{Index: index}
+				if variable {
+					current.Offset = int64(binary.LittleEndian.Uint64(slot[8:]))
+					current.Len = int64(binary.LittleEndian.Uint64(slot[16:]))
+				}
+			}
+		}
+		if !filled {
+			st.NullCount--
+		}
+		if variable {
+			dataLen := int64(len(buffer.Payload()))
+			if filled && current.Offset+current.Len == eof {
+				current.Len += dataLen
+			} else {
+				if filled {
+					// The previous data of the interval is replaced
+					st.RowCount -= current.Len / varRecLen
+				}
+				current = &IndirectRecordInfo{Index: index, Offset: eof, Len: dataLen}
+			}
+			st.RowCount += dataLen / varRecLen
+			eof += dataLen
+		} else if !filled {
+			st.RowCount++
+			current = &IndirectRecordInfo{Index: index}
+		}
+		slots[index] = current
+		if minIndex == 0 || index < minIndex {
+			minIndex = index
+		}
+		if index > maxIndex {
+			maxIndex = index
+		}
+	}
+	st.MinEpoch, st.MaxEpoch = indexRangeToEpochs(tbi, minIndex, maxIndex)
+	u.stats = st
+	return u
+}
+
+// begin removes the stats of the file before the first write, so that they
+// are not read or rebuilt while it is written, and drops the cached plans of
+// its key
+func (u *fileStatsUpdate) begin() error {
+	statsMu.Lock()
+	defer statsMu.Unlock()
+	if err := os.Remove(statsPath(u.path)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	fileGenerations[u.path]++
+	plans.invalidate(u.path)
+	return nil
+}
+
+// save writes the stats once the writes are done
+func (u *fileStatsUpdate) save() {
+	statsMu.Lock()
+	fileGenerations[u.path]++
+	if u.stats == nil {
+		statsMu.Unlock()
+		rebuildFileStatsLater(u.path)
+		return
+	}
+	defer statsMu.Unlock()
+	if err := writeFileStats(u.path, u.stats); err != nil {
+		Log(ERROR, "Failed to write the stats of %s - Error: %v", u.path, err)
+		os.Remove(statsPath(u.path))
+	}
+}
+
+// abort rebuilds the stats once writes that failed part way are done
+func (u *fileStatsUpdate) abort() {
+	u.stats = nil
+	u.save()
+}
+
+// rebuildFileStatsLater rebuilds the stats of the year file at path in the
+// background, once more if they already are
+func rebuildFileStatsLater(path string) {
+	statsMu.Lock()
+	defer statsMu.Unlock()
+	if _, running := rebuilds[path]; running {
+		rebuilds[path] = true
+		return
+	}
+	rebuilds[path] = false
+	fileStatsRebuilds.Add(1)
+	go func() {
+		defer fileStatsRebuilds.Done()
+		for {
+			if err := rebuildFileStats(path); err != nil {
+				Log(ERROR, "Failed to build the stats of %s - Error: %v", path, err)
+			}
+			statsMu.Lock()
+			again := rebuilds[path]
+			if !again {
+				delete(rebuilds, path)
+			} else {
+				rebuilds[path] = false
+			}
+			statsMu.Unlock()
+			if !again {
+				return
+			}
+		}
+	}()
+}
+
+// rebuildFileStats scans the year file at path and writes its stats. They
+// are dropped if the file is written during the scan, the write saves them
+// once it is done.
+func rebuildFileStats(path string) error {
+	statsMu.Lock()
+	generation := fileGenerations[path]
+	statsMu.Unlock()
+	if generation%2 != 0 {
+		return nil
+	}
+	tbi, err := NewTimeBucketInfoFromFile(path)
+	if err != nil {
+		return err
+	}
+	st, err := buildFileStats(tbi)
+	if err != nil {
+		return err
+	}
+	statsMu.Lock()
+	defer statsMu.Unlock()
+	if fileGenerations[path] != generation {
+		return nil
+	}
+	return writeFileStats(path, st)
+}
+
+// buildFileStats scans the index area of the year file of tbi
+func buildFileStats(tbi *TimeBucketInfo) (*FileStats, error) {
+	fp, err := os.Open(tbi.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer fp.Close()
+	fi, err := fp.Stat()
+	if err != nil {
+		return nil, err
+	}
+	recordLen := int64(tbi.GetRecordLength())
+	var varRecLen int64
+	if tbi.GetRecordType() == VARIABLE {
+		if varRecLen, err = variableRowLen(tbi); err != nil {
+			return nil, err
+		}
+	}
+	st := new(FileStats)
+	end := FileSize(tbi.GetTimeframe(), int(tbi.Year), int(recordLen))
+	if end > fi.Size() {
+		end = fi.Size()
+	}
+	var minIndex, maxIndex int64
+	err = readIndex(fp, recordLen, end, func(offset int64, record []byte) error {
+		index := int64(binary.LittleEndian.Uint64(record))
+		if index == 0 {
+			st.NullCount++
+			return nil
+		}
+		if varRecLen != 0 {
+			// {Index, Offset, Len} of the interval data
+			st.RowCount += int64(binary.LittleEndian.Uint64(record[16:])) / varRecLen
+		} else {
+			st.RowCount++
+		}
+		if minIndex == 0 || index < minIndex {
+			minIndex = index
+		}
+		if index > maxIndex {
+			maxIndex = index
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	st.MinEpoch, st.MaxEpoch = indexRangeToEpochs(tbi, minIndex, maxIndex)
+	return st, nil
+}
+
+// variableRowLen returns the length of a row of VARIABLE records on disk,
+// the aligned row written with its epoch replaced by 4 bytes of ticks
+func variableRowLen(tbi *TimeBucketInfo) (int64, error) {
+	length, err := tbi.GetVariableRecordLength()
+	if err != nil {
+		return 0, err
+	}
+	return int64(AlignedSize(int(length)-4) + 4), nil
+}
+
+// indexRangeToEpochs returns the first and last epochs of the records
+// between the indexes
+func indexRangeToEpochs(tbi *TimeBucketInfo, minIndex, maxIndex int64) (int64, int64) {
+	if maxIndex == 0 {
+		return 0, 0
+	}
+	tf := tbi.GetTimeframe()
+	minEpoch := IndexToTime(minIndex, tf, tbi.Year).Unix()
+	maxEpoch := IndexToTime(maxIndex, tf, tbi.Year).Unix()
+	if tbi.GetRecordType() == VARIABLE {
+		maxEpoch += int64(tf/time.Second) - 1
+	}
+	return minEpoch, maxEpoch
+}
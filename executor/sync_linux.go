@@ -0,0 +1,27 @@
+package executor
+
+import (
+	"os"
+	"syscall"
+)
+
+// fdatasync flushes the data of f to disk, skipping metadata not needed to
+// read it back.
+func fdatasync(f *os.File) error {
+	return syscall.Fdatasync(int(f.Fd()))
+}
+
+// fileID returns the device and inode numbers of the file.
+func fileID(fi os.FileInfo) (dev, ino uint64) {
+	if st, ok := fi.Sys().(*syscall.Stat_t); ok {
+		return uint64(st.Dev), uint64(st.Ino)
+	}
+	return 0, 0
+}
+
+// processRunning tells if the process pid exists, even if it belongs to
+// another user.
+func processRunning(pid int) bool {
+	err := syscall.Kill(pid, 0)
+	return err == nil || err == syscall.EPERM
+}
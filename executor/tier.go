@@ -0,0 +1,333 @@
+package executor
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/alpacahq/marketstore/utils"
+	. "github.com/alpacahq/marketstore/utils/io"
+	. "github.com/alpacahq/marketstore/utils/log"
+)
+
+// StorageBackend opens the year files of a storage tier for reading
+type StorageBackend interface {
+	// Open opens the year file at path in the root directory
+	Open(path string) (*os.File, error)
+}
+
+// localStorage holds the hot year files, in the root directory
+type localStorage struct{}
+
+func (localStorage) Open(path string) (*os.File, error) {
+	return os.OpenFile(path, os.O_RDONLY, 0666)
+}
+
+// DirectoryStorage holds the cold year files in a directory mirroring the
+// root directory, e.g. an S3 or GCS bucket mounted with a FUSE file system.
+// The file in the root directory is replaced by a link to the cold one, so
+// that it is still found by the catalog and written through.
+type DirectoryStorage struct {
+	RootDir string
+	Dir     string
+}
+
+// Path returns the path of the cold copy of the year file at path in the
+// root directory
+func (ds *DirectoryStorage) Path(path string) (string, error) {
+	rel, err := filepath.Rel(ds.RootDir, path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(ds.Dir, rel), nil
+}
+
+// Open opens the cold copy of the year file directly, without going through
+// the link in the root directory
+func (ds *DirectoryStorage) Open(path string) (*os.File, error) {
+	coldPath, err := ds.Path(path)
+	if err != nil {
+		return nil, err
+	}
+	return os.OpenFile(coldPath, os.O_RDONLY, 0666)
+}
+
+// coldStorage returns the cold storage of the configuration, nil if none
+func coldStorage() *DirectoryStorage {
+	if utils.InstanceConfig.ColdStorageDirectory == "" {
+		return nil
+	}
+	return &DirectoryStorage{RootDir: ThisInstance.RootDir, Dir: utils.InstanceConfig.ColdStorageDirectory}
+}
+
+// storageBackend returns the backend of the files of tier
+func storageBackend(tier StorageTier) StorageBackend {
+	if tier == ColdTier {
+		if cs := coldStorage(); cs != nil {
+			return cs
+		}
+	}
+	return localStorage{}
+}
+
+// openYearFile opens the year file at path of tbi for reading from its tier
+func openYearFile(tbi *TimeBucketInfo, path string) (*os.File, error) {
+	return storageBackend(tbi.GetStorageTier()).Open(path)
+}
+
+// PromoteYearFile moves the year file of the bucket at key for year to the
+// cold storage. The file is copied again if it is written during the copy,
+// and ErrYearFileBusy is returned if it keeps being written.
+func PromoteYearFile(key TimeBucketKey, year int16) error {
+	return changeTier(key, year, promoteYearFile)
+}
+
+// DemoteYearFile moves the year file of the bucket at key for year back
+// from the cold storage to the root directory, copying it like
+// PromoteYearFile.
+func DemoteYearFile(key TimeBucketKey, year int16) error {
+	return changeTier(key, year, demoteYearFile)
+}
+
+func changeTier(key TimeBucketKey, year int16, move func(*TimeBucketInfo) error) error {
+	if err := checkWritable(); err != nil {
+		return err
+	}
+	if coldStorage() == nil {
+		return ErrNoColdStorage
+	}
+	tbi, err := yearFileInfo(key, year)
+	if err != nil {
+		return err
+	}
+	return move(tbi)
+}
+
+// yearFileInfo returns the TimeBucketInfo of the year file of the bucket at
+// key for year
+func yearFileInfo(key TimeBucketKey, year int16) (*TimeBucketInfo, error) {
+	path := filepath.Join(key.GetPathToYearFiles(ThisInstance.RootDir), fmt.Sprintf("%d.bin", year))
+	for _, tbi := range ThisInstance.CatalogDir.GatherTimeBucketInfo() {
+		if tbi.Path == path {
+			return tbi, nil
+		}
+	}
+	return nil, fmt.Errorf("no year file %d for %s", year, key.String())
+}
+
+// Times a year file is copied between tiers before giving up, when it keeps
+// being written during the copy
+const tierCopyAttempts = 3
+
+// copyUnchanged copies the file at src to dst and calls commit, provided the
+// year file at path was not written during the copy. Writes are not held up
+// by the copy, which can be slow to a cold mount; a file written meanwhile
+// is copied again. The check and commit are made while no write is applied,
+// so that no write is lost to the switch.
+func copyUnchanged(path, src, dst string, commit func() error) error {
+	for attempt := 0; attempt < tierCopyAttempts; attempt++ {
+		generation := fileGeneration(path)
+		if err := copyFile(src, dst); err != nil {
+			return err
+		}
+		if committed, err := commitUnchanged(path, generation, commit); committed || err != nil {
+			return err
+		}
+		Log(INFO, "Tier: %s was written during the copy, copying it again", path)
+	}
+	os.Remove(dst)
+	return ErrYearFileBusy
+}
+
+// commitUnchanged calls commit if the year file at path is still at
+// generation, telling if it did
+func commitUnchanged(path string, generation int64, commit func() error) (bool, error) {
+	primaryWrites.Lock()
+	defer primaryWrites.Unlock()
+	if generation%2 != 0 || fileGeneration(path) != generation {
+		return false, nil
+	}
+	return true, commit()
+}
+
+// promoteYearFile copies the year file of tbi to the cold storage and
+// replaces it by a link to the copy
+func promoteYearFile(tbi *TimeBucketInfo) error {
+	if tbi.GetStorageTier() == ColdTier {
+		return nil
+	}
+	coldPath, err := coldStorage().Path(tbi.Path)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(coldPath), 0700); err != nil {
+		return err
+	}
+	return copyUnchanged(tbi.Path, tbi.Path, coldPath, func() error {
+		if err := linkColdFile(tbi.Path, coldPath); err != nil {
+			return err
+		}
+		Log(INFO, "Tier: moved %s to %s", tbi.Path, coldPath)
+		return ThisInstance.CatalogDir.SetStorageTier(tbi.Path, ColdTier)
+	})
+}
+
+// linkColdFile replaces the file at path by a link to coldPath. The link is
+// renamed over the file for readers to always find one.
+func linkColdFile(path, coldPath string) error {
+	linkPath := path + ".tier"
+	os.Remove(linkPath)
+	if err := os.Symlink(coldPath, linkPath); err != nil {
+		return err
+	}
+	if err := os.Rename(linkPath, path); err != nil {
+		os.Remove(linkPath)
+		return err
+	}
+	return nil
+}
+
+// moveColdYearFiles moves the cold copies of the year files of tbis to where
+// they belong once their bucket is renamed to newPath, and relinks them. It
+// returns a function moving them back.
+func moveColdYearFiles(tbis []*TimeBucketInfo, newPath string) (undo func(), err error) {
+	type move struct{ path, from, to string }
+	var moved []move
+	undo = func() {
+		for i := len(moved) - 1; i >= 0; i-- {
+			m := moved[i]
+			if err := os.Rename(m.to, m.from); err != nil {
+				Log(ERROR, "Tier: moving %s back to %s - Error: %v", m.to, m.from, err)
+				continue
+			}
+			if err := linkColdFile(m.path, m.from); err != nil {
+				Log(ERROR, "Tier: linking %s to %s - Error: %v", m.path, m.from, err)
+			}
+		}
+	}
+	for _, tbi := range tbis {
+		if tbi.GetStorageTier() != ColdTier {
+			continue
+		}
+		cs := coldStorage()
+		if cs == nil {
+			undo()
+			return nil, ErrNoColdStorage
+		}
+		m := move{path: tbi.Path}
+		if m.from, err = cs.Path(tbi.Path); err == nil {
+			m.to, err = cs.Path(filepath.Join(newPath, filepath.Base(tbi.Path)))
+		}
+		if err == nil {
+			err = os.MkdirAll(filepath.Dir(m.to), 0700)
+		}
+		if err == nil {
+			err = os.Rename(m.from, m.to)
+		}
+		if err != nil {
+			undo()
+			return nil, err
+		}
+		moved = append(moved, m)
+		if err = linkColdFile(m.path, m.to); err != nil {
+			undo()
+			return nil, err
+		}
+	}
+	return undo, nil
+}
+
+// demoteYearFile copies the cold year file of tbi back over its link in the
+// root directory and removes it from the cold storage
+func demoteYearFile(tbi *TimeBucketInfo) error {
+	if tbi.GetStorageTier() == HotTier {
+		return nil
+	}
+	coldPath, err := coldStorage().Path(tbi.Path)
+	if err != nil {
+		return err
+	}
+	err = copyUnchanged(tbi.Path, coldPath, tbi.Path+".tier", func() error {
+		if err := os.Rename(tbi.Path+".tier", tbi.Path); err != nil {
+			os.Remove(tbi.Path + ".tier")
+			return err
+		}
+		return ThisInstance.CatalogDir.SetStorageTier(tbi.Path, HotTier)
+	})
+	if err != nil {
+		return err
+	}
+	Log(INFO, "Tier: moved %s back from %s", tbi.Path, coldPath)
+	return os.Remove(coldPath)
+}
+
+// copyFile copies the file at src to dst through a temporary file synced
+// and renamed, so that dst is never seen partly written
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	tmpPath := dst + ".tmp"
+	out, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err == nil {
+		err = out.Sync()
+	}
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, dst)
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+	}
+	return err
+}
+
+// promoteColdYears moves the year files older than coldAfterYears to the
+// cold storage one at a time, returning the number of files moved. It
+// returns early once stop is closed.
+func promoteColdYears(coldAfterYears int, stop <-chan struct{}) int {
+	lastHotYear := time.Now().UTC().Year() - coldAfterYears
+	var promoted int
+	for _, tbi := range ThisInstance.CatalogDir.GatherTimeBucketInfo() {
+		select {
+		case <-stop:
+			return promoted
+		default:
+		}
+		if int(tbi.Year) >= lastHotYear || tbi.GetStorageTier() == ColdTier {
+			continue
+		}
+		if err := promoteYearFile(tbi); err != nil {
+			Log(ERROR, "Tier: %s - Error: %v", tbi.Path, err)
+			continue
+		}
+		promoted++
+	}
+	return promoted
+}
+
+// promoteColdYearsDaily moves the year files getting old to the cold storage
+// now and then once a day, until stop is closed
+func promoteColdYearsDaily(coldAfterYears int, stop <-chan struct{}) {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+	for {
+		if promoted := promoteColdYears(coldAfterYears, stop); promoted > 0 {
+			Log(INFO, "Moved %d year files to the cold storage", promoted)
+		}
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package executor
+
+import (
+	"os"
+	"syscall"
+)
+
+// diskUsage returns the bytes allocated to the file at path, which are fewer
+// than its size when it has holes
+func diskUsage(path string) int64 {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	if st, ok := fi.Sys().(*syscall.Stat_t); ok {
+		return st.Blocks * 512
+	}
+	return fi.Size()
+}
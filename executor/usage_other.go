@@ -0,0 +1,18 @@
+//go:build !linux
+// +build !linux
+
+package executor
+
+import (
+	"os"
+)
+
+// diskUsage returns the size of the file at path, the space allocated to
+// it is not known here
+func diskUsage(path string) int64 {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return fi.Size()
+}
@@ -0,0 +1,260 @@
+package executor
+
+import (
+	"encoding/binary"
+	"fmt"
+	stdio "io"
+	"os"
+	"time"
+
+	"github.com/alpacahq/marketstore/executor/readhint"
+	"github.com/alpacahq/marketstore/utils"
+	. "github.com/alpacahq/marketstore/utils/io"
+	. "github.com/alpacahq/marketstore/utils/log"
+)
+
+// VacuumProgress reports the vacuum of a year file
+type VacuumProgress struct {
+	Path string `json:"path"`
+	// Share of the intervals of the file without a record
+	NullRatio float64 `json:"null_ratio"`
+	// Disk space used by the file before and after the vacuum
+	BytesBefore int64  `json:"bytes_before"`
+	BytesAfter  int64  `json:"bytes_after"`
+	Error       string `json:"error,omitempty"`
+}
+
+// Vacuum compacts the year files of which more than nullRatio of the
+// intervals hold no record, calling progress once each file is done. The
+// records keep their positions, the empty intervals of the new file are
+// left unallocated and the data of VARIABLE records no longer referenced,
+// e.g. replaced by later writes, is dropped. Writes go on during the vacuum,
+// each file is built aside and renamed over the original if it was not
+// written in the meantime. A file that fails is reported to progress and
+// left as it was.
+func Vacuum(nullRatio float64, progress func(VacuumProgress)) error {
+	if nullRatio < 0 || nullRatio >= 1 {
+		return fmt.Errorf("Vacuum: null ratio must be within [0, 1), got %v", nullRatio)
+	}
+	if err := checkWritable(); err != nil {
+		return err
+	}
+	for _, tbi := range ThisInstance.CatalogDir.GatherTimeBucketInfo() {
+		// The compacted file would replace the link to the cold one
+		if tbi.GetStorageTier() == ColdTier {
+			continue
+		}
+		ratio, err := yearFileNullRatio(tbi)
+		if err != nil {
+			Log(ERROR, "Vacuum: %s - Error: %v", tbi.Path, err)
+			progress(VacuumProgress{Path: tbi.Path, Error: err.Error()})
+			continue
+		}
+		if ratio <= nullRatio {
+			continue
+		}
+		vp, err := vacuumYearFile(tbi, ratio)
+		if err != nil {
+			Log(ERROR, "Vacuum: %s - Error: %v", tbi.Path, err)
+			vp.Error = err.Error()
+		}
+		progress(vp)
+	}
+	return nil
+}
+
+// yearFileNullRatio returns the share of the intervals of the year file of
+// tbi without a record
+func yearFileNullRatio(tbi *TimeBucketInfo) (float64, error) {
+	fp, err := os.Open(tbi.Path)
+	if err != nil {
+		return 0, err
+	}
+	defer fp.Close()
+	recordLen := int64(tbi.GetRecordLength())
+	end := FileSize(tbi.GetTimeframe(), int(tbi.Year), int(recordLen))
+	var nulls int64
+	err = readIndex(fp, recordLen, end, func(offset int64, record []byte) error {
+		if binary.LittleEndian.Uint64(record) == 0 {
+			nulls++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return float64(nulls) / float64((end-Headersize)/recordLen), nil
+}
+
+// readIndex calls fn with each record of the index area of the year file
+// open as fp, up to end, reading RecordsPerRead records at a time. A record
+// is only valid until fn returns. ErrInvalidFileFormat is returned if the
+// file ends before the index area does.
+func readIndex(fp *os.File, recordLen, end int64, fn func(offset int64, record []byte) error) error {
+	buffer := make([]byte, RecordsPerRead*recordLen)
+	for offset := int64(Headersize); offset+recordLen <= end; {
+		chunk := buffer
+		if rest := (end - offset) / recordLen * recordLen; rest < int64(len(chunk)) {
+			chunk = chunk[:rest]
+		}
+		if _, err := fp.ReadAt(chunk, offset); err == stdio.EOF {
+			return ErrInvalidFileFormat
+		} else if err != nil {
+			return err
+		}
+		for i := int64(0); i < int64(len(chunk)); i += recordLen {
+			if err := fn(offset+i, chunk[i:i+recordLen]); err != nil {
+				return err
+			}
+		}
+		offset += int64(len(chunk))
+	}
+	return nil
+}
+
+// Times a year file is compacted before giving up, when it keeps being
+// written during the compaction
+const vacuumAttempts = 3
+
+// vacuumYearFile compacts the year file of tbi, of which ratio of the
+// intervals are empty. Writes go on during the compaction, a file written
+// meanwhile is compacted again. Only the switch to the compacted file waits
+// for the writes being applied, and for writes to be resumed if they are
+// paused.
+func vacuumYearFile(tbi *TimeBucketInfo, ratio float64) (vp VacuumProgress, err error) {
+	vp = VacuumProgress{Path: tbi.Path, NullRatio: ratio, BytesBefore: diskUsage(tbi.Path)}
+	tmpPath := tbi.Path + ".vacuum"
+	for attempt := 0; attempt < vacuumAttempts; attempt++ {
+		generation := fileGeneration(tbi.Path)
+		lastOffset, err := compactYearFile(tbi, tmpPath)
+		if err != nil {
+			return vp, err
+		}
+		committed, err := commitVacuum(tbi.Path, tmpPath, generation)
+		if err != nil {
+			os.Remove(tmpPath)
+			return vp, err
+		} else if committed {
+			if lastOffset >= 0 {
+				readhint.SetLastKnown(tbi.Path, lastOffset)
+			}
+			vp.BytesAfter = diskUsage(tbi.Path)
+			return vp, nil
+		}
+		os.Remove(tmpPath)
+		Log(INFO, "Vacuum: %s was written during the compaction, compacting it again", tbi.Path)
+	}
+	return vp, ErrYearFileBusy
+}
+
+// commitVacuum renames the compacted file at tmpPath over the year file at
+// path, provided the year file is still at generation, telling if it did
+func commitVacuum(path, tmpPath string, generation int64) (bool, error) {
+	if err := beginWrite(); err != nil {
+		return false, err
+	}
+	defer endWrite()
+	return commitUnchanged(path, generation, func() error {
+		rewrite := newFileRewrite(path)
+		if err := rewrite.begin(); err != nil {
+			return err
+		}
+		defer rewrite.save()
+		return os.Rename(tmpPath, path)
+	})
+}
+
+// compactYearFile writes the records of the year file of tbi to a new file
+// at tmpPath, returning the offset of the last one, -1 if there is none
+func compactYearFile(tbi *TimeBucketInfo, tmpPath string) (lastOffset int64, err error) {
+	src, err := os.Open(tbi.Path)
+	if err != nil {
+		return -1, err
+	}
+	defer src.Close()
+	fi, err := src.Stat()
+	if err != nil {
+		return -1, err
+	}
+	recordLen := int64(tbi.GetRecordLength())
+	end := FileSize(tbi.GetTimeframe(), int(tbi.Year), int(recordLen))
+	header := make([]byte, Headersize)
+	if _, err = src.ReadAt(header, 0); err != nil {
+		return -1, err
+	}
+
+	fp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0600)
+	if err != nil {
+		return -1, err
+	}
+	lastOffset = -1
+	err = func() error {
+		defer fp.Close()
+		if _, err := fp.Write(header); err != nil {
+			return err
+		}
+		if err := fp.Truncate(end); err != nil {
+			return err
+		}
+		// VARIABLE data is appended after the index like the writer does
+		eof := end
+		var payload []byte
+		err := readIndex(src, recordLen, end, func(offset int64, record []byte) error {
+			if binary.LittleEndian.Uint64(record) == 0 {
+				return nil
+			}
+			if tbi.GetRecordType() == VARIABLE {
+				// {Index, Offset, Len} of the interval data
+				dataOffset := binary.LittleEndian.Uint64(record[8:])
+				dataLen := binary.LittleEndian.Uint64(record[16:])
+				if dataOffset+dataLen > uint64(fi.Size()) {
+					return fmt.Errorf("data of the record at %d is past the end of the file", offset)
+				}
+				if uint64(cap(payload)) < dataLen {
+					payload = make([]byte, dataLen)
+				}
+				payload = payload[:dataLen]
+				if _, err := src.ReadAt(payload, int64(dataOffset)); err != nil {
+					return err
+				}
+				if _, err := fp.WriteAt(payload, eof); err != nil {
+					return err
+				}
+				binary.LittleEndian.PutUint64(record[8:], uint64(eof))
+				eof += int64(dataLen)
+			}
+			if _, err := fp.WriteAt(record, offset); err != nil {
+				return err
+			}
+			lastOffset = offset
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		return fp.Sync()
+	}()
+	if err != nil {
+		os.Remove(tmpPath)
+		return -1, err
+	}
+	return lastOffset, nil
+}
+
+// vacuumEvery vacuums the year files at every interval of the policy
+func vacuumEvery(policy utils.VacuumPolicy) {
+	for range time.Tick(policy.Interval) {
+		start := time.Now()
+		var vacuumed int
+		err := Vacuum(policy.NullRatio, func(vp VacuumProgress) {
+			if vp.Error == "" {
+				vacuumed++
+			}
+		})
+		if err != nil {
+			Log(ERROR, "Vacuum: %v", err)
+			continue
+		}
+		Log(INFO, "Vacuumed %d year files in %v", vacuumed, time.Since(start))
+	}
+}
@@ -0,0 +1,314 @@
+package executor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/alpacahq/marketstore/planner"
+	"github.com/alpacahq/marketstore/utils"
+	. "github.com/alpacahq/marketstore/utils/io"
+	. "github.com/alpacahq/marketstore/utils/log"
+)
+
+// ViewDefinitionName is the file holding the definition of a view, kept in
+// the directory of the view bucket next to its year files
+const ViewDefinitionName = "view.json"
+
+// AggSpec is how the columns of the source bucket are aggregated into the
+// intervals of a view, the columns not in Method are left out of the view
+type AggSpec struct {
+	Method AggMethod `json:"method"`
+}
+
+// ViewMetadata is the definition of a materialized view
+type ViewMetadata struct {
+	ViewKey         TimeBucketKey `json:"view_key"`
+	SourceKey       TimeBucketKey `json:"source_key"`
+	Agg             AggSpec       `json:"agg"`
+	RefreshInterval time.Duration `json:"refresh_interval"`
+}
+
+// view is a materialized view kept up to date by its refresh goroutine
+type view struct {
+	ViewMetadata
+	stop chan struct{}
+	done chan struct{}
+}
+
+var (
+	viewsMu sync.Mutex
+	views   = map[TimeBucketKey]*view{}
+)
+
+// MaterializeView creates the bucket at viewKey holding the records of
+// sourceKey resampled to the timeframe of viewKey with agg. The view is
+// populated before MaterializeView returns, then every refreshInterval the
+// intervals of the view covering the records written to the source since
+// the last refresh are recomputed in the background. The definition is kept
+// with the view bucket, so the view is refreshed again once the instance is
+// restarted. ErrIncompatibleTimeframe is returned when the timeframe of
+// viewKey can not be built from whole intervals of the source.
+func MaterializeView(viewKey, sourceKey TimeBucketKey, agg AggSpec, refreshInterval time.Duration) error {
+	if err := checkWritable(); err != nil {
+		return err
+	}
+	if viewKey == sourceKey {
+		return fmt.Errorf("MaterializeView: %s can not be a view of itself", viewKey.String())
+	}
+	if refreshInterval <= 0 {
+		return fmt.Errorf("MaterializeView: refresh interval must be positive")
+	}
+	if len(agg.Method) == 0 {
+		return fmt.Errorf("MaterializeView: no columns to aggregate")
+	}
+	sourceTbi, err := ThisInstance.CatalogDir.GetLatestTimeBucketInfoFromKey(&sourceKey)
+	if err != nil {
+		return err
+	}
+	sourceTf, err := sourceKey.GetTimeFrame()
+	if err != nil {
+		return err
+	}
+	tf, err := viewKey.GetTimeFrame()
+	if err != nil {
+		return err
+	}
+	if tf.Duration < sourceTf.Duration || tf.Duration%sourceTf.Duration != 0 || tf.Duration > utils.Day {
+		return ErrIncompatibleTimeframe
+	}
+	// The view has the aggregated columns in the order of the source
+	shapes := []DataShape{{Name: "Epoch", Type: INT64}}
+	for _, ds := range sourceTbi.GetDataShapes() {
+		if _, ok := agg.Method[ds.Name]; ok && ds.Name != "Epoch" {
+			shapes = append(shapes, ds)
+		}
+	}
+	if len(shapes)-1 != len(agg.Method) {
+		return fmt.Errorf("MaterializeView: %v", ErrColumnNotFound)
+	}
+
+	viewsMu.Lock()
+	defer viewsMu.Unlock()
+	if _, ok := views[viewKey]; ok {
+		return ErrViewExists
+	}
+	if err = CreateBucket(viewKey, shapes, *tf, FIXED); err != nil {
+		return err
+	}
+	v := newView(ViewMetadata{
+		ViewKey:         viewKey,
+		SourceKey:       sourceKey,
+		Agg:             agg,
+		RefreshInterval: refreshInterval,
+	})
+	err = writeViewDefinition(&v.ViewMetadata)
+	if err == nil {
+		err = v.start(false)
+	}
+	if err != nil {
+		ThisInstance.CatalogDir.RemoveTimeBucket(&viewKey)
+		return err
+	}
+	views[viewKey] = v
+	return nil
+}
+
+// ListViews returns the definitions of the materialized views, sorted by
+// the key of the view
+func ListViews() ([]ViewMetadata, error) {
+	viewsMu.Lock()
+	defer viewsMu.Unlock()
+	list := make([]ViewMetadata, 0, len(views))
+	for _, v := range views {
+		list = append(list, v.ViewMetadata)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ViewKey.String() < list[j].ViewKey.String() })
+	return list, nil
+}
+
+// DropView stops refreshing the view at viewKey and removes its bucket
+func DropView(viewKey TimeBucketKey) error {
+	if err := checkWritable(); err != nil {
+		return err
+	}
+	// The view is left as it is if writes stay paused
+	if err := beginWrite(); err != nil {
+		return err
+	}
+	defer endWrite()
+	viewsMu.Lock()
+	defer viewsMu.Unlock()
+	v, ok := views[viewKey]
+	if !ok {
+		return ErrViewNotFound
+	}
+	close(v.stop)
+	<-v.done
+	delete(views, viewKey)
+	return ThisInstance.CatalogDir.RemoveTimeBucket(&viewKey)
+}
+
+func newView(meta ViewMetadata) *view {
+	return &view{
+		ViewMetadata: meta,
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+}
+
+// start begins the refreshes of the view. The view is populated from the
+// whole source first, in the background if async is true.
+func (v *view) start(async bool) error {
+	events, cancel, err := Watch(v.SourceKey)
+	if err != nil {
+		return err
+	}
+	first, last, err := v.sourceRange()
+	if err == nil && !async && last != 0 {
+		err = v.refresh(first, last)
+		first, last = 0, 0
+	}
+	if err != nil {
+		cancel()
+		return err
+	}
+	go v.run(events, cancel, first, last)
+	return nil
+}
+
+// run recomputes the intervals of the view written to in the source at
+// every refresh interval, starting with those between first and last
+func (v *view) run(events <-chan WatchEvent, cancel CancelFunc, first, last int64) {
+	defer close(v.done)
+	defer cancel()
+	ticker := time.NewTicker(v.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-v.stop:
+			return
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			if ev.EventType != Write {
+				continue
+			}
+			if last == 0 || ev.FirstEpoch < first {
+				first = ev.FirstEpoch
+			}
+			if ev.Epoch > last {
+				last = ev.Epoch
+			}
+		case <-ticker.C:
+			if last == 0 {
+				continue
+			}
+			// The range is kept to be retried at the next refresh
+			if err := v.refresh(first, last); err != nil {
+				Log(ERROR, "Failed to refresh view %s - Error: %v", v.ViewKey.String(), err)
+				continue
+			}
+			first, last = 0, 0
+		}
+	}
+}
+
+// refresh recomputes the intervals of the view holding the epochs between
+// first and last
+func (v *view) refresh(first, last int64) error {
+	tf, err := v.ViewKey.GetTimeFrame()
+	if err != nil {
+		return err
+	}
+	seconds := int64(tf.Duration / time.Second)
+	q := planner.NewQuery(ThisInstance.CatalogDir)
+	q.AddTargetKey(&v.SourceKey)
+	q.SetRange(first-first%seconds, last-last%seconds+seconds-1)
+	pr, err := q.Parse()
+	if err != nil {
+		return err
+	}
+	r, err := NewReader(pr)
+	if err != nil {
+		return err
+	}
+	csm, _, err := r.Read()
+	if err != nil {
+		return err
+	}
+	cs := csm[v.SourceKey]
+	if cs == nil || cs.Len() == 0 {
+		return nil
+	}
+	if cs, err = cs.Resample(*tf, v.Agg.Method); err != nil {
+		return err
+	}
+	out := NewColumnSeriesMap()
+	out.AddColumnSeries(v.ViewKey, cs)
+	return WriteCSM(out, false)
+}
+
+// sourceRange returns the epochs from the beginning of the first year file
+// of the source to the end of its last
+func (v *view) sourceRange() (int64, int64, error) {
+	tbis, err := getTimeBucketInfos(&v.SourceKey)
+	if err != nil || len(tbis) == 0 {
+		return 0, 0, err
+	}
+	tz := utils.InstanceConfig.Timezone
+	first := time.Date(int(tbis[0].Year), time.January, 1, 0, 0, 0, 0, tz)
+	last := time.Date(int(tbis[len(tbis)-1].Year)+1, time.January, 1, 0, 0, 0, 0, tz)
+	return first.Unix(), last.Unix() - 1, nil
+}
+
+func viewDefinitionPath(key TimeBucketKey) string {
+	return filepath.Join(key.GetPathToYearFiles(ThisInstance.CatalogDir.GetPath()), ViewDefinitionName)
+}
+
+func writeViewDefinition(meta *ViewMetadata) error {
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(viewDefinitionPath(meta.ViewKey), data, 0600)
+}
+
+// restoreViews resumes the refreshes of the views defined under the root
+// directory. The views are brought up to date with their source in the
+// background.
+func restoreViews(rootDir string) {
+	filepath.Walk(rootDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || info.Name() != ViewDefinitionName {
+			return nil
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			Log(ERROR, "Failed to read view definition %s - Error: %v", path, err)
+			return nil
+		}
+		var meta ViewMetadata
+		if err = json.Unmarshal(data, &meta); err != nil || meta.RefreshInterval <= 0 {
+			Log(ERROR, "Invalid view definition %s - Error: %v", path, err)
+			return nil
+		}
+		viewsMu.Lock()
+		defer viewsMu.Unlock()
+		if _, ok := views[meta.ViewKey]; ok {
+			return nil
+		}
+		v := newView(meta)
+		if err = v.start(true); err != nil {
+			Log(ERROR, "Failed to restore view %s - Error: %v", meta.ViewKey.String(), err)
+			return nil
+		}
+		views[meta.ViewKey] = v
+		return nil
+	})
+}
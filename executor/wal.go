@@ -13,6 +13,7 @@ import (
 	"io/ioutil"
 	"path/filepath"
 	"sort"
+	"sync"
 
 	"github.com/alpacahq/marketstore/executor/buffile"
 	"github.com/alpacahq/marketstore/utils/io"
@@ -290,13 +291,19 @@ func (wf *WALFileType) flushToWAL(tgc *TransactionPipe) (err error) {
 	/*
 		Write the buffers to primary files (should happen after WAL writes)
 	*/
+	written := make([]string, 0, len(writesPerFile))
+	defer func() { setBucketsModified(written) }()
 	for keyPath, writes := range writesPerFile {
 		recordType := fileRecordTypes[keyPath]
 		if err := wf.writePrimary(keyPath, writes, recordType); err != nil {
 			return err
 		}
+		written = append(written, wf.WALKeyToFullPath(keyPath))
 		for i, buffer := range writes {
-			appendRecord(keyPath, trigger.Record(buffer.IndexAndPayload()))
+			// Null records only clear slots (e.g. truncation), there is nothing to trigger on
+			if buffer.Index() != 0 {
+				appendRecord(keyPath, trigger.Record(buffer.IndexAndPayload()))
+			}
 			writes[i] = nil // for GC
 		}
 		writesPerFile[keyPath] = nil // for GC
@@ -304,8 +311,20 @@ func (wf *WALFileType) flushToWAL(tgc *TransactionPipe) (err error) {
 	return nil
 }
 
+// primaryWrites is held while the WAL or WriteBatch writes to a year file,
+// and by WriteAtomicMulti while it replaces the year files, so that no write
+// goes to a file being replaced
+var primaryWrites sync.Mutex
+
 func (wf *WALFileType) writePrimary(keyPath string, writes []offsetIndexBuffer, recordType io.EnumRecordType) error {
+	primaryWrites.Lock()
+	defer primaryWrites.Unlock()
 	fullPath := wf.WALKeyToFullPath(keyPath)
+	stats := newFileStatsUpdate(fullPath, writes)
+	if err := stats.begin(); err != nil {
+		glog.Errorf("cannot mark file %s as being written: %v", fullPath, err)
+		return err
+	}
 	type WriteAtCloser interface {
 		goio.WriterAt
 		goio.Closer
@@ -314,16 +333,16 @@ func (wf *WALFileType) writePrimary(keyPath string, writes []offsetIndexBuffer,
 	var fp WriteAtCloser
 	var err error
 	if recordType == io.FIXED && len(writes) >= batchThreshold {
-		fp, err = buffile.New(fullPath)
+		fp, err = buffile.NewWithFlag(fullPath, yearFileWriteFlag())
 	} else {
-		fp, err = os.OpenFile(fullPath, os.O_RDWR, 0700)
+		fp, err = os.OpenFile(fullPath, yearFileWriteFlag(), 0700)
 	}
 	if err != nil {
 		// this is critical, in fact, since tx has been committed
 		glog.Errorf("cannot open file %s for write: %v", fullPath, err)
+		stats.abort()
 		return err
 	}
-	defer fp.Close()
 
 	for _, buffer := range writes {
 		switch recordType {
@@ -334,9 +353,16 @@ func (wf *WALFileType) writePrimary(keyPath string, writes []offsetIndexBuffer,
 		}
 		if err != nil {
 			glog.Errorf("failed to write committed data: %v", err)
+			fp.Close()
+			stats.abort()
 			return err
 		}
 	}
+	if err = fp.Close(); err != nil {
+		stats.abort()
+		return err
+	}
+	stats.save()
 	return nil
 }
 
@@ -635,6 +661,10 @@ func (wf *WALFileType) replayTGData(TG_Serialized []byte) (err error) {
 			dataLen := int(io.ToInt32(TG_Serialized[cursor : cursor+4]))
 			cursor += 4
 			fullPath := wf.WALKeyToFullPath(WALKeyPath)
+			if fullPath != cfp.fileName {
+				// The stats of the file are not those of the replayed writes
+				os.Remove(statsPath(fullPath))
+			}
 			fp, err := cfp.GetFP(fullPath)
 			if err != nil {
 				return err
@@ -833,6 +863,9 @@ func (wf *WALFileType) SyncWAL(WALRefresh, PrimaryRefresh time.Duration, walRota
 			wf.flushToWAL(ThisInstance.TXNPipe)
 			glog.Info("Flushing to disk...")
 			wf.createCheckpoint()
+			if err := markCleanShutdown(wf.RootPath); err != nil {
+				Log(ERROR, "Failed to mark the shutdown as clean - Error: %v", err)
+			}
 			ThisInstance.WALWg.Done()
 			return
 		}
@@ -846,7 +879,7 @@ func (wf *WALFileType) SyncWAL(WALRefresh, PrimaryRefresh time.Duration, walRota
 // present in the write channel, as it will flush as soon as possible.
 func (wf *WALFileType) RequestFlush() {
 	if !haveWALWriter {
-		wf.flushToWAL(ThisInstance.TXNPipe)
+		wf.flushDirectly()
 		return
 	}
 	// if there's already a queued flush, no need to queue another
@@ -857,3 +890,35 @@ func (wf *WALFileType) RequestFlush() {
 	ThisInstance.TXNPipe.flushChannel <- f
 	<-f
 }
+
+// WaitFlush flushes the WAL like RequestFlush, but always queues a flush of
+// its own and waits for it, so that every write queued before the call is
+// in the files once it returns
+func (wf *WALFileType) WaitFlush() {
+	if !haveWALWriter {
+		wf.flushDirectly()
+		return
+	}
+	f := make(chan struct{})
+	ThisInstance.TXNPipe.flushChannel <- f
+	<-f
+}
+
+// directFlushes serializes the flushes made by the writers themselves when
+// there is no WAL writer goroutine, e.g. a view refreshing while another
+// bucket is written
+var directFlushes sync.Mutex
+
+func (wf *WALFileType) flushDirectly() {
+	directFlushes.Lock()
+	defer directFlushes.Unlock()
+	wf.flushToWAL(ThisInstance.TXNPipe)
+}
+
+// flushQueuedWrites waits for the writes queued in the WAL to be in the year
+// files, before an operation reads, rewrites or moves the files directly.
+// Otherwise a queued write could land after the files are read, or over
+// their rewritten content, or at a path they were moved from.
+func flushQueuedWrites() {
+	ThisInstance.WALFile.WaitFlush()
+}
@@ -0,0 +1,88 @@
+package executor
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/alpacahq/marketstore/catalog"
+	"github.com/alpacahq/marketstore/executor/readhint"
+	. "github.com/alpacahq/marketstore/utils/io"
+	. "github.com/alpacahq/marketstore/utils/log"
+)
+
+// Number of records read at once while looking for the last one of a file
+const warmupChunkRecords = 8192
+
+// warmReadHints sets the read hint of the latest year files of every bucket
+// to their last non-NULL record, so that the first LAST query after a restart
+// doesn't have to read through the empty end of the files.
+func warmReadHints(d *catalog.Directory, years int) {
+	start := time.Now()
+	buckets := map[string][]*TimeBucketInfo{}
+	for _, tbi := range d.GatherTimeBucketInfo() {
+		dir := filepath.Dir(tbi.Path)
+		buckets[dir] = append(buckets[dir], tbi)
+	}
+	var hinted int
+	for _, tbis := range buckets {
+		sort.Slice(tbis, func(i, j int) bool { return tbis[i].Year > tbis[j].Year })
+		if len(tbis) > years {
+			tbis = tbis[:years]
+		}
+		for _, tbi := range tbis {
+			offset, err := lastRecordOffset(tbi)
+			if err != nil {
+				Log(ERROR, "warmReadHints: %s - Error: %v", tbi.Path, err)
+				continue
+			}
+			if offset >= 0 {
+				readhint.SetLastKnown(tbi.Path, offset)
+				hinted++
+			}
+		}
+	}
+	Log(INFO, "Set read hints of %d files in %v", hinted, time.Since(start))
+}
+
+// lastRecordOffset returns the offset of the last non-NULL record of the year
+// file, or -1 if it has none.
+func lastRecordOffset(tbi *TimeBucketInfo) (int64, error) {
+	fp, err := os.Open(tbi.Path)
+	if err != nil {
+		return -1, err
+	}
+	defer fp.Close()
+	fi, err := fp.Stat()
+	if err != nil {
+		return -1, err
+	}
+
+	// The payload of VARIABLE records follows the index
+	recordLen := int64(tbi.GetRecordLength())
+	end := FileSize(tbi.GetTimeframe(), int(tbi.Year), int(recordLen))
+	if fi.Size() < end {
+		end = Headersize + (fi.Size()-Headersize)/recordLen*recordLen
+	}
+	buffer := make([]byte, warmupChunkRecords*recordLen)
+	for end > Headersize {
+		start := end - int64(len(buffer))
+		if start < Headersize {
+			start = Headersize
+		}
+		chunk := buffer[:end-start]
+		if _, err := fp.ReadAt(chunk, start); err != nil {
+			return -1, err
+		}
+		for pos := int64(len(chunk)) - recordLen; pos >= 0; pos -= recordLen {
+			// Every record begins with its index, which is 0 when NULL
+			if binary.LittleEndian.Uint64(chunk[pos:]) != 0 {
+				return start + pos, nil
+			}
+		}
+		end = start
+	}
+	return -1, nil
+}
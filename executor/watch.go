@@ -0,0 +1,239 @@
+package executor
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/alpacahq/marketstore/planner"
+	"github.com/alpacahq/marketstore/plugins/trigger"
+	. "github.com/alpacahq/marketstore/utils/io"
+	. "github.com/alpacahq/marketstore/utils/log"
+)
+
+type EventType int
+
+const (
+	// Write is sent once records of the bucket are on disk
+	Write EventType = iota
+	// Delete is sent when a year file or the bucket itself is removed or renamed
+	Delete
+	// SchemaChange is sent when a year file with a different data shape
+	// replaces or is added to the bucket
+	SchemaChange
+)
+
+// How many events are buffered for a watch before further ones are dropped
+const watchChannelDepth = 256
+
+type WatchEvent struct {
+	EventType EventType
+	Key       TimeBucketKey
+	// Epochs of the earliest and latest records of a Write
+	FirstEpoch int64
+	Epoch      int64
+}
+
+// CancelFunc stops a watch and closes its channel
+type CancelFunc func()
+
+// bucketWatch is the fsnotify watcher shared by every watch of a bucket
+// directory, with the data shape last seen in the directory
+type bucketWatch struct {
+	key        TimeBucketKey
+	watcher    *fsnotify.Watcher
+	subs       map[chan WatchEvent]struct{}
+	names      []string
+	types      []EnumElementType
+	recordType EnumRecordType
+}
+
+var (
+	watchMu sync.Mutex
+	watches = map[string]*bucketWatch{}
+)
+
+// Watch returns a channel of the changes made to the bucket at key. Writes
+// are sent as the write path puts them on disk, while deletions and schema
+// changes come from the bucket directory through fsnotify, so they are also
+// seen when made outside of this process. Events are dropped when the
+// channel is full rather than holding back writers. The watch lasts until
+// the returned CancelFunc is called.
+func Watch(key TimeBucketKey) (<-chan WatchEvent, CancelFunc, error) {
+	dir := key.GetPathToYearFiles(ThisInstance.CatalogDir.GetPath())
+	if _, err := os.Stat(dir); err != nil {
+		return nil, nil, err
+	}
+
+	watchMu.Lock()
+	defer watchMu.Unlock()
+	bw, ok := watches[dir]
+	if !ok {
+		watcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			return nil, nil, err
+		}
+		if err = watcher.Add(dir); err != nil {
+			watcher.Close()
+			return nil, nil, err
+		}
+		bw = &bucketWatch{
+			key:     key,
+			watcher: watcher,
+			subs:    map[chan WatchEvent]struct{}{},
+		}
+		if tbis, err := getTimeBucketInfos(&key); err == nil && len(tbis) > 0 {
+			bw.setShape(tbis[len(tbis)-1])
+		}
+		watches[dir] = bw
+		go bw.run(dir)
+	}
+	ch := make(chan WatchEvent, watchChannelDepth)
+	bw.subs[ch] = struct{}{}
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() { unwatch(dir, ch) })
+	}
+	return ch, cancel, nil
+}
+
+func unwatch(dir string, ch chan WatchEvent) {
+	watchMu.Lock()
+	bw := watches[dir]
+	delete(bw.subs, ch)
+	close(ch)
+	last := len(bw.subs) == 0
+	if last {
+		delete(watches, dir)
+	}
+	watchMu.Unlock()
+
+	// Closing waits on the event loop, which needs watchMu to broadcast
+	if last {
+		bw.watcher.Close()
+	}
+}
+
+// broadcast sends ev to the watches of the bucket without blocking
+func (bw *bucketWatch) broadcast(ev WatchEvent) {
+	watchMu.Lock()
+	defer watchMu.Unlock()
+	for ch := range bw.subs {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
+
+func (bw *bucketWatch) setShape(tbi *TimeBucketInfo) {
+	bw.names = tbi.GetElementNames()
+	bw.types = tbi.GetElementTypes()
+	bw.recordType = tbi.GetRecordType()
+}
+
+// shapeChanged records the data shape of tbi, returning true if it differs
+// from the one previously seen in the bucket
+func (bw *bucketWatch) shapeChanged(tbi *TimeBucketInfo) bool {
+	known := bw.names != nil
+	changed := tbi.GetRecordType() != bw.recordType ||
+		!planner.ElementsEqual(tbi.GetElementTypes(), bw.types) ||
+		strings.Join(tbi.GetElementNames(), ",") != strings.Join(bw.names, ",")
+	bw.setShape(tbi)
+	return known && changed
+}
+
+func (bw *bucketWatch) run(dir string) {
+	for {
+		select {
+		case ev, ok := <-bw.watcher.Events:
+			if !ok {
+				return
+			}
+			bw.handle(dir, ev)
+		case err, ok := <-bw.watcher.Errors:
+			if !ok {
+				return
+			}
+			Log(ERROR, "Watch: %s - Error: %v", dir, err)
+		}
+	}
+}
+
+func (bw *bucketWatch) handle(dir string, ev fsnotify.Event) {
+	if filepath.Clean(ev.Name) == dir {
+		if ev.Op&(fsnotify.Remove|fsnotify.Rename) != 0 {
+			bw.broadcast(WatchEvent{EventType: Delete, Key: bw.key})
+		}
+		return
+	}
+	if !isYearFile(ev.Name) {
+		return
+	}
+	switch {
+	case ev.Op&(fsnotify.Remove|fsnotify.Rename) != 0:
+		bw.broadcast(WatchEvent{EventType: Delete, Key: bw.key})
+	case ev.Op&fsnotify.Create != 0:
+		tbi, err := NewTimeBucketInfoFromFile(ev.Name)
+		if err != nil {
+			return
+		}
+		if bw.shapeChanged(tbi) {
+			bw.broadcast(WatchEvent{EventType: SchemaChange, Key: bw.key})
+		}
+	}
+}
+
+// isYearFile returns true if path names a year file, e.g. 2017.bin
+func isYearFile(path string) bool {
+	name := filepath.Base(path)
+	if filepath.Ext(name) != ".bin" {
+		return false
+	}
+	_, err := strconv.ParseInt(strings.TrimSuffix(name, ".bin"), 10, 16)
+	return err == nil
+}
+
+// notifyWatches sends a Write to the watches of the year file at keyPath
+// with the epochs of the earliest and latest of the written records
+func notifyWatches(keyPath string, records []trigger.Record) {
+	watchMu.Lock()
+	if len(watches) == 0 || len(records) == 0 {
+		watchMu.Unlock()
+		return
+	}
+	bw, ok := watches[filepath.Join(ThisInstance.CatalogDir.GetPath(), filepath.Dir(keyPath))]
+	watchMu.Unlock()
+	if !ok {
+		return
+	}
+	tf, err := bw.key.GetTimeFrame()
+	if err != nil {
+		return
+	}
+	year, err := strconv.Atoi(strings.TrimSuffix(filepath.Base(keyPath), ".bin"))
+	if err != nil {
+		return
+	}
+	var first, index int64
+	for _, record := range records {
+		i := record.Index()
+		if i > index {
+			index = i
+		}
+		if first == 0 || i < first {
+			first = i
+		}
+	}
+	bw.broadcast(WatchEvent{
+		EventType:  Write,
+		Key:        bw.key,
+		FirstEpoch: IndexToTime(first, tf.Duration, int16(year)).Unix(),
+		Epoch:      IndexToTime(index, tf.Duration, int16(year)).Unix(),
+	})
+}
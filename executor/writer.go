@@ -1,13 +1,20 @@
 package executor
 
 import (
+	"encoding/binary"
 	"fmt"
 	stdio "io"
+	"io/ioutil"
+	"math"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/alpacahq/marketstore/catalog"
+	"github.com/alpacahq/marketstore/plugins/trigger"
+	"github.com/alpacahq/marketstore/utils"
 	"github.com/alpacahq/marketstore/utils/io"
 	. "github.com/alpacahq/marketstore/utils/io"
 	"github.com/golang/glog"
@@ -25,6 +32,9 @@ func NewWriter(tbi *io.TimeBucketInfo, tgc *TransactionPipe, rootCatDir *catalog
 		range restriction.  If there is a date range restriction, the write() routine should produce
 		an error when an out-of-bounds write is tried.
 	*/
+	if err := checkWritable(); err != nil {
+		return nil, err
+	}
 	// Check to ensure there is a valid WALFile for this instance before writing
 	if ThisInstance.WALFile == nil {
 		err := fmt.Errorf("there is not an active WALFile for this instance, so cannot write")
@@ -219,64 +229,631 @@ func WriteBufferToFileIndirect(fp *os.File, buffer offsetIndexBuffer) (err error
 // DataShapeVector defined by the file header. WriteCSM will create any files if they do
 // not already exist for the given ColumnSeriesMap based on its TimeBucketKey.
 func WriteCSM(csm io.ColumnSeriesMap, isVariableLength bool) (err error) {
-	cDir := ThisInstance.CatalogDir
+	if err = checkWritable(); err != nil {
+		return err
+	}
+	if err = beginWrite(); err != nil {
+		return err
+	}
+	defer endWrite()
+	for _, cs := range csm {
+		if err = checkZeroEpoch(cs.GetEpoch()); err != nil {
+			return err
+		}
+	}
 	for tbk, cs := range csm {
-		tf, err := tbk.GetTimeFrame()
-		if err != nil {
+		if err = writeColumnSeries(tbk, cs, isVariableLength, nil); err != nil {
 			return err
 		}
+	}
+	wal := ThisInstance.WALFile
+	wal.RequestFlush()
+	return nil
+}
+
+// writeColumnSeries hands the rows of cs to the writer of the bucket at tbk,
+// at times if given or at the times of cs otherwise
+func writeColumnSeries(tbk io.TimeBucketKey, cs *io.ColumnSeries, isVariableLength bool, times []time.Time) error {
+	tf, err := tbk.GetTimeFrame()
+	if err != nil {
+		return err
+	}
+
+	var recordType io.EnumRecordType
+	if isVariableLength {
+		recordType = io.VARIABLE
+	} else {
+		recordType = io.FIXED
+	}
+	// TODO check if the previsouly-written data schema matches the input
+	tbi, err := getOrAddTimeBucket(tbk, *tf, int16(cs.GetTime()[0].Year()), cs.GetDataShapes(), recordType)
+	if err != nil {
+		return err
+	}
+
+	/*
+		Create a writer for this TimeBucket
+	*/
+	w, err := NewWriter(tbi, ThisInstance.TXNPipe, ThisInstance.CatalogDir)
+	if err != nil {
+		return err
+	}
+	for i, ds := range tbi.GetDataShapesWithEpoch() {
+		if csDs := cs.GetDataShapes()[i]; !ds.Equal(csDs) {
+			return fmt.Errorf(
+				"data shape does not match on-disk data shape: %v != %v",
+				cs.GetDataShapes(),
+				tbi.GetDataShapesWithEpoch(),
+			)
+
+		}
+	}
+	rs := cs.ToRowSeries(tbk)
+	rowdata := rs.GetData()
+	if times == nil {
+		times = rs.GetTime()
+	}
+	if tbi.GetRecordType() == io.VARIABLE {
+		if err := checkVariableRecordLen(tbi, times, rs.GetRowLen()); err != nil {
+			return err
+		}
+	}
+	w.WriteRecords(times, rowdata)
+	recordBucketWrite(tbk, latestEpoch(cs.GetEpoch()))
+	return nil
+}
+
+// WriteEpochFloat writes the columns of data at epochs given as float64
+// seconds, e.g. 1609459200.5, with one value per epoch in each column. The
+// epochs are converted to whole seconds and nanoseconds, so that the
+// fraction of a second is kept as the ticks of VARIABLE records instead of
+// being truncated. For FIXED records the epochs are rounded down to the
+// start of their interval. A bucket that does not exist is created with
+// FIXED records.
+func WriteEpochFloat(key io.TimeBucketKey, epochs []float64, data map[string]interface{}) error {
+	if err := checkWritable(); err != nil {
+		return err
+	}
+	if len(epochs) == 0 {
+		return nil
+	}
+	tf, err := key.GetTimeFrame()
+	if err != nil {
+		return err
+	}
+	isVariableLength := false
+	var names []string
+	if tbi, err := ThisInstance.CatalogDir.GetLatestTimeBucketInfoFromKey(&key); err == nil {
+		isVariableLength = tbi.GetRecordType() == io.VARIABLE
+		for _, ds := range tbi.GetDataShapesWithEpoch() {
+			names = append(names, ds.Name)
+		}
+	}
+
+	times := make([]time.Time, len(epochs))
+	seconds := make([]int64, len(epochs))
+	for i, epoch := range epochs {
+		if math.IsNaN(epoch) || math.IsInf(epoch, 0) {
+			return fmt.Errorf("WriteEpochFloat: epoch %v is not a number of seconds", epoch)
+		}
+		secs := math.Floor(epoch)
+		t := time.Unix(int64(secs), int64(math.Round((epoch-secs)*1e9)))
+		if !isVariableLength {
+			local := io.ToSystemTimezone(t)
+			t = io.IndexToTime(io.TimeToIndex(local, tf.Duration), tf.Duration, int16(local.Year()))
+		}
+		times[i] = t
+		seconds[i] = t.Unix()
+	}
+	if err = checkZeroEpoch(seconds); err != nil {
+		return err
+	}
+	cs, err := io.ColumnSeriesFromMap(data, seconds)
+	if err != nil {
+		return err
+	}
+	// The columns of an existing bucket are in the order of its file
+	if names != nil {
+		if err = cs.Project(names); err != nil {
+			return err
+		}
+	}
+
+	if err = beginWrite(); err != nil {
+		return err
+	}
+	defer endWrite()
+	if err = writeColumnSeries(key, cs, isVariableLength, times); err != nil {
+		return err
+	}
+	ThisInstance.WALFile.RequestFlush()
+	return nil
+}
+
+// checkZeroEpoch returns ErrZeroEpoch if any of the epochs is 0, which the
+// readers take for an empty record and skip.
+func checkZeroEpoch(epochs []int64) error {
+	for _, epoch := range epochs {
+		if epoch == 0 {
+			return ErrZeroEpoch
+		}
+	}
+	return nil
+}
+
+// checkVariableRecordLen returns ErrVariableRecordTooLarge if the rows of a
+// write that fall in the same interval of the variable record bucket add up
+// to more than the configured MaxVariableRecordLen, as an interval is read
+// back in one piece.
+func checkVariableRecordLen(tbi *io.TimeBucketInfo, ts []time.Time, rowLen int) error {
+	// On disk the epoch of each row is replaced by 4 bytes of interval ticks
+	onDiskLen := rowLen - 8 + 4
+	var prevIndex int64
+	var payloadLen int
+	for _, t := range ts {
+		index := TimeToIndex(t, tbi.GetTimeframe())
+		if index != prevIndex {
+			prevIndex, payloadLen = index, 0
+		}
+		if payloadLen += onDiskLen; payloadLen > utils.InstanceConfig.MaxVariableRecordLen {
+			glog.Errorf("WriteCSM: %d bytes written at %v in %s, the maximum is %d",
+				payloadLen, t, tbi.Path, utils.InstanceConfig.MaxVariableRecordLen)
+			return ErrVariableRecordTooLarge
+		}
+	}
+	return nil
+}
+
+// getOrAddTimeBucket returns the latest year file of the bucket at tbk, adding
+// the bucket with the given data shapes if it does not exist yet.
+func getOrAddTimeBucket(tbk io.TimeBucketKey, tf utils.Timeframe, year int16,
+	dsv []io.DataShape, recordType io.EnumRecordType) (*io.TimeBucketInfo, error) {
+
+	cDir := ThisInstance.CatalogDir
+	tbi, err := cDir.GetLatestTimeBucketInfoFromKey(&tbk)
+	if err == nil {
+		return tbi, nil
+	}
+	tbi = io.NewTimeBucketInfo(
+		tf,
+		tbk.GetPathToYearFiles(cDir.GetPath()),
+		"Created By Writer", year,
+		dsv, recordType)
+
+	/*
+		Verify there is an available TimeBucket for the destination
+	*/
+	if err := cDir.AddTimeBucket(&tbk, tbi); err != nil {
+		// If File Exists error, ignore it, otherwise return the error
+		if !strings.Contains(err.Error(), "Can not overwrite file") && !strings.Contains(err.Error(), "file exists") {
+			return nil, err
+		}
+	}
+	return tbi, nil
+}
+
+// CreateBucket adds the bucket at key with the given data shapes, timeframe
+// and record type before any data is written to it, by creating the year
+// file of the current year with its header and only empty records.
+// ErrBucketAlreadyExists is returned if the bucket exists.
+func CreateBucket(key io.TimeBucketKey, shapes []io.DataShape, tf utils.Timeframe, recType io.EnumRecordType) error {
+	if err := checkWritable(); err != nil {
+		return err
+	}
+	if err := beginWrite(); err != nil {
+		return err
+	}
+	defer endWrite()
+	cDir := ThisInstance.CatalogDir
+	if _, err := cDir.GetLatestTimeBucketInfoFromKey(&key); err == nil {
+		return ErrBucketAlreadyExists
+	}
+	if elementTypes, _ := io.CreateShapesForTimeBucketInfo(shapes); len(elementTypes) == 0 {
+		return fmt.Errorf("CreateBucket: %s needs at least one column besides Epoch", key.String())
+	}
+	tbi := io.NewTimeBucketInfo(
+		tf,
+		key.GetPathToYearFiles(cDir.GetPath()),
+		"Created By CreateBucket", int16(time.Now().UTC().Year()),
+		shapes, recType)
+	return cDir.AddTimeBucket(&key, tbi)
+}
+
+// batchFile holds the writes of a WriteBatch call to a single year file
+type batchFile struct {
+	path     string
+	keys     []io.TimeBucketKey
+	writes   []offsetIndexBuffer
+	dev, ino uint64
+}
+
+// WriteBatch writes every RowSeries of batches directly to the year files of
+// its bucket and syncs each file once, rather than once per write. Files are
+// written in device and inode order to keep the storage device seeking
+// forward. Unlike WriteCSM the writes do not go through the WAL, the data is
+// on disk once WriteBatch returns. Buckets that do not exist are created.
+// Only FIXED records are supported. If some of the batches could not be
+// written a *PartialWriteError lists the keys written and those that failed.
+func WriteBatch(batches map[io.TimeBucketKey]*io.RowSeries) error {
+	if err := checkWritable(); err != nil {
+		return err
+	}
+	if err := beginWrite(); err != nil {
+		return err
+	}
+	defer endWrite()
+	flushQueuedWrites()
+
+	failed := make(map[io.TimeBucketKey]error)
+	filesByPath := make(map[string]*batchFile)
+	for key, rs := range batches {
+		writes, err := batchWrites(key, rs)
+		if err != nil {
+			failed[key] = err
+			continue
+		}
+		for path, w := range writes {
+			bf, ok := filesByPath[path]
+			if !ok {
+				bf = &batchFile{path: path}
+				filesByPath[path] = bf
+			}
+			bf.keys = append(bf.keys, key)
+			bf.writes = append(bf.writes, w...)
+		}
+	}
 
-		// TODO check if the previsouly-written data schema matches the input
-		tbi, err := cDir.GetLatestTimeBucketInfoFromKey(&tbk)
+	files := make([]*batchFile, 0, len(filesByPath))
+	for _, bf := range filesByPath {
+		fi, err := os.Stat(bf.path)
 		if err != nil {
-			var recordType io.EnumRecordType
-			if isVariableLength {
-				recordType = io.VARIABLE
-			} else {
-				recordType = io.FIXED
+			for _, key := range bf.keys {
+				failed[key] = err
 			}
+			continue
+		}
+		bf.dev, bf.ino = fileID(fi)
+		files = append(files, bf)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].dev != files[j].dev {
+			return files[i].dev < files[j].dev
+		}
+		return files[i].ino < files[j].ino
+	})
 
-			year := int16(cs.GetTime()[0].Year())
-			tbi = io.NewTimeBucketInfo(
-				*tf,
-				tbk.GetPathToYearFiles(cDir.GetPath()),
-				"Created By Writer", year,
-				cs.GetDataShapes(), recordType)
+	written := make([]string, 0, len(files))
+	for _, bf := range files {
+		if err := writeBatchFile(bf); err != nil {
+			glog.Errorf("WriteBatch: writing %s: %v", bf.path, err)
+			for _, key := range bf.keys {
+				failed[key] = err
+			}
+			continue
+		}
+		written = append(written, bf.path)
+		dispatchBatchFile(bf)
+	}
+	setBucketsModified(written)
 
-			/*
-				Verify there is an available TimeBucket for the destination
-			*/
-			if err := cDir.AddTimeBucket(&tbk, tbi); err != nil {
-				// If File Exists error, ignore it, otherwise return the error
-				if !strings.Contains(err.Error(), "Can not overwrite file") && !strings.Contains(err.Error(), "file exists") {
-					return err
+	for key, rs := range batches {
+		if _, ok := failed[key]; !ok && rs.GetNumRows() > 0 {
+			recordBucketWrite(key, latestEpoch(rs.GetEpoch()))
+		}
+	}
+
+	if len(failed) == 0 {
+		return nil
+	}
+	perr := &PartialWriteError{Failed: failed}
+	for key := range batches {
+		if _, ok := failed[key]; !ok {
+			perr.Succeeded = append(perr.Succeeded, key)
+		}
+	}
+	sort.Slice(perr.Succeeded, func(i, j int) bool {
+		return perr.Succeeded[i].String() < perr.Succeeded[j].String()
+	})
+	return perr
+}
+
+// batchWrites formats the rows of rs as writes to the year files of the
+// bucket at key, keyed by file path.
+func batchWrites(key io.TimeBucketKey, rs *io.RowSeries) (map[string][]offsetIndexBuffer, error) {
+	if rs.GetNumRows() == 0 {
+		return nil, nil
+	}
+	if err := checkZeroEpoch(rs.GetEpoch()); err != nil {
+		return nil, err
+	}
+	tf, err := key.GetTimeFrame()
+	if err != nil {
+		return nil, err
+	}
+	times := rs.GetTime()
+	tbi, err := getOrAddTimeBucket(key, *tf, int16(times[0].Year()), rs.GetDataShapes(), io.FIXED)
+	if err != nil {
+		return nil, err
+	}
+	if tbi.GetRecordType() != io.FIXED {
+		return nil, fmt.Errorf("WriteBatch: only supported for fixed records")
+	}
+	if !dataShapesMatch(rs.GetDataShapes(), tbi.GetDataShapesWithEpoch()) {
+		return nil, fmt.Errorf(
+			"data shape does not match on-disk data shape: %v != %v",
+			rs.GetDataShapes(),
+			tbi.GetDataShapesWithEpoch(),
+		)
+	}
+
+	writes := make(map[string][]offsetIndexBuffer)
+	for i, t := range times {
+		if year := int16(t.Year()); year != tbi.Year {
+			if tbi, err = ThisInstance.CatalogDir.GetSubDirectoryAndAddFile(tbi.Path, year); err != nil {
+				return nil, err
+			}
+		}
+		index := TimeToIndex(t, tbi.GetTimeframe())
+		// The row's epoch column is replaced by the index, preceded by the file offset
+		buffer := make([]byte, 8+rs.GetRowLen())
+		binary.LittleEndian.PutUint64(buffer, uint64(IndexToOffset(index, tbi.GetRecordLength())))
+		copy(buffer[8:], rs.GetRow(i))
+		binary.LittleEndian.PutUint64(buffer[8:], uint64(index))
+		writes[tbi.Path] = append(writes[tbi.Path], offsetIndexBuffer(buffer))
+	}
+	return writes, nil
+}
+
+func dataShapesMatch(left, right []io.DataShape) bool {
+	if len(left) != len(right) {
+		return false
+	}
+	for i, ds := range left {
+		if !ds.Equal(right[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// yearFileWriteFlag returns the flag the year files are opened with to be
+// written to. With DSyncWrites each write returns once its data is on disk.
+func yearFileWriteFlag() int {
+	if utils.InstanceConfig.DSyncWrites {
+		return os.O_RDWR | os.O_SYNC
+	}
+	return os.O_RDWR
+}
+
+func writeBatchFile(bf *batchFile) error {
+	primaryWrites.Lock()
+	defer primaryWrites.Unlock()
+	stats := newFileStatsUpdate(bf.path, bf.writes)
+	if err := stats.begin(); err != nil {
+		return err
+	}
+	fp, err := os.OpenFile(bf.path, yearFileWriteFlag(), 0700)
+	if err != nil {
+		stats.abort()
+		return err
+	}
+	defer fp.Close()
+	for _, buffer := range bf.writes {
+		if err = WriteBufferToFile(fp, buffer); err != nil {
+			stats.abort()
+			return err
+		}
+	}
+	if err = fdatasync(fp); err != nil {
+		stats.abort()
+		return err
+	}
+	stats.save()
+	return nil
+}
+
+// setBucketsModified records now as the last write to the buckets of the year
+// files at paths, once for each bucket
+func setBucketsModified(paths []string) {
+	if ThisInstance.CatalogDir == nil {
+		return
+	}
+	now := time.Now()
+	done := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		dir := filepath.Dir(path)
+		if done[dir] {
+			continue
+		}
+		done[dir] = true
+		if err := ThisInstance.CatalogDir.SetLastModified(path, now); err != nil {
+			glog.Errorf("failed to set the last write time of %s: %v", dir, err)
+		}
+	}
+}
+
+// dispatchBatchFile hands the records written to the file to the triggers
+func dispatchBatchFile(bf *batchFile) {
+	records := make([]trigger.Record, len(bf.writes))
+	for i, buffer := range bf.writes {
+		records[i] = trigger.Record(buffer.IndexAndPayload())
+	}
+	dispatchWritten(ThisInstance.WALFile.FullPathToWALKey(bf.path), records)
+}
+
+// WriteAtomicMulti writes every RowSeries of batch, either all of them or
+// none. The year files written to are copied to temporary files holding the
+// writes, which then replace the originals by renaming. Each replaced file is
+// kept until all the renames succeeded, to be put back if one of them fails.
+// The renames follow each other closely but are not a single operation, so a
+// reader may still see some of them before the others. No other write is
+// made to the year files during the renames. Buckets that do not exist are
+// created. Only FIXED records are supported, and year files in the cold
+// storage are refused with ErrColdYearFile.
+func WriteAtomicMulti(batch map[io.TimeBucketKey]*io.RowSeries) error {
+	if err := checkWritable(); err != nil {
+		return err
+	}
+	if err := beginWrite(); err != nil {
+		return err
+	}
+	defer endWrite()
+	flushQueuedWrites()
+
+	filesByPath := make(map[string]*batchFile)
+	for key, rs := range batch {
+		writes, err := batchWrites(key, rs)
+		if err != nil {
+			return fmt.Errorf("WriteAtomicMulti: %s: %v", key.String(), err)
+		}
+		for path, w := range writes {
+			bf, ok := filesByPath[path]
+			if !ok {
+				// Renaming the staged copy over the link would leave the cold copy read
+				if tbi, err := ThisInstance.CatalogDir.PathToTimeBucketInfo(path); err == nil &&
+					tbi.GetStorageTier() == io.ColdTier {
+					return fmt.Errorf("WriteAtomicMulti: %s: %v", path, ErrColdYearFile)
 				}
+				bf = &batchFile{path: path}
+				filesByPath[path] = bf
 			}
+			bf.keys = append(bf.keys, key)
+			bf.writes = append(bf.writes, w...)
+		}
+	}
+	files := make([]*batchFile, 0, len(filesByPath))
+	for _, bf := range filesByPath {
+		files = append(files, bf)
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].path < files[j].path })
+
+	if err := stageAndReplaceFiles(files); err != nil {
+		return fmt.Errorf("WriteAtomicMulti: %v", err)
+	}
+	written := make([]string, len(files))
+	for i, bf := range files {
+		written[i] = bf.path
+		dispatchBatchFile(bf)
+	}
+	setBucketsModified(written)
+	for key, rs := range batch {
+		if rs.GetNumRows() > 0 {
+			recordBucketWrite(key, latestEpoch(rs.GetEpoch()))
 		}
+	}
+	return nil
+}
 
-		/*
-			Create a writer for this TimeBucket
-		*/
-		w, err := NewWriter(tbi, ThisInstance.TXNPipe, cDir)
+// stageAndReplaceFiles copies the year files of files to temporary files
+// holding their writes, then renames them over the originals. The copies are
+// made while the files may still be written to, those written since are
+// copied again once the other writes to the year files wait for the renames.
+func stageAndReplaceFiles(files []*batchFile) error {
+	// Phase one, nothing is visible to readers until every file is staged
+	staged := make([]string, 0, len(files))
+	defer func() {
+		for _, tmpPath := range staged {
+			os.Remove(tmpPath)
+		}
+	}()
+	generations := make([]int64, len(files))
+	for i, bf := range files {
+		generations[i] = fileGeneration(bf.path)
+		tmpPath, err := stageBatchFile(bf)
+		if err != nil {
+			return fmt.Errorf("staging %s: %v", bf.path, err)
+		}
+		staged = append(staged, tmpPath)
+	}
+
+	primaryWrites.Lock()
+	defer primaryWrites.Unlock()
+	for i, bf := range files {
+		if generations[i]%2 == 0 && fileGeneration(bf.path) == generations[i] {
+			continue
+		}
+		os.Remove(staged[i])
+		tmpPath, err := stageBatchFile(bf)
 		if err != nil {
+			staged[i] = ""
+			return fmt.Errorf("staging %s: %v", bf.path, err)
+		}
+		staged[i] = tmpPath
+	}
+
+	// Phase two
+	rewrites := make([]*fileStatsUpdate, 0, len(files))
+	defer func() {
+		for _, rewrite := range rewrites {
+			rewrite.save()
+		}
+	}()
+	for _, bf := range files {
+		rewrite := newFileRewrite(bf.path)
+		if err := rewrite.begin(); err != nil {
 			return err
 		}
-		for i, ds := range tbi.GetDataShapesWithEpoch() {
-			if csDs := cs.GetDataShapes()[i]; !ds.Equal(csDs) {
-				return fmt.Errorf(
-					"data shape does not match on-disk data shape: %v != %v",
-					cs.GetDataShapes(),
-					tbi.GetDataShapesWithEpoch(),
-				)
+		rewrites = append(rewrites, rewrite)
+	}
+	return replaceFiles(files, staged)
+}
 
+// stageBatchFile copies the year file to a temporary file next to it, holding
+// the writes of bf, and returns its path
+func stageBatchFile(bf *batchFile) (string, error) {
+	in, err := os.Open(bf.path)
+	if err != nil {
+		return "", err
+	}
+	defer in.Close()
+	tmp, err := ioutil.TempFile(filepath.Dir(bf.path), ".atomic")
+	if err != nil {
+		return "", err
+	}
+	defer tmp.Close()
+	if _, err = stdio.Copy(tmp, in); err == nil {
+		for _, buffer := range bf.writes {
+			if err = WriteBufferToFile(tmp, buffer); err != nil {
+				break
 			}
 		}
-		rs := cs.ToRowSeries(tbk)
-		rowdata := rs.GetData()
-		times := rs.GetTime()
-		w.WriteRecords(times, rowdata)
 	}
-	wal := ThisInstance.WALFile
-	wal.RequestFlush()
+	if err == nil {
+		err = fdatasync(tmp)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	return tmp.Name(), nil
+}
+
+// replaceFiles renames each staged file over the year file of files at the
+// same position. If a rename fails the files already replaced are restored.
+func replaceFiles(files []*batchFile, staged []string) error {
+	backups := make([]string, 0, len(files))
+	restore := func() {
+		for i, backup := range backups {
+			if err := os.Rename(backup, files[i].path); err != nil {
+				glog.Errorf("WriteAtomicMulti: restoring %s from %s: %v", files[i].path, backup, err)
+			}
+		}
+	}
+	for i, bf := range files {
+		backup := staged[i] + ".orig"
+		if err := os.Link(bf.path, backup); err != nil {
+			restore()
+			return err
+		}
+		if err := os.Rename(staged[i], bf.path); err != nil {
+			os.Remove(backup)
+			restore()
+			return err
+		}
+		backups = append(backups, backup)
+	}
+	for _, backup := range backups {
+		os.Remove(backup)
+	}
 	return nil
 }
@@ -38,6 +38,12 @@ func appendRecord(keyPath string, record []byte) {
 	m[keyPath] = append(m[keyPath], record)
 }
 
+// dispatchWritten hands records written outside of the WAL to the triggers.
+func dispatchWritten(keyPath string, records []trigger.Record) {
+	once.Do(setup)
+	c <- writtenRecords{key: keyPath, records: records}
+}
+
 // dispatchRecords iterates over the registered triggers and fire the event
 // if the file path matches the condition.  This is meant to be
 // run in a separate goroutine and recovers from panics in the triggers.
@@ -51,6 +57,7 @@ func dispatchRecords() {
 func run() {
 	defer func() { done <- struct{}{} }()
 	for wr := range c {
+		notifyWatches(wr.key, wr.records)
 		for _, tmatcher := range ThisInstance.TriggerMatchers {
 			if tmatcher.Match(wr.key) {
 				triggerWg.Add(1)
@@ -0,0 +1,81 @@
+package executor
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/alpacahq/marketstore/utils/io"
+)
+
+// How long the years found for a bucket are reused before rescanning
+const yearsCacheTTL = 60 * time.Second
+
+type yearsEntry struct {
+	years   []int16
+	expires time.Time
+}
+
+var (
+	yearsMu    sync.Mutex
+	yearsCache = map[string]yearsEntry{}
+)
+
+// ListYearsWithData returns the sorted years of the bucket whose year file
+// holds records. Year files are allocated in full when created, so the stats
+// of a file, or a scan of its index if it has none, tell if it holds any.
+// The result is cached for a minute, so years written to in the meantime may
+// be missing.
+func ListYearsWithData(key TimeBucketKey) ([]int16, error) {
+	dir := key.GetPathToYearFiles(ThisInstance.CatalogDir.GetPath())
+
+	yearsMu.Lock()
+	defer yearsMu.Unlock()
+	if entry, ok := yearsCache[dir]; ok && time.Now().Before(entry.expires) {
+		return entry.years, nil
+	}
+
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	years := []int16{}
+	for _, fi := range fis {
+		if fi.IsDir() || filepath.Ext(fi.Name()) != ".bin" || fi.Size() <= Headersize {
+			continue
+		}
+		year, err := strconv.ParseInt(strings.TrimSuffix(fi.Name(), ".bin"), 10, 16)
+		if err != nil {
+			continue
+		}
+		if !hasRecords(filepath.Join(dir, fi.Name())) {
+			continue
+		}
+		years = append(years, int16(year))
+	}
+	sort.Slice(years, func(i, j int) bool { return years[i] < years[j] })
+
+	yearsCache[dir] = yearsEntry{years: years, expires: time.Now().Add(yearsCacheTTL)}
+	return years, nil
+}
+
+// hasRecords tells if the year file at path holds a record, from its stats
+// or else a scan. A file that can't be scanned is assumed to hold some.
+func hasRecords(path string) bool {
+	if st := ReadFileStats(path); st != nil {
+		return st.RowCount > 0
+	}
+	tbi, err := NewTimeBucketInfoFromFile(path)
+	if err != nil {
+		return true
+	}
+	st, err := buildFileStats(tbi)
+	if err != nil {
+		return true
+	}
+	return st.RowCount > 0
+}
@@ -0,0 +1,95 @@
+package frontend
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"regexp"
+	"time"
+
+	"github.com/alpacahq/marketstore/utils"
+	. "github.com/alpacahq/marketstore/utils/log"
+)
+
+const redacted = "[REDACTED]"
+
+// Names of struct fields and config map keys whose values are not served
+var credentialName = regexp.MustCompile(`(?i)(password|token|secret|key)$`)
+
+// ConfigHandler serves the running instance configuration as JSON, with the
+// values of credential-looking fields replaced by "[REDACTED]", including
+// those within the configs of triggers and background workers
+func ConfigHandler(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(rw).Encode(redact(reflect.ValueOf(utils.InstanceConfig)))
+	if err != nil {
+		Log(ERROR, "Failed to write config message - Error: %v", err)
+	}
+}
+
+// redact converts v into values encoding/json marshals the same way, with
+// credential fields and map entries replaced by the redacted marker
+func redact(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		if loc, ok := v.Interface().(*time.Location); ok {
+			return loc.String()
+		}
+		return redact(v.Elem())
+	case reflect.Struct:
+		if _, ok := v.Interface().(json.Marshaler); ok {
+			return v.Interface()
+		}
+		out := map[string]interface{}{}
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			if field.Tag.Get("json") == "-" || credentialName.MatchString(field.Name) {
+				out[field.Name] = redacted
+			} else {
+				out[field.Name] = redact(v.Field(i))
+			}
+		}
+		return out
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		out := map[string]interface{}{}
+		for _, k := range v.MapKeys() {
+			name, ok := k.Interface().(string)
+			if !ok {
+				// Keys of config maps parsed from yaml are strings
+				continue
+			}
+			if credentialName.MatchString(name) {
+				out[name] = redacted
+			} else {
+				out[name] = redact(v.MapIndex(k))
+			}
+		}
+		return out
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		out := make([]interface{}, v.Len())
+		for i := range out {
+			out[i] = redact(v.Index(i))
+		}
+		return out
+	}
+	return v.Interface()
+}
@@ -0,0 +1,51 @@
+package frontend
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/alpacahq/marketstore/utils"
+	. "gopkg.in/check.v1"
+)
+
+func (s *ServerTestSuite) TestConfigHandler(c *C) {
+	saved := utils.InstanceConfig.BgWorkers
+	defer func() { utils.InstanceConfig.BgWorkers = saved }()
+	utils.InstanceConfig.BgWorkers = []*utils.BgWorkerSetting{{
+		Module: "gdaxfeeder.so",
+		Name:   "GdaxFetcher",
+		Config: map[string]interface{}{
+			"symbols": []interface{}{"BTC"},
+			"api_key": "abc",
+			"auth":    map[interface{}]interface{}{"password": "def"},
+		},
+	}}
+
+	rec := httptest.NewRecorder()
+	ConfigHandler(rec, httptest.NewRequest("GET", "/config", nil))
+	c.Assert(rec.Code, Equals, http.StatusOK)
+	c.Assert(strings.Contains(rec.Body.String(), "abc"), Equals, false)
+	c.Assert(strings.Contains(rec.Body.String(), "def"), Equals, false)
+	var msg struct {
+		RootDirectory string
+		Timezone      string
+		BgWorkers     []struct {
+			Name   string
+			Config map[string]interface{}
+		}
+	}
+	c.Assert(json.NewDecoder(rec.Body).Decode(&msg), IsNil)
+	c.Assert(msg.RootDirectory, Equals, utils.InstanceConfig.RootDirectory)
+	c.Assert(msg.Timezone, Equals, utils.InstanceConfig.Timezone.String())
+	c.Assert(msg.BgWorkers, HasLen, 1)
+	config := msg.BgWorkers[0].Config
+	c.Assert(config["api_key"], Equals, "[REDACTED]")
+	c.Assert(config["auth"], DeepEquals, map[string]interface{}{"password": "[REDACTED]"})
+	c.Assert(config["symbols"], DeepEquals, []interface{}{"BTC"})
+
+	rec = httptest.NewRecorder()
+	ConfigHandler(rec, httptest.NewRequest("POST", "/config", nil))
+	c.Assert(rec.Code, Equals, http.StatusMethodNotAllowed)
+}
@@ -0,0 +1,52 @@
+package frontend
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/alpacahq/marketstore/executor"
+	"github.com/alpacahq/marketstore/utils/io"
+	. "github.com/alpacahq/marketstore/utils/log"
+)
+
+const metadataPath = "/catalog/metadata/"
+
+// BucketMetadataMessage holds the creation and last write times of a bucket,
+// which are left out when they are not known
+type BucketMetadataMessage struct {
+	Key            string     `json:"key"`
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	LastModifiedAt *time.Time `json:"last_modified_at,omitempty"`
+}
+
+// BucketMetadataHandler serves the metadata of the bucket key that follows
+// the path prefix, e.g. GET /catalog/metadata/AAPL/1Min/OHLCV
+func BucketMetadataHandler(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	keyStr := strings.TrimPrefix(r.URL.Path, metadataPath)
+	if keyStr == "" {
+		http.Error(rw, "missing bucket key", http.StatusBadRequest)
+		return
+	}
+	tbi, err := executor.ThisInstance.CatalogDir.GetLatestTimeBucketInfoFromKey(io.NewTimeBucketKey(keyStr))
+	if err != nil {
+		http.Error(rw, "bucket not found", http.StatusNotFound)
+		return
+	}
+	msg := BucketMetadataMessage{Key: keyStr}
+	if createdAt := tbi.GetCreatedAt(); !createdAt.IsZero() {
+		msg.CreatedAt = &createdAt
+	}
+	if lastModifiedAt := tbi.GetLastModifiedAt(); !lastModifiedAt.IsZero() {
+		msg.LastModifiedAt = &lastModifiedAt
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err = json.NewEncoder(rw).Encode(msg); err != nil {
+		Log(ERROR, "Failed to write bucket metadata message - Error: %v", err)
+	}
+}
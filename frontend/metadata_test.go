@@ -0,0 +1,47 @@
+package frontend
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/alpacahq/marketstore/executor"
+	"github.com/alpacahq/marketstore/utils/io"
+	. "gopkg.in/check.v1"
+)
+
+func (s *ServerTestSuite) TestBucketMetadataHandler(c *C) {
+	get := func(path string) (int, BucketMetadataMessage) {
+		rec := httptest.NewRecorder()
+		BucketMetadataHandler(rec, httptest.NewRequest("GET", path, nil))
+		msg := BucketMetadataMessage{}
+		if rec.Code == http.StatusOK {
+			c.Assert(json.NewDecoder(rec.Body).Decode(&msg), IsNil)
+		}
+		return rec.Code, msg
+	}
+
+	// The bucket is created by its first write
+	before := time.Now()
+	cs := io.NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{time.Date(2003, time.January, 2, 0, 0, 0, 0, time.UTC).Unix()})
+	cs.AddColumn("Open", []float32{1})
+	csm := io.NewColumnSeriesMap()
+	csm.AddColumnSeries(*io.NewTimeBucketKey("METADATA/1Min/OHLC"), cs)
+	c.Assert(executor.WriteCSM(csm, false), IsNil)
+
+	code, msg := get("/catalog/metadata/METADATA/1Min/OHLC")
+	c.Assert(code, Equals, http.StatusOK)
+	c.Assert(msg.Key, Equals, "METADATA/1Min/OHLC")
+	c.Assert(msg.CreatedAt, NotNil)
+	c.Assert(msg.LastModifiedAt, NotNil)
+	c.Assert(msg.CreatedAt.Before(before), Equals, false)
+	c.Assert(msg.LastModifiedAt.Before(*msg.CreatedAt), Equals, false)
+
+	code, _ = get("/catalog/metadata/NOSUCH/1Min/OHLC")
+	c.Assert(code, Equals, http.StatusNotFound)
+	rec := httptest.NewRecorder()
+	BucketMetadataHandler(rec, httptest.NewRequest("POST", "/catalog/metadata/METADATA/1Min/OHLC", nil))
+	c.Assert(rec.Code, Equals, http.StatusMethodNotAllowed)
+}
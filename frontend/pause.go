@@ -0,0 +1,36 @@
+package frontend
+
+import (
+	"net/http"
+
+	"github.com/alpacahq/marketstore/executor"
+	. "github.com/alpacahq/marketstore/utils/log"
+)
+
+// PauseHandler halts all writes until POST /resume, e.g. while the year
+// files are linked for a snapshot. It responds once the writes in progress
+// are done and on disk.
+func PauseHandler(rw http.ResponseWriter, r *http.Request) {
+	serveWriteGate(rw, r, "pause", executor.Pause)
+}
+
+// ResumeHandler lets the writes halted by POST /pause proceed
+func ResumeHandler(rw http.ResponseWriter, r *http.Request) {
+	serveWriteGate(rw, r, "resume", executor.Resume)
+}
+
+func serveWriteGate(rw http.ResponseWriter, r *http.Request, action string, gate func() error) {
+	if r.Method != http.MethodPost {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	switch err := gate(); err {
+	case nil:
+		Log(INFO, "Writes are %sd", action)
+		rw.WriteHeader(http.StatusOK)
+	case executor.ErrAlreadyPaused, executor.ErrNotPaused, executor.ErrReadOnly, executor.ErrCatalogLocked:
+		http.Error(rw, err.Error(), http.StatusConflict)
+	default:
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -39,6 +39,9 @@ type QueryRequest struct {
 
 	// Support for functions is experimental and subject to change
 	Functions []string `msgpack:"functions,omitempty"`
+
+	// ID of the replica expected to serve the request, any replica if empty
+	ReplicaID string `msgpack:"replica_id,omitempty"`
 }
 
 type MultiQueryRequest struct {
@@ -50,12 +53,32 @@ type MultiQueryRequest struct {
 
 type QueryResponse struct {
 	Result *io.NumpyMultiDataset `msgpack:"result"`
+	// Estimate of the time taken to read the data, made before reading it
+	// from the scan rates of the storage devices, 0 when unknown
+	EstimatedDurationMS int64 `msgpack:"estimated_duration_ms,omitempty"`
+}
+
+// MarshalCBOR encodes the response for clients asking for CBOR, with the
+// result as a ColumnSeriesMap so that its epochs are tagged as date/times
+func (qr QueryResponse) MarshalCBOR() ([]byte, error) {
+	var csm io.ColumnSeriesMap
+	if qr.Result != nil {
+		var err error
+		if csm, err = qr.Result.ToColumnSeriesMap(); err != nil {
+			return nil, err
+		}
+	}
+	response := map[string]interface{}{"result": csm}
+	if qr.EstimatedDurationMS != 0 {
+		response["estimated_duration_ms"] = qr.EstimatedDurationMS
+	}
+	return io.CBOREncMode.Marshal(response)
 }
 
 type MultiQueryResponse struct {
-	Responses []QueryResponse `msgpack:"responses"`
-	Version   string          `msgpack:"version"`  // Server Version
-	Timezone  string          `msgpack:"timezone"` // Server Timezone
+	Responses []QueryResponse `msgpack:"responses" cbor:"responses"`
+	Version   string          `msgpack:"version" cbor:"version"`   // Server Version
+	Timezone  string          `msgpack:"timezone" cbor:"timezone"` // Server Timezone
 }
 
 // ToColumnSeriesMap converts a MultiQueryResponse to a
@@ -112,7 +135,7 @@ func (s *DataService) Query(r *http.Request, reqs *MultiQueryRequest, response *
 			}
 			response.Responses = append(response.Responses,
 				QueryResponse{
-					nmds,
+					Result: nmds,
 				})
 
 		case false:
@@ -157,10 +180,11 @@ func (s *DataService) Query(r *http.Request, reqs *MultiQueryRequest, response *
 
 			start := io.ToSystemTimezone(time.Unix(epochStart, 0))
 			stop := io.ToSystemTimezone(time.Unix(epochEnd, 0))
-			csm, tpm, err := executeQuery(
+			csm, tpm, estimate, err := executeQuery(
 				dest,
 				start, stop,
 				limitRecordCount, limitFromStart,
+				req.ReplicaID,
 			)
 			if err != nil {
 				return err
@@ -207,7 +231,8 @@ func (s *DataService) Query(r *http.Request, reqs *MultiQueryRequest, response *
 			}
 			response.Responses = append(response.Responses,
 				QueryResponse{
-					nmds,
+					Result:              nmds,
+					EstimatedDurationMS: int64(estimate / time.Millisecond),
 				})
 
 		}
@@ -236,7 +261,7 @@ Utility functions
 */
 
 func executeQuery(tbk *io.TimeBucketKey, start, end time.Time, LimitRecordCount int,
-	LimitFromStart bool) (io.ColumnSeriesMap, map[io.TimeBucketKey]int64, error) {
+	LimitFromStart bool, replicaID string) (io.ColumnSeriesMap, map[io.TimeBucketKey]int64, time.Duration, error) {
 
 	query := planner.NewQuery(executor.ThisInstance.CatalogDir)
 
@@ -273,19 +298,25 @@ func executeQuery(tbk *io.TimeBucketKey, start, end time.Time, LimitRecordCount
 		} else {
 			log.Log(log.ERROR, "Parsing query: %s\n", err)
 		}
-		return nil, nil, err
+		return nil, nil, 0, err
 	}
+	parseResult.ReplicaID = replicaID
 	scanner, err := executor.NewReader(parseResult)
 	if err != nil {
 		log.Log(log.ERROR, "Unable to create scanner: %s\n", err)
-		return nil, nil, err
+		return nil, nil, 0, err
+	}
+	// The estimate is 0 if any of the keys can not be estimated
+	estimate, err := scanner.EstimateQueryTime()
+	if err != nil {
+		estimate = 0
 	}
 	csm, tPrevMap, err := scanner.Read()
 	if err != nil {
 		log.Log(log.ERROR, "Error returned from query scanner: %s\n", err)
-		return nil, nil, err
+		return nil, nil, 0, err
 	}
-	return csm, tPrevMap, err
+	return csm, tPrevMap, estimate, err
 }
 
 func runAggFunctions(callChain []string, csInput *io.ColumnSeries) (cs *io.ColumnSeries, err error) {
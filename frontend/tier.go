@@ -0,0 +1,57 @@
+package frontend
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/alpacahq/marketstore/executor"
+	"github.com/alpacahq/marketstore/utils/io"
+	. "github.com/alpacahq/marketstore/utils/log"
+)
+
+const tierPath = "/admin/tier/"
+
+// TierHandler moves a year file to or back from the cold storage, e.g.
+// POST /admin/tier/promote?key=AAPL/1Min/OHLCV&year=2010 or
+// POST /admin/tier/demote?key=AAPL/1Min/OHLCV&year=2010
+func TierHandler(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !isAdmin(r) {
+		http.Error(rw, "admin authentication required", http.StatusUnauthorized)
+		return
+	}
+	var move func(io.TimeBucketKey, int16) error
+	switch action := strings.TrimPrefix(r.URL.Path, tierPath); action {
+	case "promote":
+		move = executor.PromoteYearFile
+	case "demote":
+		move = executor.DemoteYearFile
+	default:
+		http.Error(rw, "unknown tier action: "+action, http.StatusNotFound)
+		return
+	}
+	keyStr := r.URL.Query().Get("key")
+	if keyStr == "" {
+		http.Error(rw, "missing bucket key", http.StatusBadRequest)
+		return
+	}
+	year, err := strconv.ParseInt(r.URL.Query().Get("year"), 10, 16)
+	if err != nil {
+		http.Error(rw, "invalid year: "+r.URL.Query().Get("year"), http.StatusBadRequest)
+		return
+	}
+
+	switch err = move(*io.NewTimeBucketKey(keyStr), int16(year)); err {
+	case nil:
+		Log(INFO, "Tier: %s of %s %d done", r.URL.Path, keyStr, year)
+		rw.WriteHeader(http.StatusOK)
+	case executor.ErrYearFileBusy, executor.ErrReadOnly, executor.ErrCatalogLocked:
+		http.Error(rw, err.Error(), http.StatusConflict)
+	default:
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+	}
+}
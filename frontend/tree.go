@@ -0,0 +1,23 @@
+package frontend
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/alpacahq/marketstore/executor"
+	. "github.com/alpacahq/marketstore/utils/log"
+)
+
+// TreeHandler serves the bucket hierarchy of the catalog with the file count
+// and total byte size at each level, e.g. GET /catalog/tree
+func TreeHandler(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(rw).Encode(executor.ThisInstance.CatalogDir.Tree())
+	if err != nil {
+		Log(ERROR, "Failed to write catalog tree - Error: %v", err)
+	}
+}
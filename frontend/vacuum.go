@@ -0,0 +1,79 @@
+package frontend
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/alpacahq/marketstore/executor"
+	"github.com/alpacahq/marketstore/utils"
+	. "github.com/alpacahq/marketstore/utils/log"
+)
+
+// VacuumHandler compacts the year files with mostly empty intervals, e.g.
+// POST /admin/vacuum?null_ratio=0.8, streaming the progress of each file as
+// a line of JSON. The null ratio defaults to the one of the vacuum policy.
+func VacuumHandler(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !isAdmin(r) {
+		http.Error(rw, "admin authentication required", http.StatusUnauthorized)
+		return
+	}
+	nullRatio := utils.InstanceConfig.VacuumPolicy.NullRatio
+	if param := r.URL.Query().Get("null_ratio"); param != "" {
+		var err error
+		if nullRatio, err = strconv.ParseFloat(param, 64); err != nil {
+			http.Error(rw, "invalid null_ratio: "+param, http.StatusBadRequest)
+			return
+		}
+	}
+
+	enc := json.NewEncoder(rw)
+	flusher, _ := rw.(http.Flusher)
+	streaming := false
+	err := executor.Vacuum(nullRatio, func(vp executor.VacuumProgress) {
+		if !streaming {
+			rw.Header().Set("Content-Type", "application/x-ndjson")
+			streaming = true
+		}
+		if err := enc.Encode(vp); err != nil {
+			Log(ERROR, "Failed to write vacuum progress - Error: %v", err)
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	})
+	switch {
+	case err == nil:
+		Log(INFO, "Vacuum done")
+	case streaming:
+		// The status is sent with the first line, the error ends the stream
+		Log(ERROR, "Vacuum - Error: %v", err)
+		if err := enc.Encode(struct {
+			Error string `json:"error"`
+		}{err.Error()}); err != nil {
+			Log(ERROR, "Failed to write vacuum progress - Error: %v", err)
+		}
+	case err == executor.ErrReadOnly:
+		http.Error(rw, err.Error(), http.StatusConflict)
+	default:
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+	}
+}
+
+// isAdmin returns true if the request carries the admin token of the
+// configuration as "Authorization: Bearer <token>". No request is an admin
+// one when the token is not set.
+func isAdmin(r *http.Request) bool {
+	token := utils.InstanceConfig.AdminToken
+	auth := r.Header.Get("Authorization")
+	if token == "" || !strings.HasPrefix(auth, "Bearer ") {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(auth, "Bearer ")), []byte(token)) == 1
+}
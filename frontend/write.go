@@ -21,12 +21,12 @@ type MultiWriteRequest struct {
 }
 
 type WriteResponse struct {
-	Error   string `msgpack:"error"`
-	Version string `msgpack:"version"` // Server Version
+	Error   string `msgpack:"error" cbor:"error"`
+	Version string `msgpack:"version" cbor:"version"` // Server Version
 }
 
 type MultiWriteResponse struct {
-	Responses []WriteResponse `msgpack:"responses"`
+	Responses []WriteResponse `msgpack:"responses" cbor:"responses"`
 }
 
 func (s *DataService) Write(r *http.Request, reqs *MultiWriteRequest, response *MultiWriteResponse) (err error) {
@@ -0,0 +1,50 @@
+package frontend
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/alpacahq/marketstore/executor"
+	"github.com/alpacahq/marketstore/utils/io"
+	. "github.com/alpacahq/marketstore/utils/log"
+)
+
+const yearsPath = "/catalog/years/"
+
+type YearsMessage struct {
+	Key   string  `json:"key"`
+	Years []int16 `json:"years"`
+}
+
+// YearsHandler serves the years holding data for the bucket key that
+// follows the path prefix, e.g. GET /catalog/years/AAPL/1Min/OHLCV
+func YearsHandler(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	keyStr := strings.TrimPrefix(r.URL.Path, yearsPath)
+	if keyStr == "" {
+		http.Error(rw, "missing bucket key", http.StatusBadRequest)
+		return
+	}
+	key := io.NewTimeBucketKey(keyStr)
+	years, err := executor.ListYearsWithData(*key)
+	if os.IsNotExist(err) {
+		http.Error(rw, "bucket not found", http.StatusNotFound)
+		return
+	} else if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(rw).Encode(YearsMessage{
+		Key:   keyStr,
+		Years: years,
+	})
+	if err != nil {
+		Log(ERROR, "Failed to write years message - Error: %v", err)
+	}
+}
@@ -0,0 +1,27 @@
+package frontend
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *ServerTestSuite) TestYearsHandler(c *C) {
+	rec := httptest.NewRecorder()
+	YearsHandler(rec, httptest.NewRequest("GET", "/catalog/years/EURUSD/1Min/OHLC", nil))
+	c.Assert(rec.Code, Equals, http.StatusOK)
+	msg := YearsMessage{}
+	c.Assert(json.NewDecoder(rec.Body).Decode(&msg), IsNil)
+	c.Assert(msg.Key, Equals, "EURUSD/1Min/OHLC")
+	c.Assert(msg.Years, DeepEquals, []int16{2000, 2001, 2002})
+
+	rec = httptest.NewRecorder()
+	YearsHandler(rec, httptest.NewRequest("GET", "/catalog/years/NOSUCH/1Min/OHLC", nil))
+	c.Assert(rec.Code, Equals, http.StatusNotFound)
+
+	rec = httptest.NewRecorder()
+	YearsHandler(rec, httptest.NewRequest("POST", "/catalog/years/EURUSD/1Min/OHLC", nil))
+	c.Assert(rec.Code, Equals, http.StatusMethodNotAllowed)
+}
@@ -52,10 +52,17 @@ type RowLimit struct {
 	Number int32
 	// -1 backward, 1 forward
 	Direction DirectionEnum
+	// Number is a percentage (0-100) of the rows available for each key
+	IsPercent bool
+	// Each row is kept with a probability of SamplePercent (0-100), drawn
+	// from a source seeded with SampleSeed
+	IsSample      bool
+	SamplePercent float64
+	SampleSeed    int64
 }
 
 func NewRowLimit() *RowLimit {
-	r := RowLimit{math.MaxInt32, FIRST}
+	r := RowLimit{math.MaxInt32, FIRST, false, false, 0, 0}
 	return &r
 }
 
@@ -71,6 +78,23 @@ type ParseResult struct {
 	IntervalsPerDay int64
 	RootDir         string
 	TimeQuals       []TimeQualFunc
+	// RequireNonEmpty makes reads fail when a key has no rows in the range
+	RequireNonEmpty bool
+	// AllowPartialResults makes reads return the keys that could be read
+	// when others fail, rather than failing as a whole
+	AllowPartialResults bool
+	// ReplicaID restricts the read to the replica with this ID, any
+	// replica serves it when empty
+	ReplicaID string
+	// MaxConcurrency caps the number of keys read at once, which is never
+	// more than the read_workers of the instance, the default when it is 0
+	MaxConcurrency int
+	// FailOnNaN makes reads fail on NaN and infinite float values rather
+	// than only counting them in the ScanStats
+	FailOnNaN bool
+	// ColumnFilter lists the columns returned by reads, all of them when
+	// empty. The Epoch is always returned.
+	ColumnFilter []string
 }
 
 func NewParseResult() *ParseResult {
@@ -109,7 +133,11 @@ func (pr *ParseResult) GetCandleAttributes() (cat map[TimeBucketKey]*CandleAttri
 
 func (pr *ParseResult) GetDataShapes() (dsv map[TimeBucketKey][]DataShape) {
 	dsv = make(map[TimeBucketKey][]DataShape)
+	latest := pr.latestFiles()
 	for _, qf := range pr.QualifiedFiles {
+		if qf.File != latest[qf.Key] {
+			continue
+		}
 		/*
 			Obtain the dataShapes for the DB columns
 		*/
@@ -127,17 +155,36 @@ func (pr *ParseResult) GetDataShapes() (dsv map[TimeBucketKey][]DataShape) {
 
 func (pr *ParseResult) GetRowLen() (rlenMap map[TimeBucketKey]int) {
 	rlenMap = make(map[TimeBucketKey]int)
+	latest := pr.latestFiles()
 	for _, qf := range pr.QualifiedFiles {
+		if qf.File != latest[qf.Key] {
+			continue
+		}
 		switch qf.File.GetRecordType() {
 		case FIXED:
 			rlenMap[qf.Key] = int(qf.File.GetRecordLength())
 		case VARIABLE:
-			rlenMap[qf.Key] = int(qf.File.GetVariableRecordLength())
+			if varRecLen, err := qf.File.GetVariableRecordLength(); err == nil {
+				rlenMap[qf.Key] = int(varRecLen)
+			}
 		}
 	}
 	return rlenMap
 }
 
+// latestFiles returns the file with the newest schema for each key. Files
+// written before columns were added to a bucket hold a prefix of its columns.
+func (pr *ParseResult) latestFiles() (latest map[TimeBucketKey]*TimeBucketInfo) {
+	latest = make(map[TimeBucketKey]*TimeBucketInfo)
+	for _, qf := range pr.QualifiedFiles {
+		if tbi, ok := latest[qf.Key]; !ok || tbi.GetSchemaVersion() < qf.File.GetSchemaVersion() ||
+			(tbi.GetSchemaVersion() == qf.File.GetSchemaVersion() && tbi.Year < qf.File.Year) {
+			latest[qf.Key] = qf.File
+		}
+	}
+	return latest
+}
+
 func ElementsEqual(left, right []EnumElementType) (isEqual bool) {
 	if len(left) != len(right) {
 		return false
@@ -192,6 +239,23 @@ func (q *query) SetRowLimit(direction DirectionEnum, rowLimit int) {
 	q.Limit.Direction = direction
 }
 
+// SetRowLimitPercent limits the result of each key to percent of its rows
+// within the range, e.g. for sampling
+func (q *query) SetRowLimitPercent(direction DirectionEnum, percent int) {
+	q.SetRowLimit(direction, percent)
+	q.Limit.IsPercent = true
+}
+
+// SetSamplePercent keeps each row with a probability of percent, e.g. for
+// exploratory queries, as an alternative to a row limit. The same seed gives
+// the same sample of the same data.
+func (q *query) SetSamplePercent(percent float64, seed int64) {
+	q.Limit = NewRowLimit()
+	q.Limit.IsSample = true
+	q.Limit.SamplePercent = percent
+	q.Limit.SampleSeed = seed
+}
+
 func (q *query) SetRange(start, end int64) {
 	q.Range = new(DateRange)
 	q.SetStart(start)
@@ -239,6 +303,11 @@ func (q *query) Parse() (pr *ParseResult, err error) {
 			return nil, errors.New(fmt.Sprintf("Category: %s not in catalog\n", key))
 		}
 	}
+	for _, tf := range q.Restriction.getItemList("Timeframe") {
+		if _, ok := LookupTimeframe(tf); !ok {
+			return nil, fmt.Errorf("Timeframe: %s is not a known timeframe", tf)
+		}
+	}
 
 	// This method conditionally recurses the directory looking for restricted matches
 	// We can not use the simple Directory.Recurse() because of the conditional descent...
@@ -60,3 +60,25 @@ func (s *TestSuite) TestQuery(c *C) {
 	qfs := pr.QualifiedFiles
 	c.Assert(len(qfs), Equals, 54)
 }
+
+func (s *TestSuite) TestTimeframeRegistry(c *C) {
+	tf, ok := LookupTimeframe("6H")
+	c.Assert(ok, Equals, true)
+	c.Assert(tf.Duration, Equals, 6*time.Hour)
+
+	_, ok = LookupTimeframe("Fortnightly")
+	c.Assert(ok, Equals, false)
+	q := NewQuery(s.DataDirectory)
+	q.AddRestriction("Timeframe", "Fortnightly")
+	_, err := q.Parse()
+	c.Assert(err, ErrorMatches, "Timeframe: Fortnightly is not a known timeframe")
+
+	RegisterTimeframe("Fortnightly", 14*24*60*60)
+	tf, ok = LookupTimeframe("Fortnightly")
+	c.Assert(ok, Equals, true)
+	c.Assert(tf.String, Equals, "Fortnightly")
+	c.Assert(tf.Duration, Equals, 14*24*time.Hour)
+	// Known, but there is no such bucket
+	_, err = q.Parse()
+	c.Assert(err, ErrorMatches, "No files returned from query parse")
+}
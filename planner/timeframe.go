@@ -0,0 +1,25 @@
+package planner
+
+import (
+	"time"
+
+	"github.com/alpacahq/marketstore/utils"
+)
+
+// RegisterTimeframe makes name a timeframe of intervals seconds, e.g.
+// RegisterTimeframe("Weekly", 604800), so that buckets with non-standard bar
+// intervals can be written and queried. Names of the <multiplier><unit>
+// form, e.g. 6H, are known without being registered. It panics if intervals
+// is not positive.
+func RegisterTimeframe(name string, intervals int64) {
+	utils.RegisterCustomTimeframe(name, time.Duration(intervals)*time.Second)
+}
+
+// LookupTimeframe returns the timeframe of the registered or standard name
+func LookupTimeframe(name string) (utils.Timeframe, bool) {
+	tf := utils.TimeframeFromString(name)
+	if tf == nil {
+		return utils.Timeframe{}, false
+	}
+	return *tf, true
+}
@@ -0,0 +1,115 @@
+package zscore
+
+import (
+	"fmt"
+	"github.com/alpacahq/marketstore/uda"
+	"github.com/alpacahq/marketstore/utils/functions"
+	"github.com/alpacahq/marketstore/utils/io"
+)
+
+var (
+	requiredColumns = []io.DataShape{
+		{Name: "*", Type: io.FLOAT32},
+	}
+
+	optionalColumns = []io.DataShape{}
+
+	initArgs = []io.DataShape{}
+)
+
+/*
+	ZScore normalizes a column to (x - mean) / stddev, with one output row per
+	input row, e.g. SELECT ZScore(Close) AS Close_Z FROM `AAPL/1Min/OHLC`
+*/
+type ZScore struct {
+	uda.AggInterface
+
+	// Input arguments mapping
+	ArgMap *functions.ArgumentMap
+
+	Epochs []int64
+	Values []float32
+}
+
+func (zs *ZScore) GetRequiredArgs() []io.DataShape {
+	return requiredColumns
+}
+func (zs *ZScore) GetOptionalArgs() []io.DataShape {
+	return optionalColumns
+}
+func (zs *ZScore) GetInitArgs() []io.DataShape {
+	return initArgs
+}
+
+/*
+	Accum() sends new data to the aggregate
+*/
+func (zs *ZScore) Accum(cols io.ColumnInterface) error {
+	if cols.Len() == 0 {
+		return nil
+	}
+	epochs, ok := cols.GetColumn("Epoch").([]int64)
+	if !ok {
+		return fmt.Errorf("Unable to retrieve column named Epoch")
+	}
+	inputColDSV := zs.ArgMap.GetMappedColumns(requiredColumns[0].Name)
+	inputColName := inputColDSV[0].Name
+	inputCol, err := uda.ColumnToFloat32(cols, inputColName)
+	if err != nil {
+		return err
+	}
+	zs.Epochs = append(zs.Epochs, epochs...)
+	zs.Values = append(zs.Values, inputCol...)
+	return nil
+}
+
+/*
+	Creates a new zscore using the arguments of the specific implementation
+	for inputColumns and optionalInputColumns
+*/
+func (z ZScore) New() (out uda.AggInterface, am *functions.ArgumentMap) {
+	zs := NewZScore(requiredColumns, optionalColumns)
+	return zs, zs.ArgMap
+}
+
+/*
+CONCRETE - these may be suitable methods for general usage
+*/
+func NewZScore(inputColumns, optionalInputColumns []io.DataShape) (zs *ZScore) {
+	zs = new(ZScore)
+	zs.ArgMap = functions.NewArgumentMap(inputColumns, optionalInputColumns...)
+	return zs
+}
+func (zs *ZScore) Init(itf ...interface{}) error {
+	if unmapped := zs.ArgMap.Validate(); unmapped != nil {
+		return fmt.Errorf("Unmapped columns: %s", unmapped)
+	}
+	zs.Reset()
+	return nil
+}
+
+/*
+	Output() returns the currently valid output of this aggregate, the scores
+	are all zero if the input has zero variance
+*/
+func (zs *ZScore) Output() *io.ColumnSeries {
+	input := io.NewColumnSeries()
+	input.AddColumn("Epoch", zs.Epochs)
+	input.AddColumn("ZScore", zs.Values)
+	scores, err := input.ZScore("ZScore")
+	if _, ok := err.(io.ZeroVarianceWarning); err != nil && !ok {
+		return nil
+	}
+	cs := io.NewColumnSeries()
+	cs.AddColumn("Epoch", zs.Epochs)
+	cs.AddColumn("ZScore", scores)
+	return cs
+}
+
+/*
+	Reset() puts the aggregate state back to "new"
+*/
+func (zs *ZScore) Reset() {
+	zs.Epochs = nil
+	zs.Values = nil
+}
@@ -13,8 +13,62 @@ import (
 
 var InstanceConfig MktsConfig
 
+// DefaultMaxVariableRecordLen is the default limit, in bytes, of the payload
+// written at a single interval of a variable record bucket
+const DefaultMaxVariableRecordLen = 65535
+
+// DefaultLastKnownWarmYears is the default number of the most recent year
+// files of each bucket whose read hints are set on startup
+const DefaultLastKnownWarmYears = 10
+
+// DefaultLastKnownCheckpointInterval is how often the read hints are saved
+// to the root directory by default
+const DefaultLastKnownCheckpointInterval = 30 * time.Second
+
+// DefaultPausedWriteTimeout is how long a write waits by default for writes
+// to be resumed once they are paused
+const DefaultPausedWriteTimeout = 30 * time.Second
+
+// DefaultVacuumNullRatio is the share of the intervals of a year file that
+// must hold no record for the file to be vacuumed by default
+const DefaultVacuumNullRatio = 0.5
+
+// DefaultBulkWriteBatchSize is the default number of requests a bulk writer
+// groups in a batch
+const DefaultBulkWriteBatchSize = 1000
+
 func init() {
 	InstanceConfig.Timezone = time.UTC
+	InstanceConfig.MaxVariableRecordLen = DefaultMaxVariableRecordLen
+	InstanceConfig.LastKnownWarmYears = DefaultLastKnownWarmYears
+	InstanceConfig.LastKnownCheckpointInterval = DefaultLastKnownCheckpointInterval
+	InstanceConfig.PausedWriteTimeout = DefaultPausedWriteTimeout
+	InstanceConfig.VacuumPolicy.NullRatio = DefaultVacuumNullRatio
+	InstanceConfig.BulkWriteBatchSize = DefaultBulkWriteBatchSize
+}
+
+// ErrInvalidTimezone is returned by ValidateConfig when the timezone is not
+// a name of the IANA time zone database
+type ErrInvalidTimezone struct {
+	Name  string
+	Cause error
+}
+
+func (e ErrInvalidTimezone) Error() string {
+	return fmt.Sprintf("Invalid timezone %q: %v, use a name of the IANA time zone database "+
+		"such as America/New_York, Europe/London, Asia/Tokyo or UTC", e.Name, e.Cause)
+}
+
+// ValidateConfig checks the settings of cfg that can't be checked while
+// parsing, and loads its timezone
+func ValidateConfig(cfg *MktsConfig) error {
+	// Giving "" to LoadLocation will be UTC anyway, which is our default too.
+	tz, err := time.LoadLocation(cfg.TimezoneName)
+	if err != nil {
+		return ErrInvalidTimezone{Name: cfg.TimezoneName, Cause: err}
+	}
+	cfg.Timezone = tz
+	return nil
 }
 
 type TriggerSetting struct {
@@ -29,35 +83,78 @@ type BgWorkerSetting struct {
 	Config map[string]interface{}
 }
 
+// VacuumPolicy is when year files are vacuumed
+type VacuumPolicy struct {
+	// Share of the intervals of a year file without a record above which
+	// the file is vacuumed
+	NullRatio float64
+	// Time between the automatic vacuums, never when 0
+	Interval time.Duration
+}
+
 type MktsConfig struct {
-	RootDirectory     string
-	ListenPort        string
-	Timezone          *time.Location
-	Queryable         bool
-	StopGracePeriod   time.Duration
-	WALRotateInterval int
-	EnableAdd         bool
-	EnableRemove      bool
-	EnableLastKnown   bool
-	StartTime         time.Time
-	Triggers          []*TriggerSetting
-	BgWorkers         []*BgWorkerSetting
+	RootDirectory               string
+	ListenPort                  string
+	Timezone                    *time.Location
+	TimezoneName                string
+	Queryable                   bool
+	StopGracePeriod             time.Duration
+	WALRotateInterval           int
+	EnableAdd                   bool
+	EnableRemove                bool
+	EnableLastKnown             bool
+	LastKnownMaxAge             time.Duration
+	LastKnownWarmYears          int
+	LastKnownCheckpointInterval time.Duration
+	LockFreeHints               bool
+	MaxVariableRecordLen        int
+	ReadOnly                    bool
+	DSyncWrites                 bool
+	UseMmap                     bool
+	PausedWriteTimeout          time.Duration
+	BulkWriteBatchSize          int
+	ReadWorkers                 int
+	KafkaBrokers                []string
+	KafkaSchemaRegistry         string
+	ReplicaID                   string
+	AdminToken                  string
+	VacuumPolicy                VacuumPolicy
+	ColdStorageDirectory        string
+	ColdAfterYears              int
+	StartTime                   time.Time
+	Triggers                    []*TriggerSetting
+	BgWorkers                   []*BgWorkerSetting
 }
 
 func (m *MktsConfig) Parse(data []byte) error {
 	var err error
 	var aux struct {
-		RootDirectory     string `yaml:"root_directory"`
-		ListenPort        string `yaml:"listen_port"`
-		Timezone          string `yaml:"timezone"`
-		LogLevel          string `yaml:"log_level"`
-		Queryable         string `yaml:"queryable"`
-		StopGracePeriod   int    `yaml:"stop_grace_period"`
-		WALRotateInterval int    `yaml:"wal_rotate_interval"`
-		EnableAdd         string `yaml:"enable_add"`
-		EnableRemove      string `yaml:"enable_remove"`
-		EnableLastKnown   string `yaml:"enable_last_known"`
-		Triggers          []struct {
+		RootDirectory               string   `yaml:"root_directory"`
+		ListenPort                  string   `yaml:"listen_port"`
+		Timezone                    string   `yaml:"timezone"`
+		LogLevel                    string   `yaml:"log_level"`
+		Queryable                   string   `yaml:"queryable"`
+		StopGracePeriod             int      `yaml:"stop_grace_period"`
+		WALRotateInterval           int      `yaml:"wal_rotate_interval"`
+		EnableAdd                   string   `yaml:"enable_add"`
+		EnableRemove                string   `yaml:"enable_remove"`
+		EnableLastKnown             string   `yaml:"enable_last_known"`
+		LastKnownMaxAge             int      `yaml:"last_known_max_age"`
+		LastKnownWarmYears          int      `yaml:"last_known_warm_years"`
+		LastKnownCheckpointInterval *int     `yaml:"last_known_checkpoint_interval"`
+		LockFreeHints               string   `yaml:"lock_free_hints"`
+		MaxVariableRecordLen        int      `yaml:"max_variable_record_len"`
+		ReadOnly                    string   `yaml:"read_only"`
+		DSyncWrites                 string   `yaml:"dsync_writes"`
+		UseMmap                     string   `yaml:"use_mmap"`
+		PausedWriteTimeout          int      `yaml:"paused_write_timeout"`
+		BulkWriteBatchSize          int      `yaml:"bulk_write_batch_size"`
+		ReadWorkers                 int      `yaml:"read_workers"`
+		KafkaBrokers                []string `yaml:"kafka_brokers"`
+		KafkaSchemaRegistry         string   `yaml:"kafka_schema_registry"`
+		ReplicaID                   string   `yaml:"replica_id"`
+		AdminToken                  string   `yaml:"admin_token"`
+		Triggers                    []struct {
 			Module string                 `yaml:"module"`
 			On     string                 `yaml:"on"`
 			Config map[string]interface{} `yaml:"config"`
@@ -67,6 +164,12 @@ func (m *MktsConfig) Parse(data []byte) error {
 			Name   string                 `yaml:"name"`
 			Config map[string]interface{} `yaml:"config"`
 		} `yaml:"bgworkers"`
+		VacuumPolicy struct {
+			NullRatio float64 `yaml:"null_ratio"`
+			Interval  int     `yaml:"interval"`
+		} `yaml:"vacuum_policy"`
+		ColdStorageDirectory string `yaml:"cold_storage_directory"`
+		ColdAfterYears       int    `yaml:"cold_after_years"`
 	}
 
 	if err := yaml.Unmarshal(data, &aux); err != nil {
@@ -81,11 +184,10 @@ func (m *MktsConfig) Parse(data []byte) error {
 		return errors.New("Invalid listen port.")
 	}
 
-	// Giving "" to LoadLocation will be UTC anyway, which is our default too.
-	m.Timezone, err = time.LoadLocation(aux.Timezone)
-	if err != nil {
-		Log(FATAL, "Invalid timezone.")
-		return errors.New("Invalid timezone")
+	m.TimezoneName = aux.Timezone
+	if err = ValidateConfig(m); err != nil {
+		Log(FATAL, err.Error())
+		return err
 	}
 
 	if aux.WALRotateInterval == 0 {
@@ -109,13 +211,44 @@ func (m *MktsConfig) Parse(data []byte) error {
 			SetLogLevel(WARNING)
 		case "info":
 			SetLogLevel(INFO)
+		case "debug":
+			SetLogLevel(DEBUG)
 		}
 	} else {
 		SetLogLevel(INFO)
 	}
+	if aux.MaxVariableRecordLen > 0 {
+		m.MaxVariableRecordLen = aux.MaxVariableRecordLen
+	} else {
+		m.MaxVariableRecordLen = DefaultMaxVariableRecordLen
+	}
 	if aux.StopGracePeriod > 0 {
 		m.StopGracePeriod = time.Duration(aux.StopGracePeriod) * time.Second
 	}
+	if aux.PausedWriteTimeout > 0 {
+		m.PausedWriteTimeout = time.Duration(aux.PausedWriteTimeout) * time.Second
+	} else {
+		m.PausedWriteTimeout = DefaultPausedWriteTimeout
+	}
+	if aux.BulkWriteBatchSize > 0 {
+		m.BulkWriteBatchSize = aux.BulkWriteBatchSize
+	} else {
+		m.BulkWriteBatchSize = DefaultBulkWriteBatchSize
+	}
+	m.ReadWorkers = aux.ReadWorkers
+	if aux.LastKnownMaxAge > 0 {
+		m.LastKnownMaxAge = time.Duration(aux.LastKnownMaxAge) * time.Second
+	}
+	if aux.LastKnownWarmYears > 0 {
+		m.LastKnownWarmYears = aux.LastKnownWarmYears
+	} else {
+		m.LastKnownWarmYears = DefaultLastKnownWarmYears
+	}
+	if aux.LastKnownCheckpointInterval != nil {
+		m.LastKnownCheckpointInterval = time.Duration(*aux.LastKnownCheckpointInterval) * time.Second
+	} else {
+		m.LastKnownCheckpointInterval = DefaultLastKnownCheckpointInterval
+	}
 	if aux.EnableAdd != "" {
 		enableAdd, err := strconv.ParseBool(aux.EnableAdd)
 		if err != nil {
@@ -132,6 +265,40 @@ func (m *MktsConfig) Parse(data []byte) error {
 			m.EnableRemove = enableRemove
 		}
 	}
+	if aux.ReadOnly != "" {
+		readOnly, err := strconv.ParseBool(aux.ReadOnly)
+		if err != nil {
+			// Writes to a root directory meant to be read only could corrupt it
+			Log(ERROR, "Invalid value: %v for read_only. Running read only...", aux.ReadOnly)
+			m.ReadOnly = true
+		} else {
+			m.ReadOnly = readOnly
+		}
+	}
+	if aux.LockFreeHints != "" {
+		lockFreeHints, err := strconv.ParseBool(aux.LockFreeHints)
+		if err != nil {
+			Log(ERROR, "Invalid value: %v for lock_free_hints. Keeping the hints in a map...", aux.LockFreeHints)
+		} else {
+			m.LockFreeHints = lockFreeHints
+		}
+	}
+	if aux.DSyncWrites != "" {
+		dsyncWrites, err := strconv.ParseBool(aux.DSyncWrites)
+		if err != nil {
+			Log(ERROR, "Invalid value: %v for dsync_writes. Syncing the writes once done...", aux.DSyncWrites)
+		} else {
+			m.DSyncWrites = dsyncWrites
+		}
+	}
+	if aux.UseMmap != "" {
+		useMmap, err := strconv.ParseBool(aux.UseMmap)
+		if err != nil {
+			Log(ERROR, "Invalid value: %v for use_mmap. Reading the files with system calls...", aux.UseMmap)
+		} else {
+			m.UseMmap = useMmap
+		}
+	}
 	m.EnableLastKnown = false
 	Log(INFO, "Disabling \"enable_last_known\" feature until it is fixed...")
 	/*
@@ -145,6 +312,18 @@ func (m *MktsConfig) Parse(data []byte) error {
 			}
 		}
 	*/
+	m.KafkaBrokers = aux.KafkaBrokers
+	m.KafkaSchemaRegistry = aux.KafkaSchemaRegistry
+	m.ReplicaID = aux.ReplicaID
+	m.AdminToken = aux.AdminToken
+	if ratio := aux.VacuumPolicy.NullRatio; ratio > 0 && ratio < 1 {
+		m.VacuumPolicy.NullRatio = ratio
+	} else {
+		m.VacuumPolicy.NullRatio = DefaultVacuumNullRatio
+	}
+	m.VacuumPolicy.Interval = time.Duration(aux.VacuumPolicy.Interval) * time.Second
+	m.ColdStorageDirectory = aux.ColdStorageDirectory
+	m.ColdAfterYears = aux.ColdAfterYears
 	m.RootDirectory = aux.RootDirectory
 	m.ListenPort = fmt.Sprintf(":%v", aux.ListenPort)
 
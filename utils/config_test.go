@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestValidateConfig(c *C) {
+	cfg := MktsConfig{TimezoneName: "America/New_York"}
+	c.Assert(ValidateConfig(&cfg), IsNil)
+	c.Assert(cfg.Timezone.String(), Equals, "America/New_York")
+
+	cfg = MktsConfig{}
+	c.Assert(ValidateConfig(&cfg), IsNil)
+	c.Assert(cfg.Timezone, Equals, time.UTC)
+
+	cfg = MktsConfig{TimezoneName: "America/Gotham"}
+	err := ValidateConfig(&cfg)
+	tzErr, ok := err.(ErrInvalidTimezone)
+	c.Assert(ok, Equals, true)
+	c.Assert(tzErr.Name, Equals, "America/Gotham")
+	c.Assert(tzErr.Cause, NotNil)
+	c.Assert(err, ErrorMatches, `Invalid timezone "America/Gotham": .* such as America/New_York.*`)
+	c.Assert(cfg.Timezone, IsNil)
+}
+
+func (s *TestSuite) TestParseReadOnly(c *C) {
+	for value, readOnly := range map[string]bool{"true": true, "false": false, "maybe": true} {
+		cfg := MktsConfig{}
+		data := "root_directory: /tmp\nlisten_port: 5993\nread_only: " + value + "\n"
+		c.Assert(cfg.Parse([]byte(data)), IsNil)
+		c.Assert(cfg.ReadOnly, Equals, readOnly, Commentf("read_only: %s", value))
+	}
+}
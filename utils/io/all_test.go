@@ -1,6 +1,10 @@
 package io
 
 import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"io/ioutil"
 	"math"
 	"os"
 	"path/filepath"
@@ -340,7 +344,9 @@ func (s *TestSuite) TestTimeBucketInfo(c *C) {
 	nElements := tbi2.GetNelements()
 	c.Check(nElements, Equals, int32(2))
 
-	c.Check(tbi2.GetVariableRecordLength(), Equals, int32(0))
+	varRecLen, err := tbi2.GetVariableRecordLength()
+	c.Check(varRecLen, Equals, int32(0))
+	c.Check(err, Equals, ErrNotVariableRecord)
 
 	fcopy := tbi2.GetDeepCopy()
 	c.Check(fcopy.timeframe.Nanoseconds(), Equals, tbi.timeframe.Nanoseconds())
@@ -350,6 +356,16 @@ func (s *TestSuite) TestTimeBucketInfo(c *C) {
 	c.Check(dsv2[0].Equal(dsv[0]), Equals, true)
 }
 
+func (s *TestSuite) TestUnknownElementType(c *C) {
+	dsv := NewDataShapeVector([]string{"Open", "Bad"}, []EnumElementType{FLOAT32, EnumElementType(200)})
+	var r interface{}
+	func() {
+		defer func() { r = recover() }()
+		NewTimeBucketInfo(*utils.NewTimeframe("1Min"), c.MkDir(), "testing", 2018, dsv, FIXED)
+	}()
+	c.Assert(r, Equals, "unknown ElementType 200")
+}
+
 func (s *TestSuite) TestIndexAndOffset(c *C) {
 	recSize := int32(28)
 	loc, _ := time.LoadLocation("America/New_York")
@@ -482,6 +498,19 @@ func (s *TestSuite) TestUnion(c *C) {
 	c.Assert(cs.GetEpoch()[3], Equals, csC.GetEpoch()[0])
 	c.Assert(cs.GetEpoch()[4], Equals, csC.GetEpoch()[1])
 	c.Assert(cs.GetEpoch()[5], Equals, csC.GetEpoch()[2])
+
+	// union of several, the later overwriting the earlier
+	csD := NewColumnSeries()
+	csD.AddColumn("Epoch", []int64{3, 4})
+	csD.AddColumn("One", []float32{30, 40})
+	csD.AddColumn("Two", []float64{30, 40})
+	csD.AddColumn("Three", []int32{30, 40})
+	csD.AddColumn("Four", []int64{30, 40})
+	csD.AddColumn("Five", []byte{30, 40})
+
+	cs = ColumnSeriesUnion(csB, csC, csD)
+	c.Assert(cs.GetEpoch(), DeepEquals, []int64{1, 2, 3, 4, 5, 6})
+	c.Assert(cs.GetByName("One"), DeepEquals, []float32{1, 2, 30, 40, 5, 6})
 }
 
 func (s *TestSuite) TestSliceByEpoch(c *C) {
@@ -561,3 +590,396 @@ func (s *TestSuite) TestApplyTimeQual(c *C) {
 
 	c.Assert(cs.ApplyTimeQual(tq).Len(), Equals, 0)
 }
+
+func (s *TestSuite) TestFloat16(c *C) {
+	for _, v := range []float32{0, 1, -2, 0.5, 65504, 0.000061035156, 0.000000059604645} {
+		c.Assert(Float16ToFloat32(Float32ToFloat16(v)), Equals, v)
+	}
+	// Known encodings
+	c.Assert(Float32ToFloat16(1), Equals, uint16(0x3c00))
+	c.Assert(Float32ToFloat16(-2), Equals, uint16(0xc000))
+	c.Assert(Float32ToFloat16(65504), Equals, uint16(0x7bff))
+	// Rounding, overflow and underflow
+	c.Assert(Float16ToFloat32(Float32ToFloat16(3.14159)), Equals, float32(3.140625))
+	c.Assert(Float32ToFloat16(1e6), Equals, uint16(0x7c00))
+	c.Assert(Float32ToFloat16(1e-10), Equals, uint16(0))
+	c.Assert(math.IsNaN(float64(Float16ToFloat32(Float32ToFloat16(float32(math.NaN()))))), Equals, true)
+
+	// Every half-precision value survives a round trip through float32
+	for i := 0; i < 1<<16; i++ {
+		if f := Float16ToFloat32(uint16(i)); !math.IsNaN(float64(f)) {
+			c.Assert(Float32ToFloat16(f), Equals, uint16(i))
+		}
+	}
+
+	dsv := NewDataShapeVector([]string{"Epoch", "Price"}, []EnumElementType{INT64, FLOAT16})
+	data := []byte{}
+	for i, v := range []float32{1.5, -0.25} {
+		data, _ = Serialize(data, int64(i))
+		data, _ = Serialize(data, Float32ToFloat16(v))
+	}
+	rows := NewRows(dsv, data)
+	c.Assert(rows.GetColumn("Price").([]float32), DeepEquals, []float32{1.5, -0.25})
+
+	// float32 values are written as half precision and read back as float32
+	c.Assert(FLOAT16.TypeOf(), Equals, reflect.TypeOf(float32(0)))
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{0, 1})
+	cs.AddColumn("Price", []float32{1.5, -0.25})
+	serialized, recordLen := SerializeColumnsToRows(cs, dsv, false)
+	c.Assert(recordLen, Equals, 10)
+	c.Assert(serialized, DeepEquals, data)
+	c.Assert(FLOAT16.ConvertByteSliceInto(data[8:10]), DeepEquals, []float32{1.5})
+}
+
+func (s *TestSuite) TestAppendRow(c *C) {
+	cs := NewColumnSeries()
+	c.Assert(cs.AppendRow(1, map[string]interface{}{"Open": float32(1.5), "Volume": int32(10)}), IsNil)
+	c.Assert(cs.GetColumnNames(), DeepEquals, []string{"Epoch", "Open", "Volume"})
+	c.Assert(cs.AppendRow(2, map[string]interface{}{"Open": float32(2.5)}), IsNil)
+	c.Assert(cs.Len(), Equals, 2)
+	c.Assert(cs.GetEpoch(), DeepEquals, []int64{1, 2})
+	c.Assert(cs.GetByName("Open").([]float32), DeepEquals, []float32{1.5, 2.5})
+	c.Assert(cs.GetByName("Volume").([]int32), DeepEquals, []int32{10, 0})
+
+	c.Assert(cs.AppendRow(3, map[string]interface{}{"Close": float32(1)}), Equals, ErrColumnNotFound)
+	c.Assert(cs.AppendRow(3, map[string]interface{}{"Open": 1.0}), Equals, ErrTypeMismatch)
+	c.Assert(cs.AppendRow(3, map[string]interface{}{"Open": nil}), Equals, ErrTypeMismatch)
+	c.Assert(cs.AppendRow(3, map[string]interface{}{"Epoch": int64(3)}), Equals, ErrColumnNotFound)
+	c.Assert(cs.Len(), Equals, 2)
+	c.Assert(cs.GetByName("Volume").([]int32), DeepEquals, []int32{10, 0})
+
+	// An empty series gets no columns from a row that fails
+	empty := NewColumnSeries()
+	c.Assert(empty.AppendRow(1, map[string]interface{}{"Open": float32(1.5), "Close": nil}), Equals, ErrTypeMismatch)
+	c.Assert(empty.AppendRow(1, map[string]interface{}{"Epoch": int64(1)}), Equals, ErrColumnNotFound)
+	c.Assert(empty.IsEmpty(), Equals, true)
+}
+
+func (s *TestSuite) TestSeekBackward(c *C) {
+	r := bytes.NewReader(make([]byte, 100))
+
+	// Seek back a full step from the end
+	_, err := r.Seek(100, os.SEEK_SET)
+	c.Assert(err, IsNil)
+	seekAmt, curPos, err := SeekBackward(r, 30, 10)
+	c.Assert(err, IsNil)
+	c.Assert(seekAmt, Equals, int64(30))
+	c.Assert(curPos, Equals, int64(70))
+
+	// The step is clipped to the lower bound
+	seekAmt, curPos, err = SeekBackward(r, 70, 10)
+	c.Assert(err, IsNil)
+	c.Assert(seekAmt, Equals, int64(60))
+	c.Assert(curPos, Equals, int64(10))
+
+	// Nothing is left once at the lower bound
+	seekAmt, curPos, err = SeekBackward(r, 30, 10)
+	c.Assert(err, IsNil)
+	c.Assert(seekAmt, Equals, int64(0))
+	c.Assert(curPos, Equals, int64(10))
+
+	// A step ending exactly on the lower bound
+	_, err = r.Seek(40, os.SEEK_SET)
+	c.Assert(err, IsNil)
+	seekAmt, curPos, err = SeekBackward(r, 30, 10)
+	c.Assert(err, IsNil)
+	c.Assert(seekAmt, Equals, int64(30))
+	c.Assert(curPos, Equals, int64(10))
+
+	// A position below the lower bound can not be sought to
+	_, err = r.Seek(5, os.SEEK_SET)
+	c.Assert(err, IsNil)
+	_, _, err = SeekBackward(r, 30, -10)
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestEpochsAsTime(c *C) {
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{0, 3600})
+	ny, err := time.LoadLocation("America/New_York")
+	c.Assert(err, IsNil)
+
+	ts := cs.EpochsAsTime(ny)
+	c.Assert(ts, HasLen, 2)
+	c.Assert(ts[1].Location(), Equals, ny)
+	c.Assert(ts[1].Hour(), Equals, 20)
+
+	ts = cs.EpochsAsTime(utils.InstanceConfig.Timezone)
+	c.Assert(ts[1].Unix(), Equals, int64(3600))
+	c.Assert(&cs.EpochsAsTime(utils.InstanceConfig.Timezone)[0], Equals, &ts[0])
+
+	// A new Epoch column invalidates the cache
+	c.Assert(cs.Replace("Epoch", []int64{7200}), IsNil)
+	ts = cs.EpochsAsTime(utils.InstanceConfig.Timezone)
+	c.Assert(ts, HasLen, 1)
+	c.Assert(ts[0].Unix(), Equals, int64(7200))
+}
+
+func (s *TestSuite) TestColumnSeriesFromMap(c *C) {
+	epochs := []int64{1, 2, 3}
+	cs, err := ColumnSeriesFromMap(map[string]interface{}{
+		"Volume": []int32{10, 20, 30},
+		"Close":  []float64{1.5, 2.5, 3.5},
+	}, epochs)
+	c.Assert(err, IsNil)
+	c.Assert(cs.GetColumnNames(), DeepEquals, []string{"Epoch", "Close", "Volume"})
+	c.Assert(cs.GetEpoch(), DeepEquals, epochs)
+	c.Assert(cs.GetByName("Volume"), DeepEquals, []int32{10, 20, 30})
+
+	_, err = ColumnSeriesFromMap(map[string]interface{}{"Close": []float64{1.5}}, epochs)
+	c.Assert(err.Error(), Equals, "column Close has 1 values for 3 epochs")
+	_, err = ColumnSeriesFromMap(map[string]interface{}{"Close": []interface{}{1.5, 2.5, 3.5}}, epochs)
+	c.Assert(err, NotNil)
+	_, err = ColumnSeriesFromMap(map[string]interface{}{"Close": 1.5}, epochs)
+	c.Assert(err, NotNil)
+	_, err = ColumnSeriesFromMap(map[string]interface{}{"Epoch": epochs}, epochs)
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestFileMagic(c *C) {
+	tbi := NewTimeBucketInfo(*utils.NewTimeframe("1Min"), c.MkDir(), "testing", 2018,
+		NewDataShapeVector([]string{"Open"}, []EnumElementType{FLOAT32}), FIXED)
+	f, err := os.Create(tbi.Path)
+	c.Assert(err, IsNil)
+	c.Assert(WriteHeader(f, tbi), IsNil)
+	f.Close()
+
+	buffer, err := ioutil.ReadFile(tbi.Path)
+	c.Assert(err, IsNil)
+	c.Assert(string(buffer[:4]), Equals, "MKTS")
+	c.Assert(HasFileMagic(buffer), Equals, true)
+	c.Assert(HasFileMagic([]byte("PK\x03\x04")), Equals, false)
+
+	// Headers written before the magic begin with the version as 8 bytes
+	binary.LittleEndian.PutUint64(buffer, uint64(FileinfoVersion))
+	c.Assert(HasFileMagic(buffer), Equals, true)
+	c.Assert(ioutil.WriteFile(tbi.Path, buffer, 0644), IsNil)
+	legacy := TimeBucketInfo{Year: 2018, Path: tbi.Path}
+	c.Assert(legacy.GetVersion(), Equals, FileinfoVersion)
+	c.Assert(legacy.GetElementNames(), DeepEquals, []string{"Open"})
+}
+
+func (s *TestSuite) TestUnknownTimeframe(c *C) {
+	_, err := NewTimeBucketKey("AAPL/Fortnightly/OHLC").GetTimeFrame()
+	c.Assert(err, Equals, ErrUnknownTimeframe{Name: "Fortnightly"})
+	utils.RegisterCustomTimeframe("Fortnightly", 2*utils.Week)
+	tf, err := NewTimeBucketKey("AAPL/Fortnightly/OHLC").GetTimeFrame()
+	c.Assert(err, IsNil)
+	c.Assert(tf.Duration, Equals, 2*utils.Week)
+
+	// Headers with a timeframe of no whole seconds are not loaded
+	tbi := NewTimeBucketInfo(*utils.NewTimeframe("1Min"), c.MkDir(), "testing", 2018,
+		NewDataShapeVector([]string{"Open"}, []EnumElementType{FLOAT32}), FIXED)
+	f, err := os.Create(tbi.Path)
+	c.Assert(err, IsNil)
+	c.Assert(WriteHeader(f, tbi), IsNil)
+	f.Close()
+	buffer, err := ioutil.ReadFile(tbi.Path)
+	c.Assert(err, IsNil)
+	binary.LittleEndian.PutUint64(buffer[272:], 0)
+	c.Assert(ioutil.WriteFile(tbi.Path, buffer, 0644), IsNil)
+	_, err = NewTimeBucketInfoFromFile(tbi.Path)
+	c.Assert(err, Equals, ErrUnknownTimeframe{Name: "0s"})
+}
+
+func (s *TestSuite) TestResample(c *C) {
+	cs := NewColumnSeries()
+	// Two full 5Min intervals and a partial one
+	cs.AddColumn("Epoch", []int64{600, 660, 720, 900, 1140, 1200})
+	cs.AddColumn("Open", []float32{1, 2, 3, 4, 5, 6})
+	cs.AddColumn("High", []float32{5, 9, 7, 4, 8, 6})
+	cs.AddColumn("Low", []float32{1, 0.5, 3, 2, 5, 6})
+	cs.AddColumn("Close", []float32{1, 2, 3, 4, 5, 6})
+	cs.AddColumn("Volume", []int64{10, 20, 30, 40, 50, 60})
+	cs.AddColumn("Price", []int32{1, 2, 4, 3, 4, 5})
+
+	out, err := cs.Resample(*utils.NewTimeframe("5Min"), AggMethod{
+		"Open":   AggFirst,
+		"High":   AggMax,
+		"Low":    AggMin,
+		"Close":  AggLast,
+		"Volume": AggSum,
+		"Price":  AggMean,
+	})
+	c.Assert(err, IsNil)
+	c.Assert(out.GetColumnNames(), DeepEquals, []string{"Epoch", "Open", "High", "Low", "Close", "Volume", "Price"})
+	c.Assert(out.GetEpoch(), DeepEquals, []int64{600, 900, 1200})
+	c.Assert(out.GetByName("Open"), DeepEquals, []float32{1, 4, 6})
+	c.Assert(out.GetByName("High"), DeepEquals, []float32{9, 8, 6})
+	c.Assert(out.GetByName("Low"), DeepEquals, []float32{0.5, 2, 6})
+	c.Assert(out.GetByName("Close"), DeepEquals, []float32{3, 5, 6})
+	c.Assert(out.GetByName("Volume"), DeepEquals, []int64{60, 90, 60})
+	c.Assert(out.GetByName("Price"), DeepEquals, []int32{2, 3, 5})
+
+	// Columns left out of the method are dropped
+	out, err = cs.Resample(*utils.NewTimeframe("1H"), AggMethod{"Close": AggLast})
+	c.Assert(err, IsNil)
+	c.Assert(out.GetColumnNames(), DeepEquals, []string{"Epoch", "Close"})
+	c.Assert(out.GetEpoch(), DeepEquals, []int64{0})
+	c.Assert(out.GetByName("Close"), DeepEquals, []float32{6})
+
+	_, err = cs.Resample(*utils.NewTimeframe("5Min"), AggMethod{"Missing": AggLast})
+	c.Assert(err, NotNil)
+
+	unsorted := NewColumnSeries()
+	unsorted.AddColumn("Epoch", []int64{900, 600})
+	unsorted.AddColumn("Close", []float32{1, 2})
+	_, err = unsorted.Resample(*utils.NewTimeframe("5Min"), AggMethod{"Close": AggLast})
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestColumnIndex(c *C) {
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{1, 2})
+	cs.AddColumn("Open", []float32{1, 2})
+	cs.AddColumn("Close", []float32{3, 4})
+
+	i := cs.ColumnIndex("Close")
+	c.Assert(i, Equals, 2)
+	c.Assert(cs.GetColumnByIndex(i), DeepEquals, []float32{3, 4})
+	c.Assert(cs.ColumnIndex("Missing"), Equals, -1)
+	c.Assert(cs.GetColumnByIndex(-1), IsNil)
+	c.Assert(cs.GetColumnByIndex(3), IsNil)
+
+	// The positions follow changes to the columns
+	c.Assert(cs.Remove("Open"), IsNil)
+	c.Assert(cs.ColumnIndex("Close"), Equals, 1)
+	c.Assert(cs.Rename("Last", "Close"), IsNil)
+	c.Assert(cs.ColumnIndex("Close"), Equals, -1)
+	c.Assert(cs.ColumnIndex("Last"), Equals, 1)
+	cs.AddColumn("Volume", []int64{5, 6})
+	c.Assert(cs.GetColumnByIndex(cs.ColumnIndex("Volume")), DeepEquals, []int64{5, 6})
+}
+
+func (s *TestSuite) TestZScore(c *C) {
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{1, 2, 3, 4})
+	cs.AddColumn("Close", []float32{2, 4, 4, 6})
+	cs.AddColumn("Volume", []int64{7, 7, 7, 7})
+
+	// Mean 4, population standard deviation sqrt(2)
+	scores, err := cs.ZScore("Close")
+	c.Assert(err, IsNil)
+	c.Assert(scores, HasLen, 4)
+	for i, want := range []float64{-math.Sqrt2, 0, 0, math.Sqrt2} {
+		c.Assert(math.Abs(scores[i]-want) < 1e-12, Equals, true)
+	}
+
+	scores, err = cs.ZScore("Volume")
+	c.Assert(err, Equals, ZeroVarianceWarning("Volume"))
+	c.Assert(scores, DeepEquals, []float64{0, 0, 0, 0})
+
+	_, err = cs.ZScore("Missing")
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestCumulative(c *C) {
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{1, 2, 3, 4, 5})
+	cs.AddColumn("Close", []float32{2, 4, 3, 5, 1})
+	cs.AddColumn("Volume", []int32{10, 20, 30, 40, 50})
+
+	sum, err := cs.CumSum("Volume")
+	c.Assert(err, IsNil)
+	c.Assert(sum, DeepEquals, []float64{10, 30, 60, 100, 150})
+	max, err := cs.CumMax("Close")
+	c.Assert(err, IsNil)
+	c.Assert(max, DeepEquals, []float64{2, 4, 4, 5, 5})
+	min, err := cs.CumMin("Close")
+	c.Assert(err, IsNil)
+	c.Assert(min, DeepEquals, []float64{2, 2, 2, 2, 1})
+
+	change, err := cs.PctChange("Close", 2)
+	c.Assert(err, IsNil)
+	c.Assert(change, HasLen, 5)
+	c.Assert(math.IsNaN(change[0]) && math.IsNaN(change[1]), Equals, true)
+	c.Assert(change[2:], DeepEquals, []float64{0.5, 0.25, -2.0 / 3})
+
+	_, err = cs.PctChange("Close", 0)
+	c.Assert(err, NotNil)
+	_, err = cs.CumSum("Missing")
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestCorrelationWith(c *C) {
+	a := NewColumnSeries()
+	a.AddColumn("Epoch", []int64{1, 2, 3, 4, 5})
+	a.AddColumn("Close", []float32{1, 2, 3, 4, 100})
+	b := NewColumnSeries()
+	b.AddColumn("Epoch", []int64{0, 2, 3, 4, 6})
+	b.AddColumn("Close", []float64{7, 4, 6, 8, 1})
+
+	// Aligned on epochs 2, 3 and 4 only
+	corr, err := a.CorrelationWith(b, "Close")
+	c.Assert(err, IsNil)
+	c.Assert(math.Abs(corr-1) < 1e-9, Equals, true, Commentf("%v", corr))
+
+	b.Replace("Close", []float64{7, 8, 6, 4, 1})
+	corr, err = a.CorrelationWith(b, "Close")
+	c.Assert(err, IsNil)
+	c.Assert(math.Abs(corr+1) < 1e-9, Equals, true, Commentf("%v", corr))
+
+	b.Replace("Epoch", []int64{0, 2, 7, 8, 9})
+	corr, err = a.CorrelationWith(b, "Close")
+	c.Assert(err, IsNil)
+	c.Assert(math.IsNaN(corr), Equals, true)
+
+	_, err = a.CorrelationWith(b, "Volume")
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestLinearInterpolate(c *C) {
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{10, 20, 40})
+	cs.AddColumn("Close", []float32{1, 3, 2})
+
+	values, err := cs.LinearInterpolate("Close", []int64{5, 10, 15, 20, 30, 40, 45})
+	c.Assert(err, IsNil)
+	c.Assert(math.IsNaN(values[0]), Equals, true)
+	c.Assert(values[1:6], DeepEquals, []float64{1, 2, 3, 2.5, 2})
+	c.Assert(math.IsNaN(values[6]), Equals, true)
+
+	_, err = cs.LinearInterpolate("Close", []int64{30, 15})
+	c.Assert(err, NotNil)
+	_, err = cs.LinearInterpolate("Volume", []int64{15})
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestDiffWith(c *C) {
+	a := NewColumnSeries()
+	a.AddColumn("Epoch", []int64{10, 20, 30})
+	a.AddColumn("Close", []float32{1.5, 3, 2})
+	b := NewColumnSeries()
+	b.AddColumn("Epoch", []int64{20, 30, 40, 30})
+	b.AddColumn("Close", []float64{1, 2.5, 4, 10})
+
+	diff, err := a.DiffWith(b, "Close")
+	c.Assert(err, IsNil)
+	c.Assert(diff.GetEpoch(), DeepEquals, []int64{10, 20, 30, 40})
+	values := diff.GetByName("Close").([]float64)
+	c.Assert(math.IsNaN(values[0]), Equals, true)
+	c.Assert(values[1:3], DeepEquals, []float64{2, -0.5})
+	c.Assert(math.IsNaN(values[3]), Equals, true)
+
+	_, err = a.DiffWith(b, "Open")
+	c.Assert(err, NotNil)
+	_, err = a.DiffWith(nil, "Close")
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestColumnSeriesMapMarshalCBOR(c *C) {
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", []int64{1363896240, 1363896300})
+	cs.AddColumn("Close", []float32{1.5, 2})
+	csm := NewColumnSeriesMap()
+	csm.AddColumnSeries(*NewTimeBucketKey("AAPL/1Min/OHLC"), cs)
+
+	encoded, err := csm.MarshalCBOR()
+	c.Assert(err, IsNil)
+	// One bucket, whose 46 byte key maps to its two columns sorted by name
+	key := "AAPL/1Min/OHLC:Symbol/Timeframe/AttributeGroup"
+	c.Assert(hex.EncodeToString(encoded), Equals, "a1"+"782e"+hex.EncodeToString([]byte(key))+
+		"a2"+"65436c6f7365"+"82"+"fa3fc00000"+"fa40000000"+
+		"6545706f6368"+"82"+"c11a514b67b0"+"c11a514b67ec")
+}
@@ -0,0 +1,48 @@
+package io
+
+import (
+	"github.com/fxamacker/cbor/v2"
+)
+
+// CBOREncMode encodes with the map keys and struct fields sorted bytewise,
+// so that a value is always encoded the same way
+var CBOREncMode cbor.EncMode
+
+func init() {
+	var err error
+	if CBOREncMode, err = (cbor.EncOptions{Sort: cbor.SortBytewiseLexical}).EncMode(); err != nil {
+		panic(err)
+	}
+}
+
+// MarshalCBOR encodes the map as a CBOR map from each bucket key to a map of
+// its columns by name. The values of the Epoch column are tagged as
+// epoch-based date/times for CBOR datetime libraries.
+func (csm ColumnSeriesMap) MarshalCBOR() ([]byte, error) {
+	series := make(map[string]map[string]interface{}, len(csm))
+	for tbk, cs := range csm {
+		columns := make(map[string]interface{}, len(cs.orderedNames))
+		for _, name := range cs.orderedNames {
+			columns[name] = cs.columns[name]
+		}
+		if epochs, ok := cs.columns["Epoch"].([]int64); ok {
+			columns["Epoch"] = cborEpochs(epochs)
+		}
+		series[tbk.String()] = columns
+	}
+	return CBOREncMode.Marshal(series)
+}
+
+// cborEpochs is an Epoch column whose values are encoded with tag 1
+type cborEpochs []int64
+
+// cborTagEpochDateTime is the number of the tag of epoch-based date/times
+const cborTagEpochDateTime = 1
+
+func (epochs cborEpochs) MarshalCBOR() ([]byte, error) {
+	tagged := make([]cbor.Tag, len(epochs))
+	for i, epoch := range epochs {
+		tagged[i] = cbor.Tag{Number: cborTagEpochDateTime, Content: epoch}
+	}
+	return cbor.Marshal(tagged)
+}
@@ -1,12 +1,15 @@
 package io
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/alpacahq/marketstore/utils"
 )
 
 //go:generate ./generateMethods.sh generatedMethods.go
@@ -26,6 +29,11 @@ type ColumnInterface interface {
 	GetTime() []time.Time
 }
 
+var (
+	ErrColumnNotFound = errors.New("column not found in column series")
+	ErrTypeMismatch   = errors.New("value type does not match column type")
+)
+
 type ColumnSeries struct {
 	ColumnInterface
 
@@ -33,6 +41,11 @@ type ColumnSeries struct {
 	orderedNames     []string
 	candleAttributes *CandleAttributes
 	nameIncrement    map[string]int
+	// Position of each column in orderedNames, built by ColumnIndex
+	nameIndex map[string]int
+	// Epochs converted to the system timezone and the column they came from
+	epochTimes       []time.Time
+	epochTimesSource []int64
 }
 
 func NewColumnSeries() *ColumnSeries {
@@ -80,6 +93,25 @@ func (cs *ColumnSeries) GetTime() []time.Time {
 	return ts
 }
 
+// EpochsAsTime returns the Epoch column as times in loc. The conversion to the
+// system timezone is cached until the Epoch column is replaced or resized.
+func (cs *ColumnSeries) EpochsAsTime(loc *time.Location) []time.Time {
+	ep := cs.GetEpoch()
+	cacheable := loc == utils.InstanceConfig.Timezone
+	if cacheable && cs.epochTimes != nil && len(cs.epochTimesSource) == len(ep) &&
+		(len(ep) == 0 || &cs.epochTimesSource[0] == &ep[0]) {
+		return cs.epochTimes
+	}
+	ts := make([]time.Time, len(ep))
+	for i, secs := range ep {
+		ts[i] = time.Unix(secs, 0).In(loc)
+	}
+	if cacheable {
+		cs.epochTimes, cs.epochTimesSource = ts, ep
+	}
+	return ts
+}
+
 func (cs *ColumnSeries) GetColumnNames() (columnNames []string) {
 	return cs.orderedNames
 }
@@ -92,6 +124,31 @@ func (cs *ColumnSeries) SetCandleAttributes(cat *CandleAttributes) {
 	cs.candleAttributes = cat
 }
 
+// ColumnIndex returns the position of the column name, for use with
+// GetColumnByIndex, or -1 if there is no such column. The positions are
+// resolved once and kept until the columns are added, removed or renamed.
+func (cs *ColumnSeries) ColumnIndex(name string) int {
+	if cs.nameIndex == nil {
+		cs.nameIndex = make(map[string]int, len(cs.orderedNames))
+		for i, n := range cs.orderedNames {
+			cs.nameIndex[n] = i
+		}
+	}
+	if i, ok := cs.nameIndex[name]; ok {
+		return i
+	}
+	return -1
+}
+
+// GetColumnByIndex returns the column at position i, as given by ColumnIndex,
+// or nil if i is out of range
+func (cs *ColumnSeries) GetColumnByIndex(i int) interface{} {
+	if i < 0 || i >= len(cs.orderedNames) {
+		return nil
+	}
+	return cs.columns[cs.orderedNames[i]]
+}
+
 func (cs *ColumnSeries) GetColumns() map[string]interface{} {
 	return cs.columns
 }
@@ -108,8 +165,93 @@ func (cs *ColumnSeries) AddColumn(name string, columnData interface{}) (outname
 	}
 	cs.orderedNames = append(cs.orderedNames, name)
 	cs.columns[name] = columnData
+	cs.nameIndex = nil
 	return name
 }
+
+// AppendRow appends a single record to the series. Columns without a value in
+// values are padded with their zero value. On an empty series the columns are
+// created from the supplied values, ordered by name after the Epoch column.
+func (cs *ColumnSeries) AppendRow(epoch int64, values map[string]interface{}) error {
+	// Validate everything before changing the series, so that it is left as
+	// it was on errors and the columns stay the same length
+	for name, value := range values {
+		if name == "Epoch" {
+			return ErrColumnNotFound
+		}
+		if value == nil {
+			return ErrTypeMismatch
+		}
+		if cs.IsEmpty() {
+			continue
+		}
+		col, ok := cs.columns[name]
+		if !ok {
+			return ErrColumnNotFound
+		}
+		if reflect.TypeOf(value) != reflect.TypeOf(col).Elem() {
+			return ErrTypeMismatch
+		}
+	}
+	if cs.IsEmpty() {
+		names := make([]string, 0, len(values))
+		for name := range values {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		cs.AddColumn("Epoch", []int64{})
+		for _, name := range names {
+			cs.AddColumn(name, reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(values[name])), 0, 0).Interface())
+		}
+	}
+	for _, name := range cs.orderedNames {
+		colValue := reflect.ValueOf(cs.columns[name])
+		var elem reflect.Value
+		if name == "Epoch" {
+			elem = reflect.ValueOf(epoch)
+		} else if value, ok := values[name]; ok {
+			elem = reflect.ValueOf(value)
+		} else {
+			elem = reflect.Zero(colValue.Type().Elem())
+		}
+		cs.columns[name] = reflect.Append(colValue, elem).Interface()
+	}
+	return nil
+}
+
+// ColumnSeriesFromMap builds a series from columns keyed by name, such as
+// those decoded from clients that represent a result as a map. Each column
+// must be a typed slice, e.g. []float64, with one value per epoch. The
+// columns are ordered by name after the Epoch column.
+func ColumnSeriesFromMap(m map[string]interface{}, epochs []int64) (*ColumnSeries, error) {
+	names := make([]string, 0, len(m))
+	for name, col := range m {
+		if name == "Epoch" {
+			return nil, fmt.Errorf("column Epoch is given by epochs and can not be in the map")
+		}
+		value := reflect.ValueOf(col)
+		if value.Kind() != reflect.Slice {
+			return nil, fmt.Errorf("column %s is a %T, not a slice", name, col)
+		}
+		if et := GetElementType(col); et == NONE || et == STRING ||
+			value.Type().Elem() != et.TypeOf() {
+			return nil, fmt.Errorf("column %s is a %T, which is not a supported column type", name, col)
+		}
+		if value.Len() != len(epochs) {
+			return nil, fmt.Errorf("column %s has %d values for %d epochs", name, value.Len(), len(epochs))
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	cs := NewColumnSeries()
+	cs.AddColumn("Epoch", epochs)
+	for _, name := range names {
+		cs.AddColumn(name, m[name])
+	}
+	return cs, nil
+}
+
 func (cs *ColumnSeries) IsEmpty() bool {
 	return len(cs.orderedNames) == 0
 }
@@ -150,6 +292,7 @@ func (cs *ColumnSeries) Rename(newName, oldName string) error {
 	cs.AddColumn(newName, oldColumn)
 	cs.Remove(oldName)
 	cs.orderedNames = newNames
+	cs.nameIndex = nil
 	return nil
 }
 
@@ -172,6 +315,7 @@ func (cs *ColumnSeries) Remove(targetName string) error {
 	}
 	cs.orderedNames = newNames
 	delete(cs.columns, targetName)
+	cs.nameIndex = nil
 	return nil
 }
 func (cs *ColumnSeries) Project(keepList []string) error {
@@ -187,6 +331,7 @@ func (cs *ColumnSeries) Project(keepList []string) error {
 	}
 	cs.columns = newCols
 	cs.orderedNames = newNames
+	cs.nameIndex = nil
 	return nil
 }
 
@@ -325,11 +470,11 @@ func max(x, y int) int {
 	return y
 }
 
-// ColumnSeriesUnion takes to column series and creates a union
+// ColumnSeriesUnion takes column series and creates a union
 // and returns another column series. The values in the union
-// are unique, and right values overwrite left values in when
-// epochs are duplicated.
-func ColumnSeriesUnion(left, right *ColumnSeries) *ColumnSeries {
+// are unique, and the values of later series overwrite those
+// of earlier ones when epochs are duplicated.
+func ColumnSeriesUnion(left *ColumnSeries, rights ...*ColumnSeries) *ColumnSeries {
 	out := NewColumnSeries()
 
 	out.candleAttributes = left.candleAttributes
@@ -351,8 +496,10 @@ func ColumnSeriesUnion(left, right *ColumnSeries) *ColumnSeries {
 		m[epoch] = &entry{epoch: epoch, index: i, refSeries: left}
 	}
 
-	for i, epoch := range right.GetEpoch() {
-		m[epoch] = &entry{epoch: epoch, index: i, refSeries: right}
+	for _, right := range rights {
+		for i, epoch := range right.GetEpoch() {
+			m[epoch] = &entry{epoch: epoch, index: i, refSeries: right}
+		}
 	}
 
 	entries := make([]*entry, len(m))
@@ -536,7 +683,12 @@ func SerializeColumnsToRows(cs *ColumnSeries, dataShapes []DataShape, align64 bo
 		}
 		columnData := cs.columns[colName]
 		columnList = append(columnList, columnData)
-		colInBytes := SwapSliceData(columnData, byte(0)).([]byte)
+		var colInBytes []byte
+		if col, ok := columnData.([]float32); ok && shape.Type == FLOAT16 {
+			colInBytes = float16Bytes(col)
+		} else {
+			colInBytes = SwapSliceData(columnData, byte(0)).([]byte)
+		}
 		colInBytesList = append(colInBytesList, colInBytes)
 	}
 	if !shapesContainsEpoch {
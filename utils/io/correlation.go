@@ -0,0 +1,51 @@
+package io
+
+import (
+	"fmt"
+	"math"
+)
+
+// CorrelationWith returns the Pearson correlation of the numeric column col
+// of the series and of other, over the epochs found in both. When an epoch
+// repeats in other its first row is used. The result is NaN when fewer than
+// 2 epochs are aligned or when either column is constant over them.
+func (cs *ColumnSeries) CorrelationWith(other *ColumnSeries, col string) (float64, error) {
+	if other == nil {
+		return 0, fmt.Errorf("CorrelationWith: no series to correlate with")
+	}
+	left, err := cs.floatColumn("CorrelationWith", col)
+	if err != nil {
+		return 0, err
+	}
+	right, err := other.floatColumn("CorrelationWith", col)
+	if err != nil {
+		return 0, err
+	}
+	rows := make(map[int64]int, len(right))
+	for i, epoch := range other.GetEpoch() {
+		if _, ok := rows[epoch]; !ok {
+			rows[epoch] = i
+		}
+	}
+
+	// The co-moments are accumulated in a single pass like ZScore does
+	var n, meanX, meanY, cov, m2X, m2Y float64
+	for i, epoch := range cs.GetEpoch() {
+		j, ok := rows[epoch]
+		if !ok {
+			continue
+		}
+		x, y := left[i], right[j]
+		n++
+		dx, dy := x-meanX, y-meanY
+		meanX += dx / n
+		meanY += dy / n
+		cov += dx * (y - meanY)
+		m2X += dx * (x - meanX)
+		m2Y += dy * (y - meanY)
+	}
+	if n < 2 {
+		return math.NaN(), nil
+	}
+	return cov / math.Sqrt(m2X*m2Y), nil
+}
@@ -0,0 +1,74 @@
+package io
+
+import (
+	"fmt"
+	"math"
+)
+
+// CumSum returns the running sum of the numeric column name
+func (cs *ColumnSeries) CumSum(name string) ([]float64, error) {
+	return cs.cumulative("CumSum", name, func(acc, x float64) float64 { return acc + x })
+}
+
+// CumMax returns the running maximum of the numeric column name, e.g. the
+// high watermark used to compute drawdowns
+func (cs *ColumnSeries) CumMax(name string) ([]float64, error) {
+	return cs.cumulative("CumMax", name, math.Max)
+}
+
+// CumMin returns the running minimum of the numeric column name
+func (cs *ColumnSeries) CumMin(name string) ([]float64, error) {
+	return cs.cumulative("CumMin", name, math.Min)
+}
+
+// PctChange returns (x[t] - x[t-periods]) / x[t-periods] for each value of
+// the numeric column name. The first periods values are NaN.
+func (cs *ColumnSeries) PctChange(name string, periods int) ([]float64, error) {
+	if periods <= 0 {
+		return nil, fmt.Errorf("PctChange: periods must be positive, got %d", periods)
+	}
+	values, err := cs.floatColumn("PctChange", name)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]float64, len(values))
+	for i := range out {
+		if i < periods {
+			out[i] = math.NaN()
+			continue
+		}
+		prev := values[i-periods]
+		out[i] = (values[i] - prev) / prev
+	}
+	return out, nil
+}
+
+// cumulative applies op to the running result and each value of the column
+func (cs *ColumnSeries) cumulative(op, name string, f func(acc, x float64) float64) ([]float64, error) {
+	values, err := cs.floatColumn(op, name)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]float64, len(values))
+	for i, x := range values {
+		if i == 0 {
+			out[i] = x
+		} else {
+			out[i] = f(out[i-1], x)
+		}
+	}
+	return out, nil
+}
+
+// floatColumn returns the numeric column name converted to float64, with the
+// errors prefixed by op
+func (cs *ColumnSeries) floatColumn(op, name string) ([]float64, error) {
+	if !cs.Exists(name) {
+		return nil, fmt.Errorf("%s: column %s: %v", op, name, ErrColumnNotFound)
+	}
+	values, err := columnAsFloat64(cs.columns[name])
+	if err != nil {
+		return nil, fmt.Errorf("%s: column %s: %v", op, name, err)
+	}
+	return values, nil
+}
@@ -59,6 +59,7 @@ const (
 	UINT16
 	UINT32
 	UINT64
+	FLOAT16
 )
 
 var (
@@ -82,6 +83,8 @@ var (
 		UINT16:  {reflect.Uint16, "uint16", 2, reflect.TypeOf(uint16(0))},
 		UINT32:  {reflect.Uint32, "uint32", 4, reflect.TypeOf(uint32(0))},
 		UINT64:  {reflect.Uint64, "uint64", 8, reflect.TypeOf(uint64(0))},
+		// Stored as half precision, but float32 values in memory
+		FLOAT16: {reflect.Float32, "float16", 2, reflect.TypeOf(float32(0))},
 	}
 )
 
@@ -148,6 +151,8 @@ func (e EnumElementType) ConvertByteSliceInto(data []byte) interface{} {
 		return SwapSliceByte(data, uint8(0)).([]uint8)
 	case UINT16:
 		return SwapSliceByte(data, uint16(0)).([]uint16)
+	case FLOAT16:
+		return getFloat16Column(0, 2, len(data)/2, data)
 	case UINT32:
 		return SwapSliceByte(data, uint32(0)).([]uint32)
 	case UINT64:
@@ -232,6 +237,8 @@ type DirectionEnum uint8
 const (
 	FIRST DirectionEnum = iota
 	LAST
+	// NEAREST finds the single record closest in time to the range start
+	NEAREST
 )
 
 /*
@@ -0,0 +1,62 @@
+package io
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// DiffWith returns the difference between the numeric column col of the
+// series and of other at each epoch of either, e.g. the spread between the
+// prices of two instruments. The result holds the sorted epochs and the
+// float64 column col, which is NaN at the epochs missing from one of the
+// series. When an epoch repeats in a series its first row is used.
+func (cs *ColumnSeries) DiffWith(other *ColumnSeries, col string) (*ColumnSeries, error) {
+	if other == nil {
+		return nil, fmt.Errorf("DiffWith: no series to subtract")
+	}
+	left, err := cs.floatColumn("DiffWith", col)
+	if err != nil {
+		return nil, err
+	}
+	right, err := other.floatColumn("DiffWith", col)
+	if err != nil {
+		return nil, err
+	}
+	firstRows := func(epochs []int64) map[int64]int {
+		rows := make(map[int64]int, len(epochs))
+		for i, epoch := range epochs {
+			if _, ok := rows[epoch]; !ok {
+				rows[epoch] = i
+			}
+		}
+		return rows
+	}
+	leftRows, rightRows := firstRows(cs.GetEpoch()), firstRows(other.GetEpoch())
+
+	epochs := make([]int64, 0, len(leftRows)+len(rightRows))
+	for epoch := range leftRows {
+		epochs = append(epochs, epoch)
+	}
+	for epoch := range rightRows {
+		if _, ok := leftRows[epoch]; !ok {
+			epochs = append(epochs, epoch)
+		}
+	}
+	sort.Slice(epochs, func(i, j int) bool { return epochs[i] < epochs[j] })
+
+	diff := make([]float64, len(epochs))
+	for k, epoch := range epochs {
+		i, inLeft := leftRows[epoch]
+		j, inRight := rightRows[epoch]
+		if !inLeft || !inRight {
+			diff[k] = math.NaN()
+			continue
+		}
+		diff[k] = left[i] - right[j]
+	}
+	out := NewColumnSeries()
+	out.AddColumn("Epoch", epochs)
+	out.AddColumn(col, diff)
+	return out, nil
+}
@@ -0,0 +1,111 @@
+package io
+
+import (
+	"math"
+)
+
+/*
+FLOAT16 columns are stored on disk as IEEE 754 half-precision values (2 bytes)
+and surface as float32 once read. All 65536 bit patterns are decoded once at
+init, so converting a column is a table lookup per element.
+*/
+var float16Table [1 << 16]float32
+
+func init() {
+	for i := range float16Table {
+		float16Table[i] = decodeFloat16(uint16(i))
+	}
+}
+
+// Float16ToFloat32 returns the float32 value of the half-precision bits v.
+func Float16ToFloat32(v uint16) float32 {
+	return float16Table[v]
+}
+
+// Float32ToFloat16 converts v to half-precision bits, rounding to nearest even.
+// Values too large for half precision become infinity, values too small
+// become (signed) zero and NaN is preserved.
+func Float32ToFloat16(v float32) uint16 {
+	bits := math.Float32bits(v)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int32(bits>>23) & 0xff
+	mant := bits & 0x7fffff
+
+	switch {
+	case exp == 0xff:
+		// Inf or NaN, keep a mantissa bit set for NaN
+		if mant != 0 {
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	case exp-127 > 15:
+		// Overflow
+		return sign | 0x7c00
+	case exp-127 >= -14:
+		// Normal range
+		half := uint32(exp-127+15)<<10 | mant>>13
+		return sign | uint16(roundToNearestEven(half, mant, 13))
+	case exp-127 >= -25:
+		// Subnormal range, shift the implicit leading bit into the mantissa
+		mant |= 0x800000
+		shift := uint32(-14-(exp-127)) + 13
+		return sign | uint16(roundToNearestEven(mant>>shift, mant, shift))
+	}
+	// Underflow
+	return sign
+}
+
+// roundToNearestEven rounds the truncated value half using the shift bits
+// dropped from mant.
+func roundToNearestEven(half, mant, shift uint32) uint32 {
+	dropped := mant & (1<<shift - 1)
+	midpoint := uint32(1) << (shift - 1)
+	if dropped > midpoint || (dropped == midpoint && half&1 == 1) {
+		half++
+	}
+	return half
+}
+
+func decodeFloat16(v uint16) float32 {
+	sign := uint32(v&0x8000) << 16
+	exp := uint32(v>>10) & 0x1f
+	mant := uint32(v & 0x3ff)
+
+	switch {
+	case exp == 0x1f:
+		// Inf or NaN
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	case exp == 0:
+		if mant == 0 {
+			return math.Float32frombits(sign)
+		}
+		// Subnormal, normalize it for float32
+		exp = 127 - 14
+		for mant&0x400 == 0 {
+			mant <<= 1
+			exp--
+		}
+		mant &= 0x3ff
+		return math.Float32frombits(sign | exp<<23 | mant<<13)
+	}
+	return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+}
+
+func getFloat16Column(offset, reclen, nrecs int, data []byte) (col []float32) {
+	col = make([]float32, nrecs)
+	for i := 0; i < nrecs; i++ {
+		pos := i*reclen + offset
+		col[i] = float16Table[uint16(data[pos])|uint16(data[pos+1])<<8]
+	}
+	return col
+}
+
+// float16Bytes returns the half-precision bytes of the float32 values of col.
+func float16Bytes(col []float32) []byte {
+	data := make([]byte, 2*len(col))
+	for i, v := range col {
+		bits := Float32ToFloat16(v)
+		data[2*i], data[2*i+1] = byte(bits), byte(bits>>8)
+	}
+	return data
+}
@@ -0,0 +1,47 @@
+package io
+
+import (
+	"fmt"
+	"math"
+)
+
+// LinearInterpolate returns the values of the numeric column col at each of
+// the sorted targetEpochs, interpolated linearly between the rows of the
+// series around them. A target at the epoch of a row takes its value, and
+// targets before the first row or after the last are NaN as the values are
+// not extrapolated. The epochs of the series must be sorted.
+func (cs *ColumnSeries) LinearInterpolate(col string, targetEpochs []int64) ([]float64, error) {
+	values, err := cs.floatColumn("LinearInterpolate", col)
+	if err != nil {
+		return nil, err
+	}
+	epochs := cs.GetEpoch()
+	for i := 1; i < len(epochs); i++ {
+		if epochs[i] < epochs[i-1] {
+			return nil, fmt.Errorf("LinearInterpolate: epochs of the series are not sorted")
+		}
+	}
+
+	interpolated := make([]float64, len(targetEpochs))
+	var j int
+	for i, target := range targetEpochs {
+		if i > 0 && target < targetEpochs[i-1] {
+			return nil, fmt.Errorf("LinearInterpolate: target epochs are not sorted")
+		}
+		if len(epochs) == 0 || target < epochs[0] || target > epochs[len(epochs)-1] {
+			interpolated[i] = math.NaN()
+			continue
+		}
+		// The targets are sorted, so the row before them only moves forward
+		for j+1 < len(epochs) && epochs[j+1] <= target {
+			j++
+		}
+		if epochs[j] == target {
+			interpolated[i] = values[j]
+			continue
+		}
+		ratio := float64(target-epochs[j]) / float64(epochs[j+1]-epochs[j])
+		interpolated[i] = values[j] + ratio*(values[j+1]-values[j])
+	}
+	return interpolated, nil
+}
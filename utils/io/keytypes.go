@@ -120,12 +120,25 @@ func (mk *TimeBucketKey) SetItemInCategory(catName string, itemName string) {
 	mk.Key = itemKey + ":" + mk.GetCatKey()
 }
 
+// ErrUnknownTimeframe is returned for a timeframe that is neither of the
+// <multiplier><unit> form nor registered as a custom timeframe
+type ErrUnknownTimeframe struct {
+	Name string
+}
+
+func (e ErrUnknownTimeframe) Error() string {
+	return fmt.Sprintf("unknown timeframe %s", e.Name)
+}
+
 func (mk *TimeBucketKey) GetTimeFrame() (tf *utils.Timeframe, err error) {
 	tfs := mk.GetItemInCategory("Timeframe")
 	if len(tfs) == 0 {
 		return &utils.Timeframe{}, fmt.Errorf("Error: Unable to get timeframe from key")
 	}
-	return utils.TimeframeFromString(tfs), nil
+	if tf = utils.TimeframeFromString(tfs); tf == nil {
+		return nil, ErrUnknownTimeframe{Name: tfs}
+	}
+	return tf, nil
 }
 
 func (mk *TimeBucketKey) GetPathToYearFiles(rootDir string) string {
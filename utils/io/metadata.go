@@ -2,8 +2,11 @@ package io
 
 import (
 	"bytes"
+	"encoding/binary"
+	"errors"
 	"os"
 	"sync"
+	"sync/atomic"
 	"unsafe"
 
 	"fmt"
@@ -19,6 +22,22 @@ import (
 const Headersize = 37024
 const FileinfoVersion = int64(2.0)
 
+// FileMagic marks the first bytes of every year file header as "MKTS". Files
+// written before it was added begin with the version as 8 bytes instead.
+var FileMagic = [4]byte{'M', 'K', 'T', 'S'}
+
+// HasFileMagic returns true if the header beginning with b is that of a
+// year file, with or without the magic bytes.
+func HasFileMagic(b []byte) bool {
+	if len(b) < len(FileMagic) {
+		return false
+	}
+	return bytes.Equal(b[:len(FileMagic)], FileMagic[:]) ||
+		int64(binary.LittleEndian.Uint32(b)) == FileinfoVersion
+}
+
+var ErrNotVariableRecord = errors.New("record type is not VARIABLE")
+
 func daysInYear(year int) int {
 	testYear := time.Date(year, time.December, 31, 0, 0, 0, 0, time.Local)
 	return testYear.YearDay()
@@ -54,6 +73,11 @@ type TimeBucketInfo struct {
 	variableRecordLength int32 // In case of variable recordType, the sum of field lengths in elementTypes
 	elementNames         []string
 	elementTypes         []EnumElementType
+	// Unix nanoseconds of the creation and of the last write of the bucket,
+	// kept in its metadata file by the catalog and shared by its year files
+	createdAt, lastModifiedAt int64
+	// StorageTier of the file, kept in the metadata file of the bucket
+	storageTier int32
 
 	once sync.Once
 }
@@ -89,6 +113,16 @@ func NewTimeBucketInfo(tf utils.Timeframe, path, description string, year int16,
 	return f
 }
 
+// NewTimeBucketInfoFromFile reads the header of the year file at path. Unlike
+// the lazy loading of the catalog, a file that can't be read returns an error.
+func NewTimeBucketInfoFromFile(path string) (f *TimeBucketInfo, err error) {
+	f = new(TimeBucketInfo)
+	if err = f.readHeader(path); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
 func CreateShapesForTimeBucketInfo(dsv []DataShape) (elementTypes []EnumElementType, elementNames []string) {
 	/*
 		Takes a datashape array and returns elementTypes and elementNames
@@ -122,11 +156,80 @@ func (f *TimeBucketInfo) GetDataShapesWithEpoch() (out []DataShape) {
 
 func (f *TimeBucketInfo) getFieldRecordLength() (fieldRecordLength int) {
 	for _, elType := range f.GetElementTypes() {
+		// An unknown type would have size 0 and shift every following column
+		if _, ok := attributeMap[elType]; !ok {
+			panic(fmt.Sprintf("unknown ElementType %d", elType))
+		}
 		fieldRecordLength += elType.Size()
 	}
 	return fieldRecordLength
 }
 
+// GetCreatedAt returns when the bucket of the file was created, the zero
+// time if it is not known
+func (f *TimeBucketInfo) GetCreatedAt() time.Time {
+	return unixNanoTime(atomic.LoadInt64(&f.createdAt))
+}
+
+// GetLastModifiedAt returns when the bucket of the file was last written to,
+// the zero time if it is not known
+func (f *TimeBucketInfo) GetLastModifiedAt() time.Time {
+	return unixNanoTime(atomic.LoadInt64(&f.lastModifiedAt))
+}
+
+// SetBucketTimes sets the creation and last write times of the bucket of the
+// file, it may be called while the file is read
+func (f *TimeBucketInfo) SetBucketTimes(createdAt, lastModifiedAt time.Time) {
+	atomic.StoreInt64(&f.createdAt, timeUnixNano(createdAt))
+	atomic.StoreInt64(&f.lastModifiedAt, timeUnixNano(lastModifiedAt))
+}
+
+// StorageTier is where a year file is stored
+type StorageTier int32
+
+const (
+	// HotTier files are stored in the root directory
+	HotTier StorageTier = iota
+	// ColdTier files are stored by the cold storage and linked to from the
+	// root directory
+	ColdTier
+)
+
+func (t StorageTier) String() string {
+	switch t {
+	case HotTier:
+		return "hot"
+	case ColdTier:
+		return "cold"
+	}
+	return fmt.Sprintf("StorageTier(%d)", int32(t))
+}
+
+// GetStorageTier returns where the file is stored
+func (f *TimeBucketInfo) GetStorageTier() StorageTier {
+	return StorageTier(atomic.LoadInt32(&f.storageTier))
+}
+
+// SetStorageTier sets where the file is stored, it may be called while the
+// file is read
+func (f *TimeBucketInfo) SetStorageTier(tier StorageTier) {
+	atomic.StoreInt32(&f.storageTier, int32(tier))
+}
+
+func unixNanoTime(nanos int64) time.Time {
+	if nanos == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos).UTC()
+}
+
+func timeUnixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
 // GetDeepCopy returns a copy of this TimeBucketInfo.
 func (f *TimeBucketInfo) GetDeepCopy() *TimeBucketInfo {
 	f.once.Do(f.initFromFile)
@@ -141,6 +244,9 @@ func (f *TimeBucketInfo) GetDeepCopy() *TimeBucketInfo {
 		recordType:           f.recordType,
 		recordLength:         f.recordLength,
 		variableRecordLength: f.variableRecordLength,
+		createdAt:            atomic.LoadInt64(&f.createdAt),
+		lastModifiedAt:       atomic.LoadInt64(&f.lastModifiedAt),
+		storageTier:          atomic.LoadInt32(&f.storageTier),
 	}
 	fcopy.elementNames = make([]string, len(f.elementNames))
 	fcopy.elementTypes = make([]EnumElementType, len(f.elementTypes))
@@ -193,6 +299,13 @@ func (f *TimeBucketInfo) GetNelements() int32 {
 	return f.nElements
 }
 
+// GetSchemaVersion returns the version of the schema the file was written
+// with. Columns are only ever appended to a bucket, so the number of elements
+// identifies the schema.
+func (f *TimeBucketInfo) GetSchemaVersion() int16 {
+	return int16(f.GetNelements())
+}
+
 // GetRecordLength returns the length of a single record in the file described
 // by the given TimeBucketInfo
 func (f *TimeBucketInfo) GetRecordLength() int32 {
@@ -201,15 +314,18 @@ func (f *TimeBucketInfo) GetRecordLength() int32 {
 }
 
 // GetVariableRecordLength returns the length of a single record for a variable
-// length TimeBucketInfo file
-func (f *TimeBucketInfo) GetVariableRecordLength() int32 {
+// length TimeBucketInfo file, or ErrNotVariableRecord for any other record type
+func (f *TimeBucketInfo) GetVariableRecordLength() (int32, error) {
 	f.once.Do(f.initFromFile)
 
-	if f.recordType == VARIABLE && f.variableRecordLength == 0 {
+	if f.recordType != VARIABLE {
+		return 0, ErrNotVariableRecord
+	}
+	if f.variableRecordLength == 0 {
 		// Variable records use the raw element sizes plus a 4-byte trailer for interval ticks
 		f.variableRecordLength = int32(f.getFieldRecordLength()) + 4 // Variable records have a 4-byte trailer
 	}
-	return f.variableRecordLength
+	return f.variableRecordLength, nil
 }
 
 // GetRecordType returns the type of the file described by the TimeBucketInfo
@@ -287,12 +403,21 @@ func (f *TimeBucketInfo) readHeader(path string) (err error) {
 			return err
 		}
 	}
+	// Every other offset of the file is computed from the timeframe
+	if tf := time.Duration(header.Timeframe); tf < time.Second || tf%time.Second != 0 {
+		return ErrUnknownTimeframe{Name: tf.String()}
+	}
 	f.load(header, path)
 	return nil
 }
 
 func (f *TimeBucketInfo) load(hp *Header, path string) {
-	f.version = hp.Version
+	if hp.Magic == FileMagic {
+		f.version = int64(hp.Version)
+	} else {
+		// Without the magic bytes the version takes the first 8 bytes
+		f.version = int64(binary.LittleEndian.Uint32(hp.Magic[:]))
+	}
 	f.description = string(bytes.Trim(hp.Description[:], "\x00"))
 	f.Year = int16(hp.Year)
 	f.Path = filepath.Clean(path)
@@ -319,7 +444,8 @@ func NewTimeBucketInfoFromHeader(hp *Header, path string) *TimeBucketInfo {
 
 // Header is the on-disk byte representation of the file header
 type Header struct {
-	Version      int64
+	Magic        [4]byte
+	Version      int32
 	Description  [256]byte
 	Year         int64
 	Timeframe    int64 // Duration in nanoseconds
@@ -350,7 +476,8 @@ func (hp *Header) Load(f *TimeBucketInfo) {
 			"FileInfoVersion does not match this version of MarketStore %v != %v",
 			f.GetVersion(), FileinfoVersion)
 	}
-	hp.Version = f.GetVersion()
+	hp.Magic = FileMagic
+	hp.Version = int32(f.GetVersion())
 	copy(hp.Description[:], f.GetDescription())
 	hp.Year = int64(f.Year)
 	hp.Timeframe = int64(f.GetTimeframe().Nanoseconds())
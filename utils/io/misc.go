@@ -7,12 +7,39 @@ import (
 	"path"
 	"runtime"
 	"syscall"
+
+	. "github.com/alpacahq/marketstore/utils/log"
 )
 
 func Syncfs() {
 	syscall.Sync()
 }
 
+// SeekBackward moves the position of f back by relativeOffset bytes, without
+// going below lowerBound. It returns the number of bytes moved and the new
+// position.
+func SeekBackward(f io.Seeker, relativeOffset int32, lowerBound int64) (seekAmt int64, curPos int64, err error) {
+	// Find the current file position
+	curPos, err = f.Seek(0, os.SEEK_CUR)
+	if err != nil {
+		Log(ERROR, "Read: cannot find current file position: %s", err)
+		return 0, curPos, err
+	}
+	// If seeking backward would go lower than the lower bound, seek to lower bound
+	if (curPos - int64(relativeOffset)) <= lowerBound {
+		seekAmt = curPos - lowerBound
+	} else {
+		seekAmt = int64(relativeOffset)
+	}
+	curPos, err = f.Seek(-seekAmt, os.SEEK_CUR)
+	if err != nil {
+		err = fmt.Errorf("Error: seeking to rel offset: %d lowerBound: %d | %s",
+			relativeOffset, lowerBound, err)
+		return 0, curPos, err
+	}
+	return seekAmt, curPos, nil
+}
+
 func GetCallerFileContext(level int) (FileContext string) {
 	_, file, line, _ := runtime.Caller(1 + level)
 	return fmt.Sprintf("%s:%d", file, line)
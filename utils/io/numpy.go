@@ -18,6 +18,7 @@ var (
 		UINT16:  "u2",
 		UINT32:  "u4",
 		UINT64:  "u8",
+		FLOAT16: "f2",
 		FLOAT32: "f4",
 		FLOAT64: "f8",
 	}
@@ -0,0 +1,131 @@
+package io
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/alpacahq/marketstore/utils"
+)
+
+// Aggregation is how the values of a column within a resampled interval are
+// combined into one
+type Aggregation int
+
+const (
+	AggFirst Aggregation = iota // e.g. Open
+	AggLast                     // e.g. Close
+	AggMax                      // e.g. High
+	AggMin                      // e.g. Low
+	AggSum                      // e.g. Volume
+	AggMean                     // e.g. a VWAP proxy
+)
+
+// AggMethod maps the name of each column kept by Resample to its Aggregation
+type AggMethod map[string]Aggregation
+
+// Resample aggregates the rows of the series, which must be sorted by epoch,
+// into one row per interval of targetTF. Each row has the epoch at which its
+// interval begins, so the last row may only cover the beginning of its
+// interval. Only the columns of method are kept, in the order of the series.
+// The mean of an integer column is truncated to an integer.
+func (cs *ColumnSeries) Resample(targetTF utils.Timeframe, method AggMethod) (*ColumnSeries, error) {
+	seconds := int64(targetTF.Duration.Seconds())
+	if seconds <= 0 {
+		return nil, fmt.Errorf("Resample: timeframe %s is shorter than a second", targetTF.String)
+	}
+	for name := range method {
+		if name == "Epoch" || !cs.Exists(name) {
+			return nil, fmt.Errorf("Resample: column %s can not be aggregated: %v", name, ErrColumnNotFound)
+		}
+	}
+
+	// Rows [bounds[i], bounds[i+1]) belong to the interval beginning at epochs[i]
+	epochs := []int64{}
+	bounds := []int{}
+	for i, epoch := range cs.GetEpoch() {
+		bucket := epoch - (epoch % seconds)
+		if n := len(epochs); n > 0 && bucket < epochs[n-1] {
+			return nil, fmt.Errorf("Resample: epoch %d is out of order", epoch)
+		} else if n == 0 || bucket != epochs[n-1] {
+			epochs = append(epochs, bucket)
+			bounds = append(bounds, i)
+		}
+	}
+	bounds = append(bounds, cs.Len())
+
+	out := NewColumnSeries()
+	out.AddColumn("Epoch", epochs)
+	for _, name := range cs.orderedNames {
+		agg, ok := method[name]
+		if !ok {
+			continue
+		}
+		col := reflect.ValueOf(cs.columns[name])
+		resampled := reflect.MakeSlice(col.Type(), len(epochs), len(epochs))
+		for i := range epochs {
+			value, err := aggregate(col.Slice(bounds[i], bounds[i+1]), agg)
+			if err != nil {
+				return nil, fmt.Errorf("Resample: column %s: %v", name, err)
+			}
+			resampled.Index(i).Set(value)
+		}
+		out.AddColumn(name, resampled.Interface())
+	}
+	return out, nil
+}
+
+// aggregate combines the values of a non-empty column slice
+func aggregate(values reflect.Value, agg Aggregation) (reflect.Value, error) {
+	n := values.Len()
+	switch agg {
+	case AggFirst:
+		return values.Index(0), nil
+	case AggLast:
+		return values.Index(n - 1), nil
+	}
+
+	elemType := values.Type().Elem()
+	var toFloat func(reflect.Value) float64
+	switch elemType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		toFloat = func(v reflect.Value) float64 { return float64(v.Int()) }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		toFloat = func(v reflect.Value) float64 { return float64(v.Uint()) }
+	case reflect.Float32, reflect.Float64:
+		toFloat = func(v reflect.Value) float64 { return v.Float() }
+	default:
+		return reflect.Value{}, fmt.Errorf("%v values can only be aggregated by AggFirst or AggLast", elemType)
+	}
+
+	switch agg {
+	case AggMax, AggMin:
+		best := values.Index(0)
+		for i := 1; i < n; i++ {
+			v := values.Index(i)
+			if (agg == AggMax && toFloat(v) > toFloat(best)) || (agg == AggMin && toFloat(v) < toFloat(best)) {
+				best = v
+			}
+		}
+		return best, nil
+	case AggSum:
+		sum := reflect.New(elemType).Elem()
+		for i := 0; i < n; i++ {
+			switch v := values.Index(i); elemType.Kind() {
+			case reflect.Float32, reflect.Float64:
+				sum.SetFloat(sum.Float() + v.Float())
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+				sum.SetUint(sum.Uint() + v.Uint())
+			default:
+				sum.SetInt(sum.Int() + v.Int())
+			}
+		}
+		return sum, nil
+	case AggMean:
+		var total float64
+		for i := 0; i < n; i++ {
+			total += toFloat(values.Index(i))
+		}
+		return reflect.ValueOf(total / float64(n)).Convert(elemType), nil
+	}
+	return reflect.Value{}, fmt.Errorf("unknown aggregation %d", agg)
+}
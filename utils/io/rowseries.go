@@ -38,6 +38,8 @@ func (rows *Rows) GetColumn(colname string) (col interface{}) {
 	for _, ds := range rows.GetDataShapes() {
 		if ds.Name == colname {
 			switch ds.Type {
+			case FLOAT16:
+				return getFloat16Column(offset, int(rows.GetRowLen()), rows.GetNumRows(), rows.GetData())
 			case FLOAT32:
 				return getFloat32Column(offset, int(rows.GetRowLen()), rows.GetNumRows(), rows.GetData())
 			case FLOAT64:
@@ -0,0 +1,73 @@
+package io
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// ZeroVarianceWarning is returned by ZScore along with all zero scores when
+// every value of the column is the same
+type ZeroVarianceWarning string
+
+func (col ZeroVarianceWarning) Error() string {
+	return fmt.Sprintf("ZScore: column %s has zero variance", string(col))
+}
+
+// ZScore returns (x - mean) / stddev for each value of the numeric column
+// name, using the population standard deviation. The mean and standard
+// deviation are computed in a single pass with Welford's algorithm. If the
+// standard deviation is 0 the scores are all 0 and the error is a
+// ZeroVarianceWarning.
+func (cs *ColumnSeries) ZScore(name string) ([]float64, error) {
+	values, err := cs.floatColumn("ZScore", name)
+	if err != nil {
+		return nil, err
+	}
+
+	var mean, m2 float64
+	for i, x := range values {
+		delta := x - mean
+		mean += delta / float64(i+1)
+		m2 += delta * (x - mean)
+	}
+
+	scores := make([]float64, len(values))
+	if len(values) == 0 {
+		return scores, nil
+	}
+	stddev := math.Sqrt(m2 / float64(len(values)))
+	if stddev == 0 {
+		return scores, ZeroVarianceWarning(name)
+	}
+	for i, x := range values {
+		scores[i] = (x - mean) / stddev
+	}
+	return scores, nil
+}
+
+// columnAsFloat64 converts a column of any numeric type to float64
+func columnAsFloat64(col interface{}) ([]float64, error) {
+	if values, ok := col.([]float64); ok {
+		return values, nil
+	}
+	v := reflect.ValueOf(col)
+	out := make([]float64, v.Len())
+	switch v.Type().Elem().Kind() {
+	case reflect.Float32:
+		for i := range out {
+			out[i] = v.Index(i).Float()
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		for i := range out {
+			out[i] = float64(v.Index(i).Int())
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		for i := range out {
+			out[i] = float64(v.Index(i).Uint())
+		}
+	default:
+		return nil, fmt.Errorf("%v is not a numeric type", v.Type().Elem())
+	}
+	return out, nil
+}
@@ -9,6 +9,10 @@ import (
 func Log(level Level, format string, args ...interface{}) {
 	switch level {
 	default:
+	case DEBUG:
+		if logLevel >= DEBUG {
+			glog.Infof(format, args...)
+		}
 	case INFO:
 		if logLevel >= INFO {
 			glog.Infof(format, args...)
@@ -39,6 +43,7 @@ const (
 	ERROR
 	WARNING
 	INFO
+	DEBUG
 )
 
 var logLevel Level
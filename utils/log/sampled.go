@@ -0,0 +1,38 @@
+package log
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+type Logger interface {
+	Log(level Level, format string, args ...interface{})
+}
+
+type sampledLogger struct {
+	rate       int64
+	calls      int64
+	suppressed int64
+	log        func(level Level, format string, args ...interface{})
+}
+
+// Sampled returns a Logger that emits only one message out of every rate calls,
+// for log sites that can fire once per record. Each emitted message carries
+// the number of messages suppressed since the previous one.
+func Sampled(rate int) Logger {
+	if rate < 1 {
+		rate = 1
+	}
+	return &sampledLogger{rate: int64(rate), log: Log}
+}
+
+func (l *sampledLogger) Log(level Level, format string, args ...interface{}) {
+	if (atomic.AddInt64(&l.calls, 1)-1)%l.rate != 0 {
+		atomic.AddInt64(&l.suppressed, 1)
+		return
+	}
+	if suppressed := atomic.SwapInt64(&l.suppressed, 0); suppressed > 0 {
+		format += fmt.Sprintf(" (%d similar messages suppressed)", suppressed)
+	}
+	l.log(level, format, args...)
+}
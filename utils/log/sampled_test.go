@@ -0,0 +1,42 @@
+package log
+
+import (
+	"fmt"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+// Hook up gocheck into the "go test" runner.
+func Test(t *testing.T) { TestingT(t) }
+
+type TestSuite struct{}
+
+var _ = Suite(&TestSuite{})
+
+func (s *TestSuite) TestSampled(c *C) {
+	var logged []string
+	l := Sampled(3).(*sampledLogger)
+	l.log = func(level Level, format string, args ...interface{}) {
+		c.Assert(level, Equals, ERROR)
+		logged = append(logged, fmt.Sprintf(format, args...))
+	}
+	for i := 0; i < 7; i++ {
+		l.Log(ERROR, "read %d failed", i)
+	}
+	c.Assert(logged, DeepEquals, []string{
+		"read 0 failed",
+		"read 3 failed (2 similar messages suppressed)",
+		"read 6 failed (2 similar messages suppressed)",
+	})
+
+	// Every message is logged at a rate below 1
+	logged = nil
+	l = Sampled(0).(*sampledLogger)
+	l.log = func(level Level, format string, args ...interface{}) {
+		logged = append(logged, fmt.Sprintf(format, args...))
+	}
+	l.Log(ERROR, "a")
+	l.Log(ERROR, "b")
+	c.Assert(logged, DeepEquals, []string{"a", "b"})
+}
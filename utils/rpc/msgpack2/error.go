@@ -24,14 +24,14 @@ var ErrNullResult = errors.New("result is null")
 
 type Error struct {
 	// A Number that indicates the error type that occurred.
-	Code ErrorCode `msgpack:"code"` /* required */
+	Code ErrorCode `msgpack:"code" cbor:"code"` /* required */
 
 	// A String providing a short description of the error.
 	// The message SHOULD be limited to a concise single sentence.
-	Message string `msgpack:"message"` /* required */
+	Message string `msgpack:"message" cbor:"message"` /* required */
 
 	// A Primitive or Structured value that contains additional information about the error.
-	Data interface{} `msgpack:"data"` /* optional */
+	Data interface{} `msgpack:"data" cbor:"data"` /* optional */
 }
 
 func (e *Error) Error() string {
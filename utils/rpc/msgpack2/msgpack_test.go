@@ -9,6 +9,7 @@ package msgpack2
 
 import (
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -205,3 +206,27 @@ func TestDecodeNullResult(t *testing.T) {
 		t.Error("Expected result to be nil, but got:", result)
 	}
 }
+
+func TestServiceCBOR(t *testing.T) {
+	s := rpc.NewServer()
+	s.RegisterCodec(NewCodec(), "application/x-msgpack")
+	s.RegisterService(new(Service1), "")
+
+	j, _ := msgpack.Marshal(&Service1NoParamsRequest{"2.0", "Service1.Multiply", 1})
+	r, _ := http.NewRequest("POST", "http://localhost:8080/", bytes.NewBuffer(j))
+	r.Header.Set("Content-Type", "application/x-msgpack")
+	r.Header.Set("Accept", "text/plain, application/cbor;q=0.9")
+
+	w := NewRecorder()
+	s.ServeHTTP(w, r)
+	if ct := w.HeaderMap.Get("Content-Type"); ct != "application/cbor" {
+		t.Errorf("Wrong content type: got %q, want application/cbor", ct)
+	}
+	// {"id": 1, "result": {"Result": 9999}, "jsonrpc": "2.0"}
+	want := "a3" + "626964" + "01" +
+		"66726573756c74" + "a1" + "66526573756c74" + "19270f" +
+		"676a736f6e727063" + "63322e30"
+	if got := hex.EncodeToString(w.Body.Bytes()); got != want {
+		t.Errorf("Wrong response: got %s, want %s", got, want)
+	}
+}
@@ -8,16 +8,29 @@
 package msgpack2
 
 import (
+	"mime"
 	"net/http"
+	"strings"
 
 	"github.com/gorilla/rpc/v2"
 
+	"github.com/fxamacker/cbor/v2"
 	msgpack "github.com/vmihailenco/msgpack"
 )
 
 // var null = json.RawMessage([]byte("null"))
 var Version = "2.0"
 
+// cborEncMode sorts the map keys and struct fields of the responses bytewise
+var cborEncMode cbor.EncMode
+
+func init() {
+	var err error
+	if cborEncMode, err = (cbor.EncOptions{Sort: cbor.SortBytewiseLexical}).EncMode(); err != nil {
+		panic(err)
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Request and Response
 // ----------------------------------------------------------------------------
@@ -44,20 +57,20 @@ type serverRequest struct {
 // serverResponse represents a JSON-RPC response returned by the server.
 type serverResponse struct {
 	// JSON-RPC protocol.
-	Version string `msgpack:"jsonrpc"`
+	Version string `msgpack:"jsonrpc" cbor:"jsonrpc"`
 
 	// The Object that was returned by the invoked method. This must be null
 	// in case there was an error invoking the method.
 	// As per spec the member will be omitted if there was an error.
-	Result interface{} `msgpack:"result,omitempty"`
+	Result interface{} `msgpack:"result,omitempty" cbor:"result,omitempty"`
 
 	// An Error object if there was an error invoking the method. It must be
 	// null if there was no error.
 	// As per spec the member will be omitted if there was no error.
-	Error *Error `msgpack:"error,omitempty"`
+	Error *Error `msgpack:"error,omitempty" cbor:"error,omitempty"`
 
 	// This must be the same id as the request it is responding to.
-	Id interface{} `msgpack:"id"`
+	Id interface{} `msgpack:"id" cbor:"id"`
 }
 
 // ----------------------------------------------------------------------------
@@ -108,7 +121,18 @@ func newCodecRequest(r *http.Request, encoder rpc.Encoder) rpc.CodecRequest {
 		}
 	}
 	r.Body.Close()
-	return &CodecRequest{request: req, err: err, encoder: encoder}
+	return &CodecRequest{request: req, err: err, encoder: encoder, cbor: acceptsCBOR(r)}
+}
+
+// acceptsCBOR returns true if the client asks for a CBOR response with an
+// "Accept: application/cbor" header
+func acceptsCBOR(r *http.Request) bool {
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		if mediaType, _, err := mime.ParseMediaType(accept); err == nil && mediaType == "application/cbor" {
+			return true
+		}
+	}
+	return false
 }
 
 // CodecRequest decodes and encodes a single request.
@@ -116,6 +140,8 @@ type CodecRequest struct {
 	request *serverRequest
 	err     error
 	encoder rpc.Encoder
+	// set to encode the response in CBOR rather than msgpack
+	cbor bool
 }
 
 // Method returns the RPC method for the current request.
@@ -195,7 +221,15 @@ func (c *CodecRequest) WriteError(w http.ResponseWriter, status int, err error)
 
 func (c *CodecRequest) writeServerResponse(w http.ResponseWriter, res *serverResponse) {
 	// Id is null for notifications and they don't have a response.
-	if c.request.Id != nil {
+	if c.request.Id != nil && c.cbor {
+		encoded, err := cborEncMode.Marshal(res)
+		if err != nil {
+			rpc.WriteError(w, 400, err.Error())
+			return
+		}
+		w.Header().Set("Content-Type", "application/cbor")
+		c.encoder.Encode(w).Write(encoded)
+	} else if c.request.Id != nil {
 		w.Header().Set("Content-Type", "application/x-msgpack")
 		encoder := msgpack.NewEncoder(c.encoder.Encode(w))
 		err := encoder.Encode(res)
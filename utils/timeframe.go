@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -32,6 +33,31 @@ var Timeframes = []*Timeframe{
 	{"1D", Day},
 }
 
+var (
+	customTimeframesMu sync.RWMutex
+	// Durations of the timeframes registered by name, e.g. "Weekly"
+	customTimeframes = map[string]time.Duration{}
+)
+
+// RegisterCustomTimeframe makes name a timeframe of duration d, for names
+// that are not of the <multiplier><unit> form or that need another duration.
+// It panics if d is not a positive number of seconds.
+func RegisterCustomTimeframe(name string, d time.Duration) {
+	if d < time.Second || d%time.Second != 0 {
+		panic(fmt.Sprintf("timeframe %s: %v is not a positive number of seconds", name, d))
+	}
+	customTimeframesMu.Lock()
+	defer customTimeframesMu.Unlock()
+	customTimeframes[name] = d
+}
+
+func customTimeframe(name string) (time.Duration, bool) {
+	customTimeframesMu.RLock()
+	defer customTimeframesMu.RUnlock()
+	d, ok := customTimeframes[name]
+	return d, ok
+}
+
 type Timeframe struct {
 	String   string
 	Duration time.Duration
@@ -54,6 +80,9 @@ func NewTimeframe(arg interface{}) (tf *Timeframe) {
 }
 
 func TimeframeFromString(tf string) *Timeframe {
+	if d, ok := customTimeframe(tf); ok {
+		return &Timeframe{String: tf, Duration: d}
+	}
 	for _, def := range timeframeDefs {
 		if strings.Contains(tf, def.String) {
 			t, err := strconv.ParseInt(strings.Split(tf, def.String)[0], 10, 32)
@@ -208,6 +237,9 @@ func (cd *CandleDuration) Duration() time.Duration {
 }
 
 func CandleDurationFromString(tf string) (cd *CandleDuration) {
+	if d, ok := customTimeframe(tf); ok {
+		return &CandleDuration{String: tf, multiplier: 1, duration: d}
+	}
 	re := regexp.MustCompile("([0-9]+)(Sec|Min|H|D|W|M|Y)")
 	groups := re.FindStringSubmatch(tf)
 	if len(groups) == 0 {
@@ -105,3 +105,19 @@ func (s *UtilsTestSuite) TestCandleDuration(c *C) {
 	cd = CandleDurationFromString("abc")
 	c.Assert(cd, IsNil)
 }
+
+func (s *UtilsTestSuite) TestCustomTimeframe(c *C) {
+	c.Assert(TimeframeFromString("Quarterly"), IsNil)
+	c.Assert(CandleDurationFromString("Quarterly"), IsNil)
+
+	RegisterCustomTimeframe("Quarterly", 13*Week)
+	tf := TimeframeFromString("Quarterly")
+	c.Assert(tf.String, Equals, "Quarterly")
+	c.Assert(tf.Duration, Equals, 13*Week)
+	cd := CandleDurationFromString("Quarterly")
+	c.Assert(cd.Duration(), Equals, 13*Week)
+	start := time.Unix(0, 0).UTC().Truncate(13 * Week)
+	c.Assert(cd.IsWithin(start.Add(Week), start), Equals, true)
+
+	c.Assert(func() { RegisterCustomTimeframe("Tick", time.Millisecond) }, PanicMatches, ".*not a positive number of seconds")
+}